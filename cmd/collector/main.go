@@ -125,6 +125,7 @@ func getBootOptions(ctx context.Context, cfg *config.Config) ([]bootstrap.Option
 			container.APIProcessorService,
 			container.RequestProcessorService,
 			container.CleanupService,
+			container.SchedulerService,
 			container.S3,
 		),
 		bootstrap.WithAfterStart(
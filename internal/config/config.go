@@ -104,6 +104,11 @@ type Config struct {
 		ReadChunkSize int `env:"S3_READ_CHUNK_SIZE" envDefault:"5242880"`
 		// WriteChunkSize is the size in bytes of the chunk to write to S3.
 		WriteChunkSize int `env:"S3_WRITE_CHUNK_SIZE" envDefault:"52428800"` // 50MB
+		// DeleteBatchSize is the number of objects to delete from S3 in a single request.
+		DeleteBatchSize int `env:"S3_DELETE_BATCH_SIZE" envDefault:"1000"`
+		// BodySpillThreshold is the size in bytes of a request body above which it is stored in S3
+		// instead of the database. Zero disables spilling.
+		BodySpillThreshold int `env:"S3_BODY_SPILL_THRESHOLD" envDefault:"1048576"` // 1MB
 	}
 
 	// Collection configuration.
@@ -116,8 +121,28 @@ type Config struct {
 		CleanupInterval time.Duration `env:"CLEANUP_INTERVAL" envDefault:"1h"`
 		// CleanupIntervalJitter is the jitter for the cleanup interval.
 		CleanupIntervalJitter time.Duration `env:"CLEANUP_INTERVAL_JITTER" envDefault:"1m"`
-		// RetentionPeriod is the duration for which collections are retained.
+		// RetentionPeriod is the default duration for which collections are retained.
 		RetentionPeriod time.Duration `env:"RETENTION_PERIOD" envDefault:"168h"` // 7 days
+		// CompletedRetentionPeriod overrides RetentionPeriod for completed collections.
+		// It is counted from the collection completion. Zero means RetentionPeriod is used.
+		CompletedRetentionPeriod time.Duration `env:"COMPLETED_RETENTION_PERIOD" envDefault:"0s"`
+		// FailedRetentionPeriod overrides RetentionPeriod for failed collections.
+		// It is counted from the collection completion. Zero means RetentionPeriod is used.
+		FailedRetentionPeriod time.Duration `env:"FAILED_RETENTION_PERIOD" envDefault:"0s"`
+		// CancelledRetentionPeriod overrides RetentionPeriod for cancelled collections.
+		// It is counted from the collection completion. Zero means RetentionPeriod is used.
+		CancelledRetentionPeriod time.Duration `env:"CANCELLED_RETENTION_PERIOD" envDefault:"0s"`
+		// CompletedRequestsRetention is the duration for which collected requests of completed collections
+		// are retained in the database after completion. The result is available from S3 anyway.
+		CompletedRequestsRetention time.Duration `env:"COMPLETED_REQUESTS_RETENTION" envDefault:"0s"`
+		// CleanupBatchSize is the maximum number of rows deleted by a single statement during cleanup.
+		CleanupBatchSize int `env:"CLEANUP_BATCH_SIZE" envDefault:"10000"`
+		// CleanupRetryLimit is the maximum number of failed object storage deletions to retry per cleanup.
+		CleanupRetryLimit int `env:"CLEANUP_RETRY_LIMIT" envDefault:"1000"`
+		// PartitionInterval is the time range of a single requests partition.
+		PartitionInterval time.Duration `env:"PARTITION_INTERVAL" envDefault:"24h"`
+		// PartitionPrecreate is the number of future requests partitions created in advance.
+		PartitionPrecreate int `env:"PARTITION_PRECREATE" envDefault:"3"`
 		// FinalizerInterval is the interval for checking collection status.
 		FinalizerInterval time.Duration `env:"FINALIZER_INTERVAL" envDefault:"10s"`
 		// FinalizerIntervalJitter is the jitter for the finalizer interval.
@@ -128,8 +153,19 @@ type Config struct {
 		FinalizerMaxCollections int `env:"FINALIZER_MAX_COLLECTIONS" envDefault:"10"`
 		// FinalizerResultBatchSize is the batch size for finalizing collections.
 		FinalizerResultBatchSize int `env:"FINALIZER_RESULT_BATCH_SIZE" envDefault:"100"`
+		// FinalizerMaxAttempts is the number of failed finalization attempts after which the collection is failed.
+		FinalizerMaxAttempts int `env:"FINALIZER_MAX_ATTEMPTS" envDefault:"5"`
+		// SchedulerInterval is the interval for creating collections by schedules.
+		SchedulerInterval time.Duration `env:"SCHEDULER_INTERVAL" envDefault:"10s"`
+		// SchedulerIntervalJitter is the jitter for the scheduler interval.
+		SchedulerIntervalJitter time.Duration `env:"SCHEDULER_INTERVAL_JITTER" envDefault:"1s"`
+		// SchedulerMaxSchedules is the maximum number of schedules to run per interval.
+		SchedulerMaxSchedules int `env:"SCHEDULER_MAX_SCHEDULES" envDefault:"100"`
 		// MaxRequestsPerCollection is the maximum number of requests per collection.
 		MaxRequestsPerCollection int `env:"MAX_REQUESTS_PER_COLLECTION" envDefault:"10000"`
+		// ExpressionCostLimit is the maximum evaluation cost of a selection expression per request.
+		// Requests exceeding it don't match the collection.
+		ExpressionCostLimit uint64 `env:"EXPRESSION_COST_LIMIT" envDefault:"10000"`
 	}
 }
 
@@ -0,0 +1,30 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/collector/internal/pb/api/collector"
+	"github.com/n-r-w/ctxlog"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CompleteCollection implements collector.CollectionServiceServer.
+func (s *Service) CompleteCollection(
+	ctx context.Context, req *collector.CompleteCollectionRequest,
+) (*emptypb.Empty, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, invalidRequestError(err)
+	}
+
+	id := entity.CollectionID(req.GetCollectionId())
+
+	if err := s.collectionManager.CompleteCollection(ctx, id); err != nil {
+		return nil, changeStatusError(ctx, id, "complete", err)
+	}
+
+	ctxlog.Debug(ctx, "collection completion requested", slog.String("collection_id", id.String()))
+
+	return &emptypb.Empty{}, nil
+}
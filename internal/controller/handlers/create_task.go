@@ -2,13 +2,16 @@ package handlers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"regexp"
+	"time"
 
 	"github.com/n-r-w/collector/internal/entity"
 	"github.com/n-r-w/collector/internal/pb/api/collector"
 	"github.com/n-r-w/ctxlog"
+	"github.com/samber/mo"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -21,29 +24,17 @@ func (s *Service) CreateTask(
 		return nil, invalidRequestError(err)
 	}
 
-	if int(req.GetCompletionCriteria().GetRequestCountLimit()) > s.maxRequestsPerCollection {
-		return nil, invalidRequestError(
-			fmt.Errorf("request count limit must be less than %d", s.maxRequestsPerCollection))
-	}
-
-	headerCriteria, err := s.convertHeaderCriteria(req.GetSelectionCriteria().GetHeaderCriteria())
+	task, err := s.convertTaskRequestToEntity(req)
 	if err != nil {
 		return nil, invalidRequestError(err)
 	}
 
-	task := entity.Task{
-		MessageSelection: entity.MessageSelectionCriteria{
-			Handler:        req.GetSelectionCriteria().GetHandler(),
-			HeaderCriteria: headerCriteria,
-		},
-		Completion: entity.CompletionCriteria{
-			TimeLimit:         req.GetCompletionCriteria().GetTimeLimit().AsDuration(),
-			RequestCountLimit: int(req.GetCompletionCriteria().GetRequestCountLimit()),
-		},
-	}
-
 	collectionID, err := s.collectionManager.CreateCollection(ctx, task)
 	if err != nil {
+		if errors.Is(err, entity.ErrInvalidRetention) {
+			return nil, invalidRequestError(err)
+		}
+
 		ctxlog.Error(ctx, "failed to create collection", slog.Any("error", err))
 		return nil, status.Errorf(codes.Internal, "failed to create collection: %v", err)
 	}
@@ -55,6 +46,163 @@ func (s *Service) CreateTask(
 	}, nil
 }
 
+// convertTaskRequestToEntity converts and validates parameters of a new collection.
+func (s *Service) convertTaskRequestToEntity(req *collector.CreateTaskRequest) (entity.Task, error) {
+	if req.GetSelectionCriteria() == nil && len(req.GetRules()) == 0 {
+		return entity.Task{}, errors.New("selection criteria or rules are required")
+	}
+
+	if len(req.GetRules()) > 0 && (req.GetSelectionCriteria() != nil || req.GetExpression() != "") {
+		return entity.Task{}, errors.New("rules can't be combined with selection criteria or expression")
+	}
+
+	var (
+		selection entity.MessageSelectionCriteria
+		err       error
+	)
+	if req.GetSelectionCriteria() != nil {
+		if selection, err = s.convertSelectionCriteria(req.GetSelectionCriteria()); err != nil {
+			return entity.Task{}, err
+		}
+	}
+
+	if req.GetExpression() != "" {
+		// compiled only to validate, the collection cache compiles it again
+		if selection.Expression, err = entity.CompileExpression(req.GetExpression(), s.expressionCostLimit); err != nil {
+			return entity.Task{}, err
+		}
+	}
+
+	rules, err := s.convertRulesToEntity(req.GetRules())
+	if err != nil {
+		return entity.Task{}, err
+	}
+
+	stratification, err := convertStratificationToEntity(req.GetStratification())
+	if err != nil {
+		return entity.Task{}, err
+	}
+
+	task := entity.Task{
+		MessageSelection: selection,
+		Rules:            rules,
+		Stratification:   stratification,
+		Completion: entity.CompletionCriteria{
+			TimeLimit:                 req.GetCompletionCriteria().GetTimeLimit().AsDuration(),
+			RequestCountLimit:         int(req.GetCompletionCriteria().GetRequestCountLimit()),
+			Reservoir:                 req.GetCompletionCriteria().GetReservoir(),
+			IdleTimeout:               req.GetCompletionCriteria().GetIdleTimeout().AsDuration(),
+			ByteSizeLimit:             int64(req.GetCompletionCriteria().GetByteSizeLimit()), //nolint:gosec // checked below
+			MinRequestCount:           int(req.GetCompletionCriteria().GetMinRequestCount()),
+			TimeLimitFromFirstRequest: req.GetCompletionCriteria().GetTimeLimitFromFirstRequest(),
+			MaxWait:                   req.GetCompletionCriteria().GetMaxWait().AsDuration(),
+		},
+		Retention: convertRetentionToEntity(req.GetRetention()),
+		Metadata: entity.Metadata{
+			Name:        req.GetName(),
+			Description: req.GetDescription(),
+			Owner:       req.GetOwner(),
+			Labels:      req.GetLabels(),
+		},
+	}
+
+	if startAt := timeFromProto(req.GetStartAt()); startAt != nil {
+		task.StartAt = mo.Some(*startAt)
+	}
+
+	// the request count limit of a multi-rule collection is the sum of the quotas,
+	// so the quotas are resolved before the limit is validated
+	if err := task.ResolveRuleQuotas(); err != nil {
+		return entity.Task{}, err
+	}
+
+	// the limit can be omitted only if every rule quota is a number of requests
+	if task.Completion.RequestCountLimit <= 0 {
+		return entity.Task{}, errors.New("request count limit must be positive")
+	}
+
+	if err := task.Completion.Validate(); err != nil {
+		return entity.Task{}, err
+	}
+
+	if err := task.ValidateStratification(); err != nil {
+		return entity.Task{}, err
+	}
+
+	if task.Completion.RequestCountLimit > s.maxRequestsPerCollection {
+		return entity.Task{}, fmt.Errorf("request count limit must not exceed %d", s.maxRequestsPerCollection)
+	}
+
+	return task, nil
+}
+
+func (s *Service) convertSelectionCriteria(
+	criteria *collector.MessageSelectionCriteria,
+) (entity.MessageSelectionCriteria, error) {
+	headerCriteria, err := s.convertHeaderCriteria(criteria.GetHeaderCriteria())
+	if err != nil {
+		return entity.MessageSelectionCriteria{}, err
+	}
+
+	handlerCriteria, err := convertHandlerCriteriaToEntity(criteria)
+	if err != nil {
+		return entity.MessageSelectionCriteria{}, err
+	}
+
+	var headerGroup mo.Option[entity.HeaderGroup]
+	if criteria.GetHeaderGroup() != nil {
+		group, err := s.convertHeaderGroup(criteria.GetHeaderGroup())
+		if err != nil {
+			return entity.MessageSelectionCriteria{}, err
+		}
+
+		if err := group.Validate(); err != nil {
+			return entity.MessageSelectionCriteria{}, err
+		}
+
+		headerGroup = mo.Some(group)
+	}
+
+	bodyCriteria, err := convertBodyCriteriaToEntity(criteria.GetBodyCriteria())
+	if err != nil {
+		return entity.MessageSelectionCriteria{}, err
+	}
+
+	return entity.MessageSelectionCriteria{
+		Handler:              criteria.GetHandler(),
+		HandlerCriteria:      handlerCriteria,
+		HeaderCriteria:       headerCriteria,
+		HeaderGroup:          headerGroup,
+		BodyCriteria:         bodyCriteria,
+		DistinctBodies:       criteria.GetDistinctBodies(),
+		SampleRate:           convertSampleRateToEntity(criteria.GetSampleRate()),
+		MaxRequestsPerSecond: int(criteria.GetMaxRequestsPerSecond()),
+	}, nil
+}
+
+// convertRulesToEntity converts rules of a traffic mix. Shares are resolved to quotas by the task.
+func (s *Service) convertRulesToEntity(rules []*collector.SelectionRule) ([]entity.SelectionRule, error) {
+	result := make([]entity.SelectionRule, 0, len(rules))
+	for _, r := range rules {
+		selection, err := s.convertSelectionCriteria(r.GetSelectionCriteria())
+		if err != nil {
+			return nil, fmt.Errorf("rule %q: %w", r.GetName(), err)
+		}
+
+		rule := entity.SelectionRule{
+			Name:              r.GetName(),
+			Selection:         selection,
+			RequestCountLimit: int(r.GetRequestCountLimit()),
+		}
+		if _, ok := r.GetQuota().(*collector.SelectionRule_Percent); ok {
+			rule.Percent = mo.Some(int(r.GetPercent()))
+		}
+
+		result = append(result, rule)
+	}
+	return result, nil
+}
+
 func (s *Service) convertHeaderCriteria(criteria []*collector.Header) ([]entity.HeaderCriteria, error) {
 	result := make([]entity.HeaderCriteria, 0, len(criteria))
 	for _, c := range criteria {
@@ -70,3 +218,101 @@ func (s *Service) convertHeaderCriteria(criteria []*collector.Header) ([]entity.
 	}
 	return result, nil
 }
+
+// convertHandlerCriteriaToEntity returns handler criteria if the handler isn't matched exactly.
+func convertHandlerCriteriaToEntity(
+	criteria *collector.MessageSelectionCriteria,
+) (mo.Option[entity.HandlerCriteria], error) {
+	mode := entity.HandlerMatchMode(criteria.GetHandlerMatchMode())
+	if mode == entity.HandlerMatchExact && len(criteria.GetHandlers()) == 0 {
+		return mo.None[entity.HandlerCriteria](), nil
+	}
+
+	handlerCriteria, err := entity.NewHandlerCriteria(mode,
+		append([]string{criteria.GetHandler()}, criteria.GetHandlers()...))
+	if err != nil {
+		return mo.None[entity.HandlerCriteria](), err
+	}
+
+	return mo.Some(handlerCriteria), nil
+}
+
+func (s *Service) convertHeaderGroup(group *collector.HeaderGroup) (entity.HeaderGroup, error) {
+	criteria, err := s.convertHeaderCriteria(group.GetHeaders())
+	if err != nil {
+		return entity.HeaderGroup{}, err
+	}
+
+	groups := make([]entity.HeaderGroup, 0, len(group.GetGroups()))
+	for _, g := range group.GetGroups() {
+		nested, err := s.convertHeaderGroup(g)
+		if err != nil {
+			return entity.HeaderGroup{}, err
+		}
+
+		groups = append(groups, nested)
+	}
+
+	return entity.HeaderGroup{
+		Operator: entity.HeaderGroupOperator(group.GetOperator()),
+		Criteria: criteria,
+		Groups:   groups,
+	}, nil
+}
+
+func convertBodyCriteriaToEntity(criteria []*collector.BodyPredicate) ([]entity.BodyCriteria, error) {
+	result := make([]entity.BodyCriteria, 0, len(criteria))
+	for _, c := range criteria {
+		bodyCriteria, err := entity.NewBodyCriteria(c.GetPath(), entity.BodyOperator(c.GetOperator()), c.GetValue())
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, bodyCriteria)
+	}
+	return result, nil
+}
+
+// convertSampleRateToEntity returns the sample rate if only a part of requests is collected.
+func convertSampleRateToEntity(sampleRate float64) mo.Option[float64] {
+	if sampleRate == 0 || sampleRate == 1 {
+		return mo.None[float64]()
+	}
+
+	return mo.Some(sampleRate)
+}
+
+func convertStratificationToEntity(
+	stratification *collector.Stratification,
+) (mo.Option[entity.Stratification], error) {
+	if stratification == nil {
+		return mo.None[entity.Stratification](), nil
+	}
+
+	result := entity.Stratification{
+		HeaderName:        stratification.GetHeaderName(),
+		MaxPerValue:       int(stratification.GetMaxPerValue()),
+		MinDistinctValues: int(stratification.GetMinDistinctValues()),
+	}
+	if stratification.GetBodyPath() != "" {
+		path, err := entity.ParseJSONPath(stratification.GetBodyPath())
+		if err != nil {
+			return mo.None[entity.Stratification](), err
+		}
+		result.BodyPath = mo.Some(path)
+	}
+
+	return mo.Some(result), nil
+}
+
+func convertRetentionToEntity(retention *collector.Retention) entity.RetentionPolicy {
+	var period mo.Option[time.Duration]
+	if retention.GetPeriod() != nil {
+		period = mo.Some(retention.GetPeriod().AsDuration())
+	}
+
+	return entity.RetentionPolicy{
+		Period: period,
+		Pinned: retention.GetPinned(),
+	}
+}
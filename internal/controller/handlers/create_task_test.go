@@ -0,0 +1,101 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/n-r-w/collector/internal/pb/api/collector"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestConvertTaskRequestToEntity_RequestCountLimit(t *testing.T) {
+	t.Parallel()
+
+	s := &Service{maxRequestsPerCollection: 100}
+
+	countRule := func(name string, limit uint32) *collector.SelectionRule {
+		return &collector.SelectionRule{
+			Name:              name,
+			SelectionCriteria: &collector.MessageSelectionCriteria{Handler: "/api/" + name},
+			Quota:             &collector.SelectionRule_RequestCountLimit{RequestCountLimit: limit},
+		}
+	}
+	percentRule := func(name string, percent uint32) *collector.SelectionRule {
+		return &collector.SelectionRule{
+			Name:              name,
+			SelectionCriteria: &collector.MessageSelectionCriteria{Handler: "/api/" + name},
+			Quota:             &collector.SelectionRule_Percent{Percent: percent},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		rules     []*collector.SelectionRule
+		limit     uint32
+		minCount  uint32
+		wantLimit int
+		wantErr   bool
+	}{
+		{
+			name:      "request counts without limit",
+			rules:     []*collector.SelectionRule{countRule("search", 3), countRule("users", 2)},
+			wantLimit: 5,
+		},
+		{
+			name:      "limit is replaced by the sum of the quotas",
+			rules:     []*collector.SelectionRule{countRule("search", 3), percentRule("users", 50)},
+			limit:     10,
+			wantLimit: 8,
+		},
+		{
+			name:    "shares without limit",
+			rules:   []*collector.SelectionRule{countRule("search", 3), percentRule("users", 50)},
+			wantErr: true,
+		},
+		{
+			name:    "no rules and no limit",
+			wantErr: true,
+		},
+		{
+			name:     "min request count is checked against the resolved limit",
+			rules:    []*collector.SelectionRule{percentRule("search", 50), percentRule("users", 45)},
+			limit:    11,
+			minCount: 10,
+			wantErr:  true,
+		},
+		{
+			name:    "sum of the quotas exceeds the maximum",
+			rules:   []*collector.SelectionRule{countRule("search", 60), countRule("users", 41)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := &collector.CreateTaskRequest{
+				Rules: tt.rules,
+				CompletionCriteria: &collector.CompletionCriteria{
+					TimeLimit:         durationpb.New(time.Hour),
+					RequestCountLimit: tt.limit,
+					MinRequestCount:   tt.minCount,
+				},
+			}
+			if len(tt.rules) == 0 {
+				req.SelectionCriteria = &collector.MessageSelectionCriteria{Handler: "/api/search"}
+			}
+			require.NoError(t, req.ValidateAll())
+
+			task, err := s.convertTaskRequestToEntity(req)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.wantLimit, task.Completion.RequestCountLimit)
+		})
+	}
+}
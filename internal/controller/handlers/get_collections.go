@@ -1,10 +1,15 @@
 package handlers
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/n-r-w/collector/internal/entity"
 	"github.com/n-r-w/collector/internal/pb/api/collector"
@@ -12,6 +17,7 @@ import (
 	"github.com/samber/mo"
 	"google.golang.org/grpc/codes"
 	grpc_status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
 
@@ -28,12 +34,27 @@ func (s *Service) GetCollections(
 		return nil, invalidRequestError(err)
 	}
 
+	// all collections are returned if the page size is not set
+	pageSize := int(req.GetPageSize())
+	if pageSize > 0 {
+		// one more collection shows if there is the next page
+		filter.Limit = pageSize + 1
+	}
+
 	collections, err := s.collectionManager.GetCollections(ctx, filter)
 	if err != nil {
 		ctxlog.Error(ctx, "failed to list collections", slog.Any("error", err))
 		return nil, grpc_status.Errorf(codes.Internal, "failed to list collections: %v", err)
 	}
 
+	var nextPageToken string
+	if pageSize > 0 && len(collections) > pageSize {
+		collections = collections[:pageSize]
+		if nextPageToken, err = encodePageToken(req, collections[pageSize-1].Cursor()); err != nil {
+			return nil, grpc_status.Errorf(codes.Internal, "failed to encode page token: %v", err)
+		}
+	}
+
 	protoCollections := make([]*collector.Collection, 0, len(collections))
 	for _, c := range collections {
 		protoCollections = append(protoCollections, convertCollectionFromEntity(c))
@@ -42,10 +63,76 @@ func (s *Service) GetCollections(
 	ctxlog.Debug(ctx, "listed collections", slog.Int("count", len(protoCollections)))
 
 	return &collector.GetCollectionsResponse{
-		Collections: protoCollections,
+		Collections:   protoCollections,
+		NextPageToken: nextPageToken,
 	}, nil
 }
 
+// pageToken is the position of the next page of collections.
+// It is bound to the filters and the order of the request, the page size can be changed between pages.
+type pageToken struct {
+	CreatedAt time.Time `json:"createdAt"`
+	ID        int64     `json:"id"`
+	Filter    []byte    `json:"filter"`
+}
+
+func encodePageToken(req *collector.GetCollectionsRequest, cursor entity.CollectionCursor) (string, error) {
+	filter, err := hashCollectionFilter(req)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.Marshal(pageToken{CreatedAt: cursor.CreatedAt, ID: int64(cursor.ID), Filter: filter})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodePageToken(req *collector.GetCollectionsRequest) (entity.CollectionCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(req.GetPageToken())
+	if err != nil {
+		return entity.CollectionCursor{}, fmt.Errorf("invalid page token: %w", err)
+	}
+
+	var t pageToken
+	if err := json.Unmarshal(data, &t); err != nil {
+		return entity.CollectionCursor{}, fmt.Errorf("invalid page token: %w", err)
+	}
+
+	filter, err := hashCollectionFilter(req)
+	if err != nil {
+		return entity.CollectionCursor{}, err
+	}
+
+	if !bytes.Equal(t.Filter, filter) {
+		return entity.CollectionCursor{}, errors.New("page token doesn't match the filters or the order of the request")
+	}
+
+	return entity.CollectionCursor{CreatedAt: t.CreatedAt, ID: entity.CollectionID(t.ID)}, nil
+}
+
+// hashCollectionFilter returns the hash of the filters and the order of the request.
+func hashCollectionFilter(req *collector.GetCollectionsRequest) ([]byte, error) {
+	filter, _ := proto.Clone(req).(*collector.GetCollectionsRequest)
+	filter.PageSize = 0
+	filter.PageToken = ""
+	// the default order is the same as newest first
+	if filter.GetOrder() == collector.CollectionOrder_COLLECTION_ORDER_UNSPECIFIED {
+		filter.Order = collector.CollectionOrder_COLLECTION_ORDER_NEWEST_FIRST
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal filter: %w", err)
+	}
+
+	hash := sha256.Sum256(data)
+
+	return hash[:], nil
+}
+
 func convertCollectionStatusFromEntity(status entity.CollectionStatus) collector.Status {
 	switch status {
 	case entity.StatusPending:
@@ -60,6 +147,8 @@ func convertCollectionStatusFromEntity(status entity.CollectionStatus) collector
 		return collector.Status_STATUS_FAILED
 	case entity.StatusCancelled:
 		return collector.Status_STATUS_CANCELLED
+	case entity.StatusPaused:
+		return collector.Status_STATUS_PAUSED
 	case entity.StatusUnknown:
 		return collector.Status_STATUS_UNSPECIFIED
 	}
@@ -69,14 +158,22 @@ func convertCollectionStatusFromEntity(status entity.CollectionStatus) collector
 
 func convertCollectionFromEntity(collection entity.Collection) *collector.Collection {
 	protoStatus := &collector.Collection{ //exhaustruct:enforce
-		CollectionId: int64(collection.ID),
-		Status:       convertCollectionStatusFromEntity(collection.Status),
-		StartedAt:    timeToProtoPtr(collection.StartedAt.ToPointer()),
-		CompletedAt:  timeToProtoPtr(collection.CompletedAt.ToPointer()),
-		ErrorMessage: collection.ErrorMessage.OrEmpty(),
-		RequestCount: uint64(collection.RequestCount), //nolint:gosec // ok
-		Task:         convertTaskFromEntity(collection.Task),
-		ResultId:     string(collection.ResultID.OrEmpty()),
+		CollectionId:   int64(collection.ID),
+		Status:         convertCollectionStatusFromEntity(collection.Status),
+		StartedAt:      timeToProtoPtr(collection.StartedAt.ToPointer()),
+		CompletedAt:    timeToProtoPtr(collection.CompletedAt.ToPointer()),
+		ErrorMessage:   collection.ErrorMessage.OrEmpty(),
+		ErrorCode:      uint32(collection.ErrorCode.OrEmpty()), //nolint:gosec // ok
+		RequestCount:   uint64(collection.RequestCount),        //nolint:gosec // ok
+		DuplicateCount: uint64(collection.DuplicateCount),      //nolint:gosec // ok
+		SeenCount:      uint64(collection.SeenCount),           //nolint:gosec // ok
+		ByteCount:      uint64(collection.ByteCount),           //nolint:gosec // ok
+		LastRequestAt:  timeToProtoPtr(collection.LastRequestAt.ToPointer()),
+		PausedAt:       timeToProtoPtr(collection.Pause.PausedAt.ToPointer()),
+		FrozenDuration: durationpb.New(collection.Pause.Frozen()),
+		Task:           convertTaskFromEntity(collection.Task),
+		ResultId:       string(collection.ResultID.OrEmpty()),
+		Retention:      convertRetentionFromEntity(collection.Task.Retention),
 	}
 
 	return protoStatus
@@ -86,16 +183,85 @@ func convertTaskFromEntity(task entity.Task) *collector.Task {
 	return &collector.Task{ //exhaustruct:enforce
 		MessageSelection: convertMessageSelectionCriteriaFromEntity(task.MessageSelection),
 		Completion:       convertCompletionCriteriaFromEntity(task.Completion),
+		Expression:       task.MessageSelection.Expression.Source,
+		Rules:            convertRulesFromEntity(task.Rules),
+		Stratification:   convertStratificationFromEntity(task.Stratification),
+		StartAt:          timeToProtoPtr(task.StartAt.ToPointer()),
+		Name:             task.Metadata.Name,
+		Description:      task.Metadata.Description,
+		Owner:            task.Metadata.Owner,
+		Labels:           task.Metadata.Labels,
+	}
+}
+
+func convertStratificationFromEntity(stratification mo.Option[entity.Stratification]) *collector.Stratification {
+	s, ok := stratification.Get()
+	if !ok {
+		return nil
+	}
+
+	result := &collector.Stratification{
+		Key:               &collector.Stratification_HeaderName{HeaderName: s.HeaderName},
+		MaxPerValue:       uint32(s.MaxPerValue),       //nolint:gosec // checked on creation
+		MinDistinctValues: uint32(s.MinDistinctValues), //nolint:gosec // checked on creation
+	}
+	if path, ok := s.BodyPath.Get(); ok {
+		result.Key = &collector.Stratification_BodyPath{BodyPath: path.String()}
+	}
+
+	return result
+}
+
+func convertRulesFromEntity(rules []entity.SelectionRule) []*collector.SelectionRule {
+	result := make([]*collector.SelectionRule, 0, len(rules))
+	for _, r := range rules {
+		rule := &collector.SelectionRule{
+			Name:              r.Name,
+			SelectionCriteria: convertMessageSelectionCriteriaFromEntity(r.Selection),
+			Quota: &collector.SelectionRule_RequestCountLimit{
+				RequestCountLimit: uint32(r.RequestCountLimit), //nolint:gosec // checked on creation
+			},
+		}
+		if percent, ok := r.Percent.Get(); ok {
+			rule.Quota = &collector.SelectionRule_Percent{Percent: uint32(percent)} //nolint:gosec // checked on creation
+		}
+
+		result = append(result, rule)
 	}
+	return result
 }
 
 func convertMessageSelectionCriteriaFromEntity(
 	criteria entity.MessageSelectionCriteria,
 ) *collector.MessageSelectionCriteria {
 	return &collector.MessageSelectionCriteria{ //exhaustruct:enforce
-		Handler:        criteria.Handler,
-		HeaderCriteria: convertHeaderCriteriaFromEntity(criteria.HeaderCriteria),
+		Handler:              criteria.Handler,
+		HandlerMatchMode:     convertHandlerMatchModeFromEntity(criteria.HandlerCriteria),
+		Handlers:             convertHandlersFromEntity(criteria.HandlerCriteria),
+		HeaderCriteria:       convertHeaderCriteriaFromEntity(criteria.HeaderCriteria),
+		HeaderGroup:          convertHeaderGroupFromEntity(criteria.HeaderGroup),
+		BodyCriteria:         convertBodyCriteriaFromEntity(criteria.BodyCriteria),
+		DistinctBodies:       criteria.DistinctBodies,
+		SampleRate:           criteria.SampleRate.OrEmpty(),
+		MaxRequestsPerSecond: uint32(criteria.MaxRequestsPerSecond), //nolint:gosec // checked on creation
+	}
+}
+
+func convertHandlerMatchModeFromEntity(criteria mo.Option[entity.HandlerCriteria]) collector.HandlerMatchMode {
+	if hc, ok := criteria.Get(); ok {
+		return collector.HandlerMatchMode(hc.Mode)
 	}
+
+	return collector.HandlerMatchMode_HANDLER_MATCH_MODE_EXACT
+}
+
+// convertHandlersFromEntity returns handlers except the first one, that is returned as handler.
+func convertHandlersFromEntity(criteria mo.Option[entity.HandlerCriteria]) []string {
+	if hc, ok := criteria.Get(); ok && len(hc.Patterns) > 1 {
+		return hc.Patterns[1:]
+	}
+
+	return nil
 }
 
 func convertHeaderCriteriaFromEntity(criteria []entity.HeaderCriteria) []*collector.Header {
@@ -110,10 +276,67 @@ func convertHeaderCriteriaFromEntity(criteria []entity.HeaderCriteria) []*collec
 	return result
 }
 
+func convertHeaderGroupFromEntity(group mo.Option[entity.HeaderGroup]) *collector.HeaderGroup {
+	g, ok := group.Get()
+	if !ok {
+		return nil
+	}
+
+	groups := make([]*collector.HeaderGroup, 0, len(g.Groups))
+	for _, nested := range g.Groups {
+		groups = append(groups, convertHeaderGroupFromEntity(mo.Some(nested)))
+	}
+
+	return &collector.HeaderGroup{ //exhaustruct:enforce
+		Operator: collector.HeaderGroupOperator(g.Operator),
+		Headers:  convertHeaderCriteriaFromEntity(g.Criteria),
+		Groups:   groups,
+	}
+}
+
+func convertBodyCriteriaFromEntity(criteria []entity.BodyCriteria) []*collector.BodyPredicate {
+	result := make([]*collector.BodyPredicate, 0, len(criteria))
+	for _, c := range criteria {
+		result = append(result, &collector.BodyPredicate{ //exhaustruct:enforce
+			Path:     c.Path.String(),
+			Operator: collector.BodyOperator(c.Operator),
+			Value:    c.Value,
+		})
+	}
+
+	return result
+}
+
+func convertRetentionFromEntity(retention entity.RetentionPolicy) *collector.Retention {
+	var period *durationpb.Duration
+	if p, ok := retention.Period.Get(); ok {
+		period = durationpb.New(p)
+	}
+
+	return &collector.Retention{ //exhaustruct:enforce
+		Period: period,
+		Pinned: retention.Pinned,
+	}
+}
+
 func convertCompletionCriteriaFromEntity(criteria entity.CompletionCriteria) *collector.CompletionCriteria {
+	var idleTimeout, maxWait *durationpb.Duration
+	if criteria.IdleTimeout > 0 {
+		idleTimeout = durationpb.New(criteria.IdleTimeout)
+	}
+	if criteria.MaxWait > 0 {
+		maxWait = durationpb.New(criteria.MaxWait)
+	}
+
 	return &collector.CompletionCriteria{ //exhaustruct:enforce
-		TimeLimit:         durationpb.New(criteria.TimeLimit),
-		RequestCountLimit: uint32(criteria.RequestCountLimit), //nolint:gosec // ok
+		TimeLimit:                 durationpb.New(criteria.TimeLimit),
+		RequestCountLimit:         uint32(criteria.RequestCountLimit), //nolint:gosec // ok
+		Reservoir:                 criteria.Reservoir,
+		IdleTimeout:               idleTimeout,
+		ByteSizeLimit:             uint64(criteria.ByteSizeLimit),   //nolint:gosec // checked on creation
+		MinRequestCount:           uint32(criteria.MinRequestCount), //nolint:gosec // checked on creation
+		TimeLimitFromFirstRequest: criteria.TimeLimitFromFirstRequest,
+		MaxWait:                   maxWait,
 	}
 }
 
@@ -123,10 +346,46 @@ func convertCollectionFilterToEntity(req *collector.GetCollectionsRequest) (enti
 		return entity.CollectionFilter{}, err
 	}
 
+	labels, err := entity.ParseLabelSelector(req.GetLabelSelector())
+	if err != nil {
+		return entity.CollectionFilter{}, err
+	}
+
+	var owner mo.Option[string]
+	if req.GetOwner() != "" {
+		owner = mo.Some(req.GetOwner())
+	}
+
+	var handler mo.Option[string]
+	if req.GetHandler() != "" {
+		handler = mo.Some(req.GetHandler())
+	}
+
+	var after mo.Option[entity.CollectionCursor]
+	if req.GetPageToken() != "" {
+		cursor, err := decodePageToken(req)
+		if err != nil {
+			return entity.CollectionFilter{}, err
+		}
+		after = mo.Some(cursor)
+	}
+
+	order := entity.CollectionOrderNewestFirst
+	if req.GetOrder() == collector.CollectionOrder_COLLECTION_ORDER_OLDEST_FIRST {
+		order = entity.CollectionOrderOldestFirst
+	}
+
 	return entity.CollectionFilter{
-		Statuses: statuses,
-		FromTime: mo.PointerToOption(timeFromProto(req.GetFromTime())),
-		ToTime:   mo.PointerToOption(timeFromProto(req.GetToTime())),
+		Statuses:      statuses,
+		FromTime:      mo.PointerToOption(timeFromProto(req.GetFromTime())),
+		ToTime:        mo.PointerToOption(timeFromProto(req.GetToTime())),
+		Owner:         owner,
+		Labels:        labels,
+		Handler:       handler,
+		CompletedFrom: mo.PointerToOption(timeFromProto(req.GetCompletedFrom())),
+		CompletedTo:   mo.PointerToOption(timeFromProto(req.GetCompletedTo())),
+		Order:         order,
+		After:         after,
 	}, nil
 }
 
@@ -144,6 +403,8 @@ func convertCollectionStatusToEntity(status collector.Status) (entity.Collection
 		return entity.StatusFailed, nil
 	case collector.Status_STATUS_CANCELLED:
 		return entity.StatusCancelled, nil
+	case collector.Status_STATUS_PAUSED:
+		return entity.StatusPaused, nil
 	case collector.Status_STATUS_UNSPECIFIED:
 		return entity.StatusUnknown, errors.New("status is unspecified")
 	default:
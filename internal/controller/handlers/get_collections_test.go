@@ -0,0 +1,355 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/collector/internal/pb/api/collector"
+	"github.com/n-r-w/ctxlog"
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	grpc_status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestConvertCollectionFilterToEntity(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		req     *collector.GetCollectionsRequest
+		want    entity.CollectionFilter
+		wantErr bool
+	}{
+		{
+			name: "empty request",
+			req:  &collector.GetCollectionsRequest{},
+			want: entity.CollectionFilter{
+				Statuses: []entity.CollectionStatus{},
+				Order:    entity.CollectionOrderNewestFirst,
+			},
+		},
+		{
+			name: "all filters",
+			req: &collector.GetCollectionsRequest{
+				Statuses:      []collector.Status{collector.Status_STATUS_PAUSED, collector.Status_STATUS_COMPLETED},
+				FromTime:      timestamppb.New(from),
+				ToTime:        timestamppb.New(to),
+				LabelSelector: "team=search,!draft",
+				Owner:         "owner",
+				Handler:       "/api/search",
+				CompletedFrom: timestamppb.New(from),
+				CompletedTo:   timestamppb.New(to),
+				Order:         collector.CollectionOrder_COLLECTION_ORDER_OLDEST_FIRST,
+				PageSize:      10,
+			},
+			want: entity.CollectionFilter{
+				Statuses: []entity.CollectionStatus{entity.StatusPaused, entity.StatusCompleted},
+				FromTime: mo.Some(from),
+				ToTime:   mo.Some(to),
+				Owner:    mo.Some("owner"),
+				Labels: []entity.LabelRequirement{
+					{Key: "team", Operator: entity.LabelEquals, Value: "search"},
+					{Key: "draft", Operator: entity.LabelNotExists},
+				},
+				Handler:       mo.Some("/api/search"),
+				CompletedFrom: mo.Some(from),
+				CompletedTo:   mo.Some(to),
+				Order:         entity.CollectionOrderOldestFirst,
+			},
+		},
+		{
+			name:    "unspecified status",
+			req:     &collector.GetCollectionsRequest{Statuses: []collector.Status{collector.Status_STATUS_UNSPECIFIED}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid label selector",
+			req:     &collector.GetCollectionsRequest{LabelSelector: "=search"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid page token",
+			req:     &collector.GetCollectionsRequest{PageToken: "not a token"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter, err := convertCollectionFilterToEntity(tt.req)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, filter)
+		})
+	}
+}
+
+func TestPageToken(t *testing.T) {
+	t.Parallel()
+
+	cursor := entity.CollectionCursor{
+		CreatedAt: time.Date(2026, 1, 1, 12, 0, 0, 123, time.UTC),
+		ID:        42,
+	}
+
+	tests := []struct {
+		name    string
+		encode  *collector.GetCollectionsRequest
+		decode  *collector.GetCollectionsRequest
+		wantErr bool
+	}{
+		{
+			name:   "same filters",
+			encode: &collector.GetCollectionsRequest{Owner: "owner", PageSize: 10},
+			decode: &collector.GetCollectionsRequest{Owner: "owner", PageSize: 10},
+		},
+		{
+			name:   "page size is changed",
+			encode: &collector.GetCollectionsRequest{Owner: "owner", PageSize: 10},
+			decode: &collector.GetCollectionsRequest{Owner: "owner", PageSize: 20},
+		},
+		{
+			name:   "unspecified order is newest first",
+			encode: &collector.GetCollectionsRequest{PageSize: 10},
+			decode: &collector.GetCollectionsRequest{
+				PageSize: 10,
+				Order:    collector.CollectionOrder_COLLECTION_ORDER_NEWEST_FIRST,
+			},
+		},
+		{
+			name:    "filters are changed",
+			encode:  &collector.GetCollectionsRequest{Owner: "owner", PageSize: 10},
+			decode:  &collector.GetCollectionsRequest{Owner: "other", PageSize: 10},
+			wantErr: true,
+		},
+		{
+			name:   "order is changed",
+			encode: &collector.GetCollectionsRequest{PageSize: 10},
+			decode: &collector.GetCollectionsRequest{
+				PageSize: 10,
+				Order:    collector.CollectionOrder_COLLECTION_ORDER_OLDEST_FIRST,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			token, err := encodePageToken(tt.encode, cursor)
+			require.NoError(t, err)
+			require.NotEmpty(t, token)
+
+			tt.decode.PageToken = token
+			decoded, err := decodePageToken(tt.decode)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.True(t, cursor.CreatedAt.Equal(decoded.CreatedAt))
+			require.Equal(t, cursor.ID, decoded.ID)
+		})
+	}
+}
+
+func TestGetCollections(t *testing.T) {
+	t.Parallel()
+
+	ctx := ctxlog.MustContext(context.Background(), ctxlog.WithTesting(t))
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	collections := make([]entity.Collection, 0, 3)
+	for i := range 3 {
+		collections = append(collections, entity.Collection{
+			ID:        entity.CollectionID(3 - i),
+			Status:    entity.StatusInProgress,
+			CreatedAt: now.Add(-time.Duration(i) * time.Minute),
+		})
+	}
+
+	// the time range is required by the request validation
+	newRequest := func() *collector.GetCollectionsRequest {
+		return &collector.GetCollectionsRequest{
+			FromTime: timestamppb.New(now.Add(-time.Hour)),
+			ToTime:   timestamppb.New(now),
+			Owner:    "owner",
+		}
+	}
+
+	t.Run("all collections without page size", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		manager := NewMockICollectionManager(ctrl)
+		s := &Service{collectionManager: manager}
+
+		manager.EXPECT().GetCollections(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, filter entity.CollectionFilter) ([]entity.Collection, error) {
+				require.Zero(t, filter.Limit)
+				return collections, nil
+			})
+
+		resp, err := s.GetCollections(ctx, newRequest())
+		require.NoError(t, err)
+		require.Len(t, resp.GetCollections(), 3)
+		require.Empty(t, resp.GetNextPageToken())
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		manager := NewMockICollectionManager(ctrl)
+		s := &Service{collectionManager: manager}
+
+		req := newRequest()
+		req.PageSize = 2
+
+		manager.EXPECT().GetCollections(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, filter entity.CollectionFilter) ([]entity.Collection, error) {
+				require.Equal(t, 3, filter.Limit)
+				require.True(t, filter.After.IsAbsent())
+				return collections, nil
+			})
+
+		resp, err := s.GetCollections(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, resp.GetCollections(), 2)
+		require.Equal(t, int64(2), resp.GetCollections()[1].GetCollectionId())
+		require.NotEmpty(t, resp.GetNextPageToken())
+
+		manager.EXPECT().GetCollections(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, filter entity.CollectionFilter) ([]entity.Collection, error) {
+				require.Equal(t, 3, filter.Limit)
+				require.Equal(t, mo.Some(collections[1].Cursor()), filter.After)
+				return collections[2:], nil
+			})
+
+		req.PageToken = resp.GetNextPageToken()
+		resp, err = s.GetCollections(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, resp.GetCollections(), 1)
+		require.Equal(t, int64(1), resp.GetCollections()[0].GetCollectionId())
+		require.Empty(t, resp.GetNextPageToken())
+	})
+
+	t.Run("page token of other filters", func(t *testing.T) {
+		t.Parallel()
+
+		ctrl := gomock.NewController(t)
+		s := &Service{collectionManager: NewMockICollectionManager(ctrl)}
+
+		token, err := encodePageToken(newRequest(), collections[0].Cursor())
+		require.NoError(t, err)
+
+		req := newRequest()
+		req.Owner = "other"
+		req.PageSize = 2
+		req.PageToken = token
+		_, err = s.GetCollections(ctx, req)
+		require.Equal(t, codes.InvalidArgument, grpc_status.Code(err))
+	})
+}
+
+func TestConvertCollectionFromEntity(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	collection := entity.Collection{
+		ID:             7,
+		Status:         entity.StatusFailed,
+		RequestCount:   10,
+		DuplicateCount: 2,
+		SeenCount:      15,
+		ByteCount:      1024,
+		CreatedAt:      now,
+		StartedAt:      mo.Some(now),
+		CompletedAt:    mo.Some(now.Add(time.Hour)),
+		ResultID:       mo.Some(entity.ResultID("result")),
+		ErrorMessage:   mo.Some("failed"),
+		ErrorCode:      mo.Some(entity.ErrorCodeNotEnoughRequests),
+		Task: entity.Task{
+			MessageSelection: entity.MessageSelectionCriteria{
+				Handler: "/api/search",
+				HandlerCriteria: mo.Some(entity.HandlerCriteria{
+					Mode:     entity.HandlerMatchPrefix,
+					Patterns: []string{"/api/search", "/api/users"},
+				}),
+			},
+			Completion: entity.CompletionCriteria{
+				TimeLimit:         time.Hour,
+				RequestCountLimit: 10,
+				MinRequestCount:   5,
+			},
+			Retention: entity.RetentionPolicy{Period: mo.Some(24 * time.Hour), Pinned: true},
+			Metadata:  entity.Metadata{Name: "name", Owner: "owner", Labels: map[string]string{"team": "search"}},
+		},
+	}
+
+	c := convertCollectionFromEntity(collection)
+
+	require.Equal(t, int64(7), c.GetCollectionId())
+	require.Equal(t, collector.Status_STATUS_FAILED, c.GetStatus())
+	require.Equal(t, uint64(10), c.GetRequestCount())
+	require.Equal(t, uint64(2), c.GetDuplicateCount())
+	require.Equal(t, uint64(15), c.GetSeenCount())
+	require.Equal(t, uint64(1024), c.GetByteCount())
+	require.Equal(t, now, c.GetStartedAt().AsTime())
+	require.Equal(t, now.Add(time.Hour), c.GetCompletedAt().AsTime())
+	require.Nil(t, c.GetPausedAt())
+	require.Equal(t, "result", c.GetResultId())
+	require.Equal(t, "failed", c.GetErrorMessage())
+	require.Equal(t, uint32(entity.ErrorCodeNotEnoughRequests), c.GetErrorCode())
+
+	task := c.GetTask()
+	require.Equal(t, "/api/search", task.GetMessageSelection().GetHandler())
+	require.Equal(t, collector.HandlerMatchMode_HANDLER_MATCH_MODE_PREFIX,
+		task.GetMessageSelection().GetHandlerMatchMode())
+	require.Equal(t, []string{"/api/users"}, task.GetMessageSelection().GetHandlers())
+	require.Equal(t, time.Hour, task.GetCompletion().GetTimeLimit().AsDuration())
+	require.Equal(t, uint32(10), task.GetCompletion().GetRequestCountLimit())
+	require.Equal(t, uint32(5), task.GetCompletion().GetMinRequestCount())
+	require.Nil(t, task.GetCompletion().GetIdleTimeout())
+	require.Equal(t, "name", task.GetName())
+	require.Equal(t, "owner", task.GetOwner())
+	require.Equal(t, map[string]string{"team": "search"}, task.GetLabels())
+
+	require.Equal(t, 24*time.Hour, c.GetRetention().GetPeriod().AsDuration())
+	require.True(t, c.GetRetention().GetPinned())
+}
+
+func TestConvertCollectionStatus(t *testing.T) {
+	t.Parallel()
+
+	for _, status := range collector.Status_value {
+		s := collector.Status(status)
+		if s == collector.Status_STATUS_UNSPECIFIED {
+			continue
+		}
+
+		converted, err := convertCollectionStatusToEntity(s)
+		require.NoError(t, err)
+		require.Equal(t, s, convertCollectionStatusFromEntity(converted))
+	}
+
+	_, err := convertCollectionStatusToEntity(collector.Status(100))
+	require.Error(t, err)
+}
@@ -6,6 +6,8 @@ import (
 	"github.com/n-r-w/collector/internal/entity"
 )
 
+//go:generate mockgen -source interfaces.go -destination interfaces_mock.go -package handlers
+
 // ICollectionManager is responsible for managing collections.
 type ICollectionManager interface {
 	// Collection creates a new collection with the given parameters and returns its ID.
@@ -16,6 +18,27 @@ type ICollectionManager interface {
 	GetCollection(ctx context.Context, id entity.CollectionID) (entity.Collection, error)
 	// CancelCollection terminates an active collection.
 	CancelCollection(ctx context.Context, id entity.CollectionID) error
+	// CompleteCollection stops collecting and finalizes the collection with the requests collected so far.
+	CompleteCollection(ctx context.Context, id entity.CollectionID) error
+	// PauseCollection stops collecting until the collection is resumed.
+	// If freezeTimeLimit is set, the time limit is not counted during the pause.
+	PauseCollection(ctx context.Context, id entity.CollectionID, freezeTimeLimit bool) error
+	// ResumeCollection continues collecting of a paused collection.
+	ResumeCollection(ctx context.Context, id entity.CollectionID) error
+	// UpdateCollection changes limits and selection criteria of a collecting collection.
+	UpdateCollection(ctx context.Context, id entity.CollectionID, update entity.CollectionUpdate) error
+	// UpdateRetention changes how long a collection is kept before cleanup.
+	UpdateRetention(ctx context.Context, id entity.CollectionID, retention entity.RetentionPolicy) error
+}
+
+// IScheduleManager is responsible for managing schedules of collections.
+type IScheduleManager interface {
+	// CreateSchedule creates a schedule of collections and returns its ID.
+	CreateSchedule(ctx context.Context, schedule entity.Schedule) (entity.ScheduleID, error)
+	// GetSchedules returns all schedules.
+	GetSchedules(ctx context.Context) ([]entity.Schedule, error)
+	// DeleteSchedule deletes a schedule. Collections created by it are kept.
+	DeleteSchedule(ctx context.Context, id entity.ScheduleID) error
 }
 
 // IResultGetter is responsible for retrieving collection results by chunks.
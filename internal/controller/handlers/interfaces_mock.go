@@ -0,0 +1,275 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interfaces.go
+//
+// Generated by this command:
+//
+//	mockgen -source interfaces.go -destination interfaces_mock.go -package handlers
+//
+
+// Package handlers is a generated GoMock package.
+package handlers
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/n-r-w/collector/internal/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockICollectionManager is a mock of ICollectionManager interface.
+type MockICollectionManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockICollectionManagerMockRecorder
+}
+
+// MockICollectionManagerMockRecorder is the mock recorder for MockICollectionManager.
+type MockICollectionManagerMockRecorder struct {
+	mock *MockICollectionManager
+}
+
+// NewMockICollectionManager creates a new mock instance.
+func NewMockICollectionManager(ctrl *gomock.Controller) *MockICollectionManager {
+	mock := &MockICollectionManager{ctrl: ctrl}
+	mock.recorder = &MockICollectionManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICollectionManager) EXPECT() *MockICollectionManagerMockRecorder {
+	return m.recorder
+}
+
+// CancelCollection mocks base method.
+func (m *MockICollectionManager) CancelCollection(ctx context.Context, id entity.CollectionID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelCollection", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelCollection indicates an expected call of CancelCollection.
+func (mr *MockICollectionManagerMockRecorder) CancelCollection(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelCollection", reflect.TypeOf((*MockICollectionManager)(nil).CancelCollection), ctx, id)
+}
+
+// CompleteCollection mocks base method.
+func (m *MockICollectionManager) CompleteCollection(ctx context.Context, id entity.CollectionID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteCollection", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteCollection indicates an expected call of CompleteCollection.
+func (mr *MockICollectionManagerMockRecorder) CompleteCollection(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteCollection", reflect.TypeOf((*MockICollectionManager)(nil).CompleteCollection), ctx, id)
+}
+
+// CreateCollection mocks base method.
+func (m *MockICollectionManager) CreateCollection(ctx context.Context, task entity.Task) (entity.CollectionID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCollection", ctx, task)
+	ret0, _ := ret[0].(entity.CollectionID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCollection indicates an expected call of CreateCollection.
+func (mr *MockICollectionManagerMockRecorder) CreateCollection(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCollection", reflect.TypeOf((*MockICollectionManager)(nil).CreateCollection), ctx, task)
+}
+
+// GetCollection mocks base method.
+func (m *MockICollectionManager) GetCollection(ctx context.Context, id entity.CollectionID) (entity.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollection", ctx, id)
+	ret0, _ := ret[0].(entity.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollection indicates an expected call of GetCollection.
+func (mr *MockICollectionManagerMockRecorder) GetCollection(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollection", reflect.TypeOf((*MockICollectionManager)(nil).GetCollection), ctx, id)
+}
+
+// GetCollections mocks base method.
+func (m *MockICollectionManager) GetCollections(ctx context.Context, filter entity.CollectionFilter) ([]entity.Collection, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCollections", ctx, filter)
+	ret0, _ := ret[0].([]entity.Collection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCollections indicates an expected call of GetCollections.
+func (mr *MockICollectionManagerMockRecorder) GetCollections(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollections", reflect.TypeOf((*MockICollectionManager)(nil).GetCollections), ctx, filter)
+}
+
+// PauseCollection mocks base method.
+func (m *MockICollectionManager) PauseCollection(ctx context.Context, id entity.CollectionID, freezeTimeLimit bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseCollection", ctx, id, freezeTimeLimit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseCollection indicates an expected call of PauseCollection.
+func (mr *MockICollectionManagerMockRecorder) PauseCollection(ctx, id, freezeTimeLimit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseCollection", reflect.TypeOf((*MockICollectionManager)(nil).PauseCollection), ctx, id, freezeTimeLimit)
+}
+
+// ResumeCollection mocks base method.
+func (m *MockICollectionManager) ResumeCollection(ctx context.Context, id entity.CollectionID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeCollection", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeCollection indicates an expected call of ResumeCollection.
+func (mr *MockICollectionManagerMockRecorder) ResumeCollection(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeCollection", reflect.TypeOf((*MockICollectionManager)(nil).ResumeCollection), ctx, id)
+}
+
+// UpdateCollection mocks base method.
+func (m *MockICollectionManager) UpdateCollection(ctx context.Context, id entity.CollectionID, update entity.CollectionUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCollection", ctx, id, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCollection indicates an expected call of UpdateCollection.
+func (mr *MockICollectionManagerMockRecorder) UpdateCollection(ctx, id, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCollection", reflect.TypeOf((*MockICollectionManager)(nil).UpdateCollection), ctx, id, update)
+}
+
+// UpdateRetention mocks base method.
+func (m *MockICollectionManager) UpdateRetention(ctx context.Context, id entity.CollectionID, retention entity.RetentionPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRetention", ctx, id, retention)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRetention indicates an expected call of UpdateRetention.
+func (mr *MockICollectionManagerMockRecorder) UpdateRetention(ctx, id, retention any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRetention", reflect.TypeOf((*MockICollectionManager)(nil).UpdateRetention), ctx, id, retention)
+}
+
+// MockIScheduleManager is a mock of IScheduleManager interface.
+type MockIScheduleManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockIScheduleManagerMockRecorder
+}
+
+// MockIScheduleManagerMockRecorder is the mock recorder for MockIScheduleManager.
+type MockIScheduleManagerMockRecorder struct {
+	mock *MockIScheduleManager
+}
+
+// NewMockIScheduleManager creates a new mock instance.
+func NewMockIScheduleManager(ctrl *gomock.Controller) *MockIScheduleManager {
+	mock := &MockIScheduleManager{ctrl: ctrl}
+	mock.recorder = &MockIScheduleManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIScheduleManager) EXPECT() *MockIScheduleManagerMockRecorder {
+	return m.recorder
+}
+
+// CreateSchedule mocks base method.
+func (m *MockIScheduleManager) CreateSchedule(ctx context.Context, schedule entity.Schedule) (entity.ScheduleID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedule", ctx, schedule)
+	ret0, _ := ret[0].(entity.ScheduleID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *MockIScheduleManagerMockRecorder) CreateSchedule(ctx, schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockIScheduleManager)(nil).CreateSchedule), ctx, schedule)
+}
+
+// DeleteSchedule mocks base method.
+func (m *MockIScheduleManager) DeleteSchedule(ctx context.Context, id entity.ScheduleID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchedule", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSchedule indicates an expected call of DeleteSchedule.
+func (mr *MockIScheduleManagerMockRecorder) DeleteSchedule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*MockIScheduleManager)(nil).DeleteSchedule), ctx, id)
+}
+
+// GetSchedules mocks base method.
+func (m *MockIScheduleManager) GetSchedules(ctx context.Context) ([]entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedules", ctx)
+	ret0, _ := ret[0].([]entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedules indicates an expected call of GetSchedules.
+func (mr *MockIScheduleManagerMockRecorder) GetSchedules(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedules", reflect.TypeOf((*MockIScheduleManager)(nil).GetSchedules), ctx)
+}
+
+// MockIResultGetter is a mock of IResultGetter interface.
+type MockIResultGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIResultGetterMockRecorder
+}
+
+// MockIResultGetterMockRecorder is the mock recorder for MockIResultGetter.
+type MockIResultGetterMockRecorder struct {
+	mock *MockIResultGetter
+}
+
+// NewMockIResultGetter creates a new mock instance.
+func NewMockIResultGetter(ctrl *gomock.Controller) *MockIResultGetter {
+	mock := &MockIResultGetter{ctrl: ctrl}
+	mock.recorder = &MockIResultGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIResultGetter) EXPECT() *MockIResultGetterMockRecorder {
+	return m.recorder
+}
+
+// GetResult mocks base method.
+func (m *MockIResultGetter) GetResult(ctx context.Context, collectionID entity.CollectionID) (<-chan entity.RequestChunk, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetResult", ctx, collectionID)
+	ret0, _ := ret[0].(<-chan entity.RequestChunk)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetResult indicates an expected call of GetResult.
+func (mr *MockIResultGetterMockRecorder) GetResult(ctx, collectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetResult", reflect.TypeOf((*MockIResultGetter)(nil).GetResult), ctx, collectionID)
+}
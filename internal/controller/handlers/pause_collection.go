@@ -0,0 +1,49 @@
+package handlers
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/collector/internal/pb/api/collector"
+	"github.com/n-r-w/ctxlog"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// PauseCollection implements collector.CollectionServiceServer.
+func (s *Service) PauseCollection(
+	ctx context.Context, req *collector.PauseCollectionRequest,
+) (*emptypb.Empty, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, invalidRequestError(err)
+	}
+
+	id := entity.CollectionID(req.GetCollectionId())
+
+	if err := s.collectionManager.PauseCollection(ctx, id, req.GetFreezeTimeLimit()); err != nil {
+		return nil, changeStatusError(ctx, id, "pause", err)
+	}
+
+	ctxlog.Debug(ctx, "collection paused", slog.String("collection_id", id.String()))
+
+	return &emptypb.Empty{}, nil
+}
+
+// ResumeCollection implements collector.CollectionServiceServer.
+func (s *Service) ResumeCollection(
+	ctx context.Context, req *collector.ResumeCollectionRequest,
+) (*emptypb.Empty, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, invalidRequestError(err)
+	}
+
+	id := entity.CollectionID(req.GetCollectionId())
+
+	if err := s.collectionManager.ResumeCollection(ctx, id); err != nil {
+		return nil, changeStatusError(ctx, id, "resume", err)
+	}
+
+	ctxlog.Debug(ctx, "collection resumed", slog.String("collection_id", id.String()))
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/collector/internal/pb/api/collector"
+	"github.com/n-r-w/ctxlog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateSchedule implements collector.CollectionServiceServer.
+func (s *Service) CreateSchedule(
+	ctx context.Context, req *collector.CreateScheduleRequest,
+) (*collector.CreateScheduleResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, invalidRequestError(err)
+	}
+
+	cron, err := entity.ParseCronExpression(req.GetCronExpression())
+	if err != nil {
+		return nil, invalidRequestError(err)
+	}
+
+	task, err := s.convertTaskRequestToEntity(req.GetTask())
+	if err != nil {
+		return nil, invalidRequestError(err)
+	}
+
+	scheduleID, err := s.scheduleManager.CreateSchedule(ctx, entity.Schedule{
+		Cron: cron,
+		Task: task,
+	})
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidSchedule) || errors.Is(err, entity.ErrInvalidRetention) {
+			return nil, invalidRequestError(err)
+		}
+
+		ctxlog.Error(ctx, "failed to create schedule", slog.Any("error", err))
+		return nil, status.Errorf(codes.Internal, "failed to create schedule: %v", err)
+	}
+
+	ctxlog.Debug(ctx, "schedule created", slog.String("schedule_id", scheduleID.String()))
+
+	return &collector.CreateScheduleResponse{
+		ScheduleId: int64(scheduleID),
+	}, nil
+}
+
+// GetSchedules implements collector.CollectionServiceServer.
+func (s *Service) GetSchedules(
+	ctx context.Context, req *collector.GetSchedulesRequest,
+) (*collector.GetSchedulesResponse, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, invalidRequestError(err)
+	}
+
+	schedules, err := s.scheduleManager.GetSchedules(ctx)
+	if err != nil {
+		ctxlog.Error(ctx, "failed to list schedules", slog.Any("error", err))
+		return nil, status.Errorf(codes.Internal, "failed to list schedules: %v", err)
+	}
+
+	protoSchedules := make([]*collector.Schedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		protoSchedules = append(protoSchedules, convertScheduleFromEntity(schedule))
+	}
+
+	ctxlog.Debug(ctx, "listed schedules", slog.Int("count", len(protoSchedules)))
+
+	return &collector.GetSchedulesResponse{
+		Schedules: protoSchedules,
+	}, nil
+}
+
+// DeleteSchedule implements collector.CollectionServiceServer.
+func (s *Service) DeleteSchedule(
+	ctx context.Context, req *collector.DeleteScheduleRequest,
+) (*emptypb.Empty, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, invalidRequestError(err)
+	}
+
+	id := entity.ScheduleID(req.GetScheduleId())
+
+	if err := s.scheduleManager.DeleteSchedule(ctx, id); err != nil {
+		if errors.Is(err, entity.ErrScheduleNotFound) {
+			return nil, status.Errorf(codes.NotFound, "schedule %d not found", id)
+		}
+
+		ctxlog.Error(ctx, "failed to delete schedule", slog.Any("error", err), slog.String("schedule_id", id.String()))
+		return nil, status.Errorf(codes.Internal, "failed to delete schedule: %v", err)
+	}
+
+	ctxlog.Debug(ctx, "schedule deleted", slog.String("schedule_id", id.String()))
+
+	return &emptypb.Empty{}, nil
+}
+
+func convertScheduleFromEntity(schedule entity.Schedule) *collector.Schedule {
+	return &collector.Schedule{ //exhaustruct:enforce
+		ScheduleId:       int64(schedule.ID),
+		CronExpression:   schedule.Cron.String(),
+		Task:             convertTaskFromEntity(schedule.Task),
+		Retention:        convertRetentionFromEntity(schedule.Task.Retention),
+		NextRunAt:        timestamppb.New(schedule.NextRunAt),
+		LastRunAt:        timeToProtoPtr(schedule.LastRunAt.ToPointer()),
+		LastCollectionId: int64(schedule.LastCollectionID.OrEmpty()),
+		CreatedAt:        timestamppb.New(schedule.CreatedAt),
+	}
+}
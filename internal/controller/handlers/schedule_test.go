@@ -0,0 +1,190 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/collector/internal/pb/api/collector"
+	"github.com/n-r-w/ctxlog"
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	grpc_status "google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestCreateSchedule(t *testing.T) {
+	t.Parallel()
+
+	ctx := ctxlog.MustContext(context.Background(), ctxlog.WithTesting(t))
+
+	request := func(cron string) *collector.CreateScheduleRequest {
+		return &collector.CreateScheduleRequest{
+			CronExpression: cron,
+			Task: &collector.CreateTaskRequest{
+				SelectionCriteria: &collector.MessageSelectionCriteria{Handler: "/api/search"},
+				CompletionCriteria: &collector.CompletionCriteria{
+					TimeLimit:         durationpb.New(time.Hour),
+					RequestCountLimit: 10,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		req      *collector.CreateScheduleRequest
+		storeErr error
+		wantCode codes.Code
+	}{
+		{
+			name:     "created",
+			req:      request("0 * * * *"),
+			wantCode: codes.OK,
+		},
+		{
+			name:     "invalid cron expression",
+			req:      request("every hour"),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "invalid task",
+			req: func() *collector.CreateScheduleRequest {
+				req := request("0 * * * *")
+				req.Task.CompletionCriteria.RequestCountLimit = 101
+				return req
+			}(),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "invalid schedule",
+			req:      request("0 * * * *"),
+			storeErr: fmt.Errorf("%w: too frequent", entity.ErrInvalidSchedule),
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "storage error",
+			req:      request("0 * * * *"),
+			storeErr: errors.New("connection refused"),
+			wantCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			manager := NewMockIScheduleManager(ctrl)
+			s := &Service{scheduleManager: manager, maxRequestsPerCollection: 100}
+
+			if tt.wantCode == codes.OK || tt.storeErr != nil {
+				manager.EXPECT().CreateSchedule(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, schedule entity.Schedule) (entity.ScheduleID, error) {
+						require.Equal(t, "0 * * * *", schedule.Cron.String())
+						require.Equal(t, "/api/search", schedule.Task.MessageSelection.Handler)
+						require.Equal(t, 10, schedule.Task.Completion.RequestCountLimit)
+						return 5, tt.storeErr
+					})
+			}
+
+			resp, err := s.CreateSchedule(ctx, tt.req)
+			require.Equal(t, tt.wantCode, grpc_status.Code(err))
+			if tt.wantCode == codes.OK {
+				require.Equal(t, int64(5), resp.GetScheduleId())
+			}
+		})
+	}
+}
+
+func TestConvertScheduleFromEntity(t *testing.T) {
+	t.Parallel()
+
+	cron, err := entity.ParseCronExpression("*/5 * * * *")
+	require.NoError(t, err)
+
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		schedule entity.Schedule
+		check    func(t *testing.T, schedule *collector.Schedule)
+	}{
+		{
+			name: "never run",
+			schedule: entity.Schedule{
+				ID:        3,
+				Cron:      cron,
+				NextRunAt: now.Add(5 * time.Minute),
+				CreatedAt: now,
+				Task: entity.Task{
+					MessageSelection: entity.MessageSelectionCriteria{Handler: "/api/search"},
+					Completion:       entity.CompletionCriteria{TimeLimit: time.Hour, RequestCountLimit: 10},
+				},
+			},
+			check: func(t *testing.T, schedule *collector.Schedule) {
+				require.Equal(t, int64(3), schedule.GetScheduleId())
+				require.Equal(t, "*/5 * * * *", schedule.GetCronExpression())
+				require.Equal(t, now.Add(5*time.Minute), schedule.GetNextRunAt().AsTime())
+				require.Equal(t, now, schedule.GetCreatedAt().AsTime())
+				require.Nil(t, schedule.GetLastRunAt())
+				require.Zero(t, schedule.GetLastCollectionId())
+				require.Equal(t, "/api/search", schedule.GetTask().GetMessageSelection().GetHandler())
+				require.Equal(t, uint32(10), schedule.GetTask().GetCompletion().GetRequestCountLimit())
+				require.Nil(t, schedule.GetRetention().GetPeriod())
+			},
+		},
+		{
+			name: "run",
+			schedule: entity.Schedule{
+				ID:               4,
+				Cron:             cron,
+				NextRunAt:        now.Add(5 * time.Minute),
+				LastRunAt:        mo.Some(now),
+				LastCollectionID: mo.Some(entity.CollectionID(9)),
+				CreatedAt:        now.Add(-time.Hour),
+				Task: entity.Task{
+					Retention: entity.RetentionPolicy{Period: mo.Some(48 * time.Hour)},
+				},
+			},
+			check: func(t *testing.T, schedule *collector.Schedule) {
+				require.Equal(t, now, schedule.GetLastRunAt().AsTime())
+				require.Equal(t, int64(9), schedule.GetLastCollectionId())
+				require.Equal(t, 48*time.Hour, schedule.GetRetention().GetPeriod().AsDuration())
+				require.False(t, schedule.GetRetention().GetPinned())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.check(t, convertScheduleFromEntity(tt.schedule))
+		})
+	}
+}
+
+func TestDeleteSchedule(t *testing.T) {
+	t.Parallel()
+
+	ctx := ctxlog.MustContext(context.Background(), ctxlog.WithTesting(t))
+
+	ctrl := gomock.NewController(t)
+	manager := NewMockIScheduleManager(ctrl)
+	s := &Service{scheduleManager: manager}
+
+	manager.EXPECT().DeleteSchedule(gomock.Any(), entity.ScheduleID(1)).Return(nil)
+	manager.EXPECT().DeleteSchedule(gomock.Any(), entity.ScheduleID(2)).Return(entity.ErrScheduleNotFound)
+
+	_, err := s.DeleteSchedule(ctx, &collector.DeleteScheduleRequest{ScheduleId: 1})
+	require.NoError(t, err)
+
+	_, err = s.DeleteSchedule(ctx, &collector.DeleteScheduleRequest{ScheduleId: 2})
+	require.Equal(t, codes.NotFound, grpc_status.Code(err))
+}
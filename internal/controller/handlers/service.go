@@ -2,11 +2,15 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"log/slog"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/n-r-w/collector/internal/config"
+	"github.com/n-r-w/collector/internal/entity"
 	"github.com/n-r-w/collector/internal/pb/api/collector"
+	"github.com/n-r-w/ctxlog"
 	"github.com/n-r-w/grpcsrv"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -19,8 +23,10 @@ type Service struct {
 	collector.UnimplementedCollectionServiceServer
 
 	collectionManager        ICollectionManager
+	scheduleManager          IScheduleManager
 	resultGetter             IResultGetter
 	maxRequestsPerCollection int
+	expressionCostLimit      uint64
 }
 
 var (
@@ -32,12 +38,15 @@ var (
 func New(
 	cfg *config.Config,
 	collectionManager ICollectionManager,
+	scheduleManager IScheduleManager,
 	resultGetter IResultGetter,
 ) *Service {
 	return &Service{
 		collectionManager:        collectionManager,
+		scheduleManager:          scheduleManager,
 		resultGetter:             resultGetter,
 		maxRequestsPerCollection: cfg.Collection.MaxRequestsPerCollection,
+		expressionCostLimit:      cfg.Collection.ExpressionCostLimit,
 	}
 }
 
@@ -78,3 +87,16 @@ func (s *Service) GetOptions() grpcsrv.InitializeOptions {
 func invalidRequestError(err error) error {
 	return grpc_status.Errorf(codes.InvalidArgument, "invalid request: %v", err)
 }
+
+// changeStatusError converts an error of changing the status of a collection to a gRPC error.
+func changeStatusError(ctx context.Context, id entity.CollectionID, action string, err error) error {
+	if errors.Is(err, entity.ErrCollectionNotFound) {
+		return grpc_status.Errorf(codes.NotFound, "collection %d not found", id)
+	}
+	if errors.Is(err, entity.ErrInvalidStatus) {
+		return grpc_status.Errorf(codes.FailedPrecondition, "failed to %s collection: %v", action, err)
+	}
+
+	ctxlog.Error(ctx, "failed to "+action+" collection", slog.Any("error", err), slog.String("collection_id", id.String()))
+	return grpc_status.Errorf(codes.Internal, "failed to %s collection: %v", action, err)
+}
@@ -0,0 +1,105 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/collector/internal/pb/api/collector"
+	"github.com/n-r-w/ctxlog"
+	"github.com/samber/mo"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// UpdateCollection implements collector.CollectionServiceServer.
+func (s *Service) UpdateCollection(
+	ctx context.Context, req *collector.UpdateCollectionRequest,
+) (*emptypb.Empty, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, invalidRequestError(err)
+	}
+
+	update, err := s.convertCollectionUpdateToEntity(req)
+	if err != nil {
+		return nil, invalidRequestError(err)
+	}
+
+	id := entity.CollectionID(req.GetCollectionId())
+
+	if err := s.collectionManager.UpdateCollection(ctx, id, update); err != nil {
+		if errors.Is(err, entity.ErrInvalidCollectionUpdate) || errors.Is(err, entity.ErrInvalidCompletion) ||
+			errors.Is(err, entity.ErrInvalidStratification) || errors.Is(err, entity.ErrInvalidRetention) {
+			return nil, invalidRequestError(err)
+		}
+
+		return nil, changeStatusError(ctx, id, "update", err)
+	}
+
+	ctxlog.Debug(ctx, "collection updated", slog.String("collection_id", id.String()),
+		slog.String("author", update.Author), slog.Any("fields", update.Fields()))
+
+	return &emptypb.Empty{}, nil
+}
+
+// convertCollectionUpdateToEntity converts the fields listed in the update mask.
+func (s *Service) convertCollectionUpdateToEntity(
+	req *collector.UpdateCollectionRequest,
+) (entity.CollectionUpdate, error) {
+	paths := req.GetUpdateMask().GetPaths()
+	if len(paths) == 0 {
+		return entity.CollectionUpdate{}, errors.New("update mask is empty")
+	}
+
+	update := entity.CollectionUpdate{Author: req.GetAuthor()}
+	for _, path := range paths {
+		switch path {
+		case entity.CollectionFieldRequestCountLimit:
+			limit := int(req.GetRequestCountLimit())
+			if limit > s.maxRequestsPerCollection {
+				return entity.CollectionUpdate{},
+					fmt.Errorf("request count limit must not exceed %d", s.maxRequestsPerCollection)
+			}
+			update.RequestCountLimit = mo.Some(limit)
+
+		case entity.CollectionFieldTimeLimit:
+			if req.GetTimeLimit() == nil {
+				return entity.CollectionUpdate{}, errors.New("time limit is required")
+			}
+			update.TimeLimit = mo.Some(req.GetTimeLimit().AsDuration())
+
+		case entity.CollectionFieldIdleTimeout:
+			update.IdleTimeout = mo.Some(req.GetIdleTimeout().AsDuration())
+
+		case entity.CollectionFieldByteSizeLimit:
+			update.ByteSizeLimit = mo.Some(int64(req.GetByteSizeLimit())) //nolint:gosec // checked by the task
+
+		case entity.CollectionFieldSelection:
+			if req.GetSelectionCriteria() == nil {
+				return entity.CollectionUpdate{}, errors.New("selection criteria are required")
+			}
+			selection, err := s.convertSelectionCriteria(req.GetSelectionCriteria())
+			if err != nil {
+				return entity.CollectionUpdate{}, err
+			}
+			update.MessageSelection = mo.Some(selection)
+
+		case entity.CollectionFieldExpression:
+			var expression entity.Expression
+			if req.GetExpression() != "" {
+				// compiled only to validate, the collection cache compiles it again
+				var err error
+				if expression, err = entity.CompileExpression(req.GetExpression(), s.expressionCostLimit); err != nil {
+					return entity.CollectionUpdate{}, err
+				}
+			}
+			update.Expression = mo.Some(expression)
+
+		default:
+			return entity.CollectionUpdate{}, fmt.Errorf("field %q can't be updated", path)
+		}
+	}
+
+	return update, nil
+}
@@ -0,0 +1,140 @@
+package handlers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/collector/internal/pb/api/collector"
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestConvertCollectionUpdateToEntity(t *testing.T) {
+	t.Parallel()
+
+	s := &Service{maxRequestsPerCollection: 100, expressionCostLimit: 1000}
+
+	request := func(paths ...string) *collector.UpdateCollectionRequest {
+		return &collector.UpdateCollectionRequest{
+			CollectionId:      1,
+			Author:            "tester",
+			UpdateMask:        &fieldmaskpb.FieldMask{Paths: paths},
+			RequestCountLimit: 50,
+			TimeLimit:         durationpb.New(time.Hour),
+			IdleTimeout:       durationpb.New(time.Minute),
+			ByteSizeLimit:     1024,
+			SelectionCriteria: &collector.MessageSelectionCriteria{Handler: "/api/search"},
+			Expression:        `"search" in headers["x-team"]`,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		req     *collector.UpdateCollectionRequest
+		check   func(t *testing.T, update entity.CollectionUpdate)
+		wantErr bool
+	}{
+		{
+			name: "limits",
+			req: request(entity.CollectionFieldRequestCountLimit, entity.CollectionFieldTimeLimit,
+				entity.CollectionFieldIdleTimeout, entity.CollectionFieldByteSizeLimit),
+			check: func(t *testing.T, update entity.CollectionUpdate) {
+				require.Equal(t, "tester", update.Author)
+				require.Equal(t, mo.Some(50), update.RequestCountLimit)
+				require.Equal(t, mo.Some(time.Hour), update.TimeLimit)
+				require.Equal(t, mo.Some(time.Minute), update.IdleTimeout)
+				require.Equal(t, mo.Some(int64(1024)), update.ByteSizeLimit)
+				require.True(t, update.MessageSelection.IsAbsent())
+				require.True(t, update.Expression.IsAbsent())
+			},
+		},
+		{
+			name: "selection criteria and expression",
+			req:  request(entity.CollectionFieldSelection, entity.CollectionFieldExpression),
+			check: func(t *testing.T, update entity.CollectionUpdate) {
+				require.True(t, update.RequestCountLimit.IsAbsent())
+				selection, ok := update.MessageSelection.Get()
+				require.True(t, ok)
+				require.Equal(t, "/api/search", selection.Handler)
+				expression, ok := update.Expression.Get()
+				require.True(t, ok)
+				require.Equal(t, `"search" in headers["x-team"]`, expression.Source)
+			},
+		},
+		{
+			name: "expression is removed",
+			req: func() *collector.UpdateCollectionRequest {
+				req := request(entity.CollectionFieldExpression)
+				req.Expression = ""
+				return req
+			}(),
+			check: func(t *testing.T, update entity.CollectionUpdate) {
+				require.Equal(t, mo.Some(entity.Expression{}), update.Expression)
+			},
+		},
+		{
+			name:    "empty update mask",
+			req:     request(),
+			wantErr: true,
+		},
+		{
+			name:    "unknown field",
+			req:     request("status"),
+			wantErr: true,
+		},
+		{
+			name: "request count limit exceeds the maximum",
+			req: func() *collector.UpdateCollectionRequest {
+				req := request(entity.CollectionFieldRequestCountLimit)
+				req.RequestCountLimit = 101
+				return req
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "time limit is missing",
+			req: func() *collector.UpdateCollectionRequest {
+				req := request(entity.CollectionFieldTimeLimit)
+				req.TimeLimit = nil
+				return req
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "selection criteria are missing",
+			req: func() *collector.UpdateCollectionRequest {
+				req := request(entity.CollectionFieldSelection)
+				req.SelectionCriteria = nil
+				return req
+			}(),
+			wantErr: true,
+		},
+		{
+			name: "invalid expression",
+			req: func() *collector.UpdateCollectionRequest {
+				req := request(entity.CollectionFieldExpression)
+				req.Expression = "headers["
+				return req
+			}(),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			update, err := s.convertCollectionUpdateToEntity(tt.req)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			tt.check(t, update)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/collector/internal/pb/api/collector"
+	"github.com/n-r-w/ctxlog"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// UpdateRetention implements collector.CollectionServiceServer.
+func (s *Service) UpdateRetention(
+	ctx context.Context, req *collector.UpdateRetentionRequest,
+) (*emptypb.Empty, error) {
+	if err := req.ValidateAll(); err != nil {
+		return nil, invalidRequestError(err)
+	}
+
+	id := entity.CollectionID(req.GetCollectionId())
+
+	err := s.collectionManager.UpdateRetention(ctx, id, convertRetentionToEntity(req.GetRetention()))
+	if err != nil {
+		if errors.Is(err, entity.ErrInvalidRetention) {
+			return nil, invalidRequestError(err)
+		}
+		if errors.Is(err, entity.ErrCollectionNotFound) {
+			return nil, status.Errorf(codes.NotFound, "collection %d not found", id)
+		}
+
+		ctxlog.Error(ctx, "failed to update retention", slog.Any("error", err), slog.String("collection_id", id.String()))
+		return nil, status.Errorf(codes.Internal, "failed to update retention: %v", err)
+	}
+
+	ctxlog.Debug(ctx, "collection retention updated", slog.String("collection_id", id.String()))
+
+	return &emptypb.Empty{}, nil
+}
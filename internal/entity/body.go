@@ -0,0 +1,224 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// BodyOperator is an operator of a body criteria.
+type BodyOperator int
+
+const (
+	// BodyOperatorUnknown is an unknown operator.
+	BodyOperatorUnknown BodyOperator = 0
+	// BodyOperatorEqual matches if the value is equal to the JSON value.
+	BodyOperatorEqual BodyOperator = 1
+	// BodyOperatorRegex matches if the value is a string matching the regular expression.
+	BodyOperatorRegex BodyOperator = 2
+	// BodyOperatorExists matches if the value exists, including null values.
+	BodyOperatorExists BodyOperator = 3
+	// BodyOperatorGreater matches if the value is a number greater than the number.
+	BodyOperatorGreater BodyOperator = 4
+	// BodyOperatorGreaterOrEqual matches if the value is a number greater than or equal to the number.
+	BodyOperatorGreaterOrEqual BodyOperator = 5
+	// BodyOperatorLess matches if the value is a number less than the number.
+	BodyOperatorLess BodyOperator = 6
+	// BodyOperatorLessOrEqual matches if the value is a number less than or equal to the number.
+	BodyOperatorLessOrEqual BodyOperator = 7
+)
+
+// IsValid checks if the operator is valid.
+func (o BodyOperator) IsValid() bool {
+	return o >= BodyOperatorEqual && o <= BodyOperatorLessOrEqual
+}
+
+// isComparison checks if the operator compares numbers.
+func (o BodyOperator) isComparison() bool {
+	return o >= BodyOperatorGreater && o <= BodyOperatorLessOrEqual
+}
+
+// BodyCriteria defines a single body matching criteria.
+// Use NewBodyCriteria to create it.
+type BodyCriteria struct {
+	// Path is the path to the value in the JSON body.
+	Path JSONPath
+	// Operator is the operator to apply to the value.
+	Operator BodyOperator
+	// Value is the operand: a JSON value, a regular expression or a number depending on the operator.
+	// It is empty for BodyOperatorExists.
+	Value string
+
+	equal   any
+	pattern *regexp.Regexp
+	number  float64
+}
+
+// NewBodyCriteria parses and validates a body criteria.
+func NewBodyCriteria(path string, operator BodyOperator, value string) (BodyCriteria, error) {
+	jsonPath, err := ParseJSONPath(path)
+	if err != nil {
+		return BodyCriteria{}, err
+	}
+
+	c := BodyCriteria{
+		Path:     jsonPath,
+		Operator: operator,
+		Value:    value,
+	}
+
+	switch {
+	case operator == BodyOperatorEqual:
+		if err := json.Unmarshal([]byte(value), &c.equal); err != nil {
+			return BodyCriteria{}, fmt.Errorf("%w: value of %s must be a JSON value: %w",
+				ErrInvalidBodyCriteria, path, err)
+		}
+	case operator == BodyOperatorRegex:
+		if c.pattern, err = regexp.Compile(value); err != nil {
+			return BodyCriteria{}, fmt.Errorf("%w: compile regexp of %s: %w", ErrInvalidBodyCriteria, path, err)
+		}
+	case operator == BodyOperatorExists:
+		if value != "" {
+			return BodyCriteria{}, fmt.Errorf("%w: value of %s must be empty", ErrInvalidBodyCriteria, path)
+		}
+	case operator.isComparison():
+		if c.number, err = strconv.ParseFloat(value, 64); err != nil {
+			return BodyCriteria{}, fmt.Errorf("%w: value of %s must be a number: %w",
+				ErrInvalidBodyCriteria, path, err)
+		}
+	default:
+		return BodyCriteria{}, fmt.Errorf("%w: unknown operator %d", ErrInvalidBodyCriteria, operator)
+	}
+
+	return c, nil
+}
+
+// Match checks if the decoded JSON body matches the criteria.
+func (c BodyCriteria) Match(body any) bool {
+	value, ok := c.Path.Lookup(body)
+	if !ok {
+		return false
+	}
+
+	switch c.Operator {
+	case BodyOperatorEqual:
+		return reflect.DeepEqual(value, c.equal)
+	case BodyOperatorRegex:
+		s, ok := value.(string)
+		return ok && c.pattern.MatchString(s)
+	case BodyOperatorExists:
+		return true
+	case BodyOperatorGreater, BodyOperatorGreaterOrEqual, BodyOperatorLess, BodyOperatorLessOrEqual:
+		n, ok := value.(float64)
+		if !ok {
+			return false
+		}
+
+		switch c.Operator { //nolint:exhaustive // only comparison operators
+		case BodyOperatorGreater:
+			return n > c.number
+		case BodyOperatorGreaterOrEqual:
+			return n >= c.number
+		case BodyOperatorLess:
+			return n < c.number
+		default:
+			return n <= c.number
+		}
+	default:
+		return false
+	}
+}
+
+// JSONPath is a path to a value in a JSON document, for example $.items[0].name or $["content-type"].
+// Use ParseJSONPath to create it.
+type JSONPath struct {
+	raw      string
+	segments []any // string keys of objects and int indexes of arrays
+}
+
+// ParseJSONPath parses a JSON path.
+func ParseJSONPath(path string) (JSONPath, error) {
+	rest, ok := strings.CutPrefix(path, "$")
+	if !ok {
+		return JSONPath{}, fmt.Errorf("%w: path %q must start with $", ErrInvalidBodyCriteria, path)
+	}
+
+	var segments []any
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return JSONPath{}, fmt.Errorf("%w: empty key in path %q", ErrInvalidBodyCriteria, path)
+			}
+			segments = append(segments, rest[:end])
+			rest = rest[end:]
+
+		case '[':
+			end := strings.IndexByte(rest, ']')
+			if strings.HasPrefix(rest, `["`) {
+				// quoted key, may contain dots and brackets
+				end = strings.Index(rest, `"]`) + 1
+			}
+			if end <= 1 {
+				return JSONPath{}, fmt.Errorf("%w: unclosed bracket in path %q", ErrInvalidBodyCriteria, path)
+			}
+
+			segment := rest[1:end]
+			rest = rest[end+1:]
+
+			if key, err := strconv.Unquote(segment); err == nil {
+				segments = append(segments, key)
+				continue
+			}
+
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 {
+				return JSONPath{}, fmt.Errorf("%w: invalid index %q in path %q", ErrInvalidBodyCriteria, segment, path)
+			}
+			segments = append(segments, index)
+
+		default:
+			return JSONPath{}, fmt.Errorf("%w: unexpected %q in path %q", ErrInvalidBodyCriteria, rest[0], path)
+		}
+	}
+
+	return JSONPath{raw: path, segments: segments}, nil
+}
+
+// String returns the path as it was parsed.
+func (p JSONPath) String() string {
+	return p.raw
+}
+
+// Lookup returns the value at the path in the decoded JSON document.
+func (p JSONPath) Lookup(doc any) (any, bool) {
+	value := doc
+	for _, segment := range p.segments {
+		switch segment := segment.(type) {
+		case string:
+			object, ok := value.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			if value, ok = object[segment]; !ok {
+				return nil, false
+			}
+		case int:
+			array, ok := value.([]any)
+			if !ok || segment >= len(array) {
+				return nil, false
+			}
+			value = array[segment]
+		}
+	}
+
+	return value, true
+}
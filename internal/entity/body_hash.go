@@ -0,0 +1,186 @@
+package entity
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// maxNumberExponent limits exponents of canonicalized numbers, PostgreSQL rejects larger ones.
+const maxNumberExponent = 1000
+
+// HashBody returns the content hash of a request body, that is used as its key in request_bodies.
+// The body is hashed in the canonical form of PostgreSQL jsonb text output, so hashes calculated
+// in the database with sha256(convert_to(body::text, 'UTF8')) are the same.
+// Bodies that are not valid JSON are hashed as is.
+func HashBody(body []byte) []byte {
+	canonical, err := canonicalJSON(body)
+	if err != nil {
+		canonical = body
+	}
+
+	hash := sha256.Sum256(canonical)
+	return hash[:]
+}
+
+// canonicalJSON formats the JSON document the same way as PostgreSQL formats jsonb values.
+func canonicalJSON(data []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after JSON value")
+	}
+
+	var buf bytes.Buffer
+	if err := writeCanonical(&buf, value); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeCanonical(buf *bytes.Buffer, value any) error {
+	switch v := value.(type) {
+	case nil:
+		buf.WriteString("null")
+	case bool:
+		if v {
+			buf.WriteString("true")
+		} else {
+			buf.WriteString("false")
+		}
+	case string:
+		writeCanonicalString(buf, v)
+	case json.Number:
+		number, err := canonicalNumber(string(v))
+		if err != nil {
+			return err
+		}
+		buf.WriteString(number)
+	case []any:
+		buf.WriteByte('[')
+		for i, item := range v {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			if err := writeCanonical(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+	case map[string]any:
+		// jsonb keeps keys sorted by length first, then bytewise
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.SortFunc(keys, func(a, b string) int {
+			if len(a) != len(b) {
+				return len(a) - len(b)
+			}
+			return strings.Compare(a, b)
+		})
+
+		buf.WriteByte('{')
+		for i, key := range keys {
+			if i > 0 {
+				buf.WriteString(", ")
+			}
+			writeCanonicalString(buf, key)
+			buf.WriteString(": ")
+			if err := writeCanonical(buf, v[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+	default:
+		return fmt.Errorf("unexpected JSON value %T", value)
+	}
+
+	return nil
+}
+
+// writeCanonicalString escapes the string the same way as PostgreSQL escape_json.
+func writeCanonicalString(buf *bytes.Buffer, s string) {
+	buf.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"':
+			buf.WriteString(`\"`)
+		case '\\':
+			buf.WriteString(`\\`)
+		case '\b':
+			buf.WriteString(`\b`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\t':
+			buf.WriteString(`\t`)
+		default:
+			if c < 0x20 {
+				fmt.Fprintf(buf, `\u%04x`, c)
+			} else {
+				buf.WriteByte(c)
+			}
+		}
+	}
+	buf.WriteByte('"')
+}
+
+// canonicalNumber formats the JSON number the same way as PostgreSQL numeric output:
+// the exponent is expanded and the scale is the number of fraction digits reduced by the exponent.
+func canonicalNumber(number string) (string, error) {
+	negative := strings.HasPrefix(number, "-")
+	mantissa := strings.TrimPrefix(number, "-")
+
+	exponent := 0
+	if pos := strings.IndexAny(mantissa, "eE"); pos >= 0 {
+		if _, err := fmt.Sscan(mantissa[pos+1:], &exponent); err != nil {
+			return "", fmt.Errorf("invalid number %s: %w", number, err)
+		}
+		mantissa = mantissa[:pos]
+	}
+	if exponent > maxNumberExponent || exponent < -maxNumberExponent {
+		return "", fmt.Errorf("exponent of number %s is out of range", number)
+	}
+
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	digits := intPart + fracPart
+	point := len(intPart) + exponent
+
+	if point <= 0 {
+		digits = strings.Repeat("0", 1-point) + digits
+		point = 1
+	} else if point > len(digits) {
+		digits += strings.Repeat("0", point-len(digits))
+	}
+
+	integer := strings.TrimLeft(digits[:point], "0")
+	if integer == "" {
+		integer = "0"
+	}
+
+	result := integer
+	if fraction := digits[point:]; fraction != "" {
+		result += "." + fraction
+	}
+
+	// numeric has no negative zero
+	if negative && strings.Trim(digits, "0") != "" {
+		result = "-" + result
+	}
+
+	return result, nil
+}
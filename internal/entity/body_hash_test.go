@@ -0,0 +1,50 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	t.Parallel()
+
+	// expected values are the output of SELECT '<body>'::jsonb::text
+	tests := []struct {
+		body     string
+		expected string
+	}{
+		{`{"b":1,"a":2}`, `{"a": 2, "b": 1}`},
+		{`{"bb": 1, "a": 2, "c": 3}`, `{"a": 2, "c": 3, "bb": 1}`},
+		{`{"a": 1, "a": 2}`, `{"a": 2}`},
+		{` [1,{"x":[ ]},{}] `, `[1, {"x": []}, {}]`},
+		{`"line\nbreak \"quoted\" \u0001 é"`, `"line\nbreak \"quoted\" \u0001 é"`},
+		{`[1.50, 1e2, 1.50e1, 1E-2, -0, -0.0, 0.001, 12e-1]`, `[1.50, 100, 15.0, 0.01, 0, 0.0, 0.001, 1.2]`},
+		{`[true, false, null]`, `[true, false, null]`},
+	}
+
+	for _, test := range tests {
+		canonical, err := canonicalJSON([]byte(test.body))
+		require.NoError(t, err, test.body)
+		require.Equal(t, test.expected, string(canonical), test.body)
+	}
+
+	for _, invalid := range []string{`{`, `1 2`, `1e2000`} {
+		_, err := canonicalJSON([]byte(invalid))
+		require.Error(t, err, invalid)
+	}
+}
+
+func TestHashBody(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, HashBody([]byte(`{"b":1,"a":2}`)), HashBody([]byte(`{"a": 2, "b": 1}`)))
+
+	expected := sha256.Sum256([]byte(`{"a": 2, "b": 1}`))
+	require.Equal(t, expected[:], HashBody([]byte(`{"b":1,"a":2}`)))
+
+	// invalid JSON is hashed as is
+	expected = sha256.Sum256([]byte(`not json`))
+	require.Equal(t, expected[:], HashBody([]byte(`not json`)))
+}
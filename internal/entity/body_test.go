@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBodyCriteria_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		path     string
+		operator BodyOperator
+		value    string
+	}{
+		{name: "path without root", path: "tier", operator: BodyOperatorExists},
+		{name: "empty key", path: "$..tier", operator: BodyOperatorExists},
+		{name: "unclosed bracket", path: "$.items[0", operator: BodyOperatorExists},
+		{name: "negative index", path: "$.items[-1]", operator: BodyOperatorExists},
+		{name: "unknown operator", path: "$.tier", operator: BodyOperatorUnknown},
+		{name: "not a JSON value", path: "$.tier", operator: BodyOperatorEqual, value: "premium"},
+		{name: "invalid regex", path: "$.tier", operator: BodyOperatorRegex, value: "("},
+		{name: "value of exists", path: "$.tier", operator: BodyOperatorExists, value: "1"},
+		{name: "not a number", path: "$.price", operator: BodyOperatorGreater, value: "ten"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewBodyCriteria(tt.path, tt.operator, tt.value)
+			require.ErrorIs(t, err, ErrInvalidBodyCriteria)
+		})
+	}
+}
@@ -28,6 +28,14 @@ type Collection struct {
 	Status CollectionStatus
 	// RequestCount is the total number of requests in the collection
 	RequestCount int
+	// DuplicateCount is the number of requests not collected because their body was already collected
+	DuplicateCount int
+	// SeenCount is the number of matching requests offered to the reservoir of a reservoir collection
+	SeenCount int
+	// ByteCount is the total size of request bodies in the collection
+	ByteCount int64
+	// LastRequestAt is the timestamp when the last matching request arrived
+	LastRequestAt mo.Option[time.Time]
 	// CreatedAt is the timestamp when collection was created
 	CreatedAt time.Time
 	// StartedAt is the timestamp when collection was started
@@ -36,23 +44,88 @@ type Collection struct {
 	UpdatedAt mo.Option[time.Time]
 	// CompletedAt is the timestamp when collection reached terminal state
 	CompletedAt mo.Option[time.Time]
+	// Pause is the state of pausing of the collection
+	Pause PauseState
 
 	// ResultID is the ID of the result in the storage
 	ResultID mo.Option[ResultID]
 	// ErrorMessage contains error message if collection failed
 	ErrorMessage mo.Option[string]
 	// ErrorCode contains error code if collection failed
-	ErrorCode mo.Option[int]
+	ErrorCode mo.Option[ErrorCode]
 }
 
-// IsOutOfTimeLimit returns true if collection is out of time limit.
+// PauseState describes pauses of the collection.
+type PauseState struct {
+	// PausedAt is the timestamp when the collection was paused, if it is paused now
+	PausedAt mo.Option[time.Time]
+	// ResumedAt is the timestamp when the collection was resumed last time
+	ResumedAt mo.Option[time.Time]
+	// FreezeTimeLimit stops the time limit during the current pause
+	FreezeTimeLimit bool
+	// FrozenDuration is the total duration of the finished pauses that stopped the time limit
+	FrozenDuration time.Duration
+}
+
+// Frozen returns the duration that is not counted in the time limit, including the current pause.
+func (p PauseState) Frozen() time.Duration {
+	pausedAt, ok := p.PausedAt.Get()
+	if !ok || !p.FreezeTimeLimit {
+		return p.FrozenDuration
+	}
+
+	return p.FrozenDuration + time.Since(pausedAt)
+}
+
+// IsOutOfTimeLimit returns true if collection is out of time limit or is idle for too long.
+// The time limit of scheduled collections is counted from the start time.
+// If the time limit is counted from the first request, the collection waits for it no longer than MaxWait.
+// Pauses that freeze the time limit are not counted.
 func (c *Collection) IsOutOfTimeLimit() bool {
-	return time.Since(c.CreatedAt) >= c.Task.Completion.TimeLimit
+	completion := c.Task.Completion
+	frozen := c.Pause.Frozen()
+	startAt := c.Task.StartAt.OrElse(c.CreatedAt)
+	if completion.TimeLimitFromFirstRequest {
+		firstRequestAt, ok := c.StartedAt.Get()
+		if !ok {
+			return time.Since(startAt)-frozen >= completion.MaxWait
+		}
+		startAt = firstRequestAt
+	}
+
+	if time.Since(startAt)-frozen >= completion.TimeLimit {
+		return true
+	}
+
+	// paused collections don't get requests, so they are not idle
+	if completion.IdleTimeout <= 0 || c.Status == StatusPaused {
+		return false
+	}
+
+	// collections without requests are idle from the start or from the resume
+	idleFrom := c.LastRequestAt.OrElse(startAt)
+	if resumedAt, ok := c.Pause.ResumedAt.Get(); ok && resumedAt.After(idleFrom) {
+		idleFrom = resumedAt
+	}
+
+	return time.Since(idleFrom) >= completion.IdleTimeout
 }
 
-// IsOutOfRequestLimit returns true if collection is out of request limit.
+// IsWaitingForStart returns true if the collection doesn't collect requests received at the given time yet.
+func (c *Collection) IsWaitingForStart(at time.Time) bool {
+	startAt, ok := c.Task.StartAt.Get()
+	return ok && at.Before(startAt)
+}
+
+// IsOutOfRequestLimit returns true if collection is out of request limit or byte size limit.
+// Reservoir collections are never out of request limit, they replace collected requests until the time limit.
 func (c *Collection) IsOutOfRequestLimit() bool {
-	return c.RequestCount >= c.Task.Completion.RequestCountLimit
+	if c.Task.Completion.Reservoir {
+		return false
+	}
+
+	byteSizeLimit := c.Task.Completion.ByteSizeLimit
+	return c.RequestCount >= c.Task.Completion.RequestCountLimit || (byteSizeLimit > 0 && c.ByteCount >= byteSizeLimit)
 }
 
 // SetStatus updates collection status and related timestamps.
@@ -73,15 +146,56 @@ func (c *Collection) SetStatus(status CollectionStatus) error {
 	return nil
 }
 
-// SetError sets error message and updates status to failed.
-func (c *Collection) SetError(err string) error {
+// SetError sets error code and message and updates status to failed.
+func (c *Collection) SetError(code ErrorCode, err string) error {
+	c.ErrorCode = mo.Some(code)
 	c.ErrorMessage = mo.Some(err)
 	return c.SetStatus(StatusFailed)
 }
 
+// HasEnoughRequests returns true if collection has reached the minimum request count.
+func (c *Collection) HasEnoughRequests() bool {
+	return c.RequestCount >= c.Task.Completion.MinRequestCount
+}
+
 // CollectionFilter contains parameters for filtering collections.
 type CollectionFilter struct {
 	Statuses []CollectionStatus
 	FromTime mo.Option[time.Time] // created_at
 	ToTime   mo.Option[time.Time] // created_at
+	Owner    mo.Option[string]
+	// Labels are requirements the labels of the collection must match.
+	Labels []LabelRequirement
+	// Handler is the handler of the selection criteria, of its handler patterns or of any of its rules.
+	// Patterns are compared as written, they are not matched against the handler.
+	Handler       mo.Option[string]
+	CompletedFrom mo.Option[time.Time] // completed_at
+	CompletedTo   mo.Option[time.Time] // completed_at
+
+	Order CollectionOrder
+	// After is the position of the last collection of the previous page.
+	After mo.Option[CollectionCursor]
+	// Limit is the maximum number of collections, 0 means no limit.
+	Limit int
+}
+
+// CollectionOrder is the order of collections in the list.
+type CollectionOrder int
+
+const (
+	// CollectionOrderNewestFirst sorts collections by creation time in descending order.
+	CollectionOrderNewestFirst CollectionOrder = iota
+	// CollectionOrderOldestFirst sorts collections by creation time in ascending order.
+	CollectionOrderOldestFirst
+)
+
+// CollectionCursor is a position in the list of collections. ID breaks ties of the creation time.
+type CollectionCursor struct {
+	CreatedAt time.Time
+	ID        CollectionID
+}
+
+// Cursor returns the position of the collection in the list.
+func (c *Collection) Cursor() CollectionCursor {
+	return CollectionCursor{CreatedAt: c.CreatedAt, ID: c.ID}
 }
@@ -0,0 +1,133 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollection_IsWaitingForStart(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	collection := Collection{
+		CreatedAt: now.Add(-time.Hour),
+		Task: Task{
+			StartAt:    mo.Some(now.Add(time.Minute)),
+			Completion: CompletionCriteria{TimeLimit: time.Minute},
+		},
+	}
+	require.True(t, collection.IsWaitingForStart(now))
+	require.False(t, collection.IsWaitingForStart(now.Add(time.Minute)))
+	// the time limit is counted from the start
+	require.False(t, collection.IsOutOfTimeLimit())
+
+	collection.Task.StartAt = mo.None[time.Time]()
+	require.False(t, collection.IsWaitingForStart(now))
+	require.True(t, collection.IsOutOfTimeLimit())
+}
+
+func TestCollection_IdleAndByteSizeLimits(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	collection := Collection{
+		CreatedAt: now.Add(-time.Minute * 10),
+		Task: Task{
+			Completion: CompletionCriteria{
+				TimeLimit:         time.Hour,
+				RequestCountLimit: 100,
+				IdleTimeout:       time.Minute * 5,
+				ByteSizeLimit:     1000,
+			},
+		},
+	}
+	// collections without requests are idle from the creation
+	require.True(t, collection.IsOutOfTimeLimit())
+
+	collection.LastRequestAt = mo.Some(now.Add(-time.Minute))
+	require.False(t, collection.IsOutOfTimeLimit())
+
+	collection.ByteCount = 999
+	require.False(t, collection.IsOutOfRequestLimit())
+	collection.ByteCount = 1000
+	require.True(t, collection.IsOutOfRequestLimit())
+}
+
+func TestCollection_TimeLimitFromFirstRequest(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	collection := Collection{
+		CreatedAt: now.Add(-time.Hour * 2),
+		Task: Task{
+			Completion: CompletionCriteria{
+				TimeLimit:                 time.Hour,
+				TimeLimitFromFirstRequest: true,
+				MaxWait:                   time.Hour * 3,
+			},
+			Retention: RetentionPolicy{Period: mo.Some(time.Hour * 3)},
+		},
+	}
+	// waiting for the first request
+	require.False(t, collection.IsOutOfTimeLimit())
+
+	collection.StartedAt = mo.Some(now.Add(-time.Minute * 30))
+	require.False(t, collection.IsOutOfTimeLimit())
+
+	collection.StartedAt = mo.Some(now.Add(-time.Hour))
+	require.True(t, collection.IsOutOfTimeLimit())
+
+	// no request during the max wait
+	collection.StartedAt = mo.None[time.Time]()
+	collection.CreatedAt = now.Add(-time.Hour * 3)
+	require.True(t, collection.IsOutOfTimeLimit())
+
+	// the collection must be kept until the end of the max wait and the time limit
+	require.ErrorIs(t, collection.Task.ValidateRetention(), ErrInvalidRetention)
+	collection.Task.Retention.Period = mo.Some(time.Hour * 5)
+	require.NoError(t, collection.Task.ValidateRetention())
+}
+
+func TestCollection_Pause(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	collection := Collection{
+		Status:    StatusPaused,
+		CreatedAt: now.Add(-time.Hour * 2),
+		Task: Task{
+			Completion: CompletionCriteria{
+				TimeLimit:   time.Hour * 2,
+				IdleTimeout: time.Minute * 10,
+			},
+		},
+		Pause: PauseState{
+			PausedAt: mo.Some(now.Add(-time.Minute * 30)),
+		},
+	}
+	// the pause doesn't freeze the time limit
+	require.True(t, collection.IsOutOfTimeLimit())
+
+	// paused collections are not idle
+	collection.Pause.FreezeTimeLimit = true
+	require.Equal(t, time.Minute*30, collection.Pause.Frozen().Round(time.Minute))
+	require.False(t, collection.IsOutOfTimeLimit())
+
+	// after the resume the frozen duration is kept and the idle timeout is counted from the resume
+	collection.Status = StatusPending
+	collection.Pause = PauseState{
+		ResumedAt:      mo.Some(now.Add(-time.Minute * 5)),
+		FrozenDuration: time.Minute * 30,
+	}
+	require.False(t, collection.IsOutOfTimeLimit())
+
+	collection.Pause.ResumedAt = mo.Some(now.Add(-time.Minute * 15))
+	require.True(t, collection.IsOutOfTimeLimit())
+}
@@ -0,0 +1,9 @@
+package entity
+
+// DeletionFailure describes an object that could not be deleted from the object storage.
+type DeletionFailure struct {
+	// ResultID is the ID of the object in the storage
+	ResultID ResultID
+	// Reason is the description of the failure
+	Reason string
+}
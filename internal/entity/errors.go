@@ -7,4 +7,28 @@ var (
 	ErrCollectionNotFound = errors.New("collection not found")
 	// ErrInvalidStatus indicates that collection status is invalid.
 	ErrInvalidStatus = errors.New("invalid collection status")
+	// ErrInvalidRetention indicates that collection retention policy is invalid.
+	ErrInvalidRetention = errors.New("invalid retention policy")
+	// ErrInvalidHandlerCriteria indicates that handler criteria of the message selection is invalid.
+	ErrInvalidHandlerCriteria = errors.New("invalid handler criteria")
+	// ErrInvalidBodyCriteria indicates that body criteria of the message selection is invalid.
+	ErrInvalidBodyCriteria = errors.New("invalid body criteria")
+	// ErrInvalidHeaderGroup indicates that header group of the message selection is invalid.
+	ErrInvalidHeaderGroup = errors.New("invalid header group")
+	// ErrInvalidRules indicates that selection rules are invalid.
+	ErrInvalidRules = errors.New("invalid selection rules")
+	// ErrInvalidExpression indicates that selection expression is invalid.
+	ErrInvalidExpression = errors.New("invalid selection expression")
+	// ErrInvalidStratification indicates that stratification of the collection is invalid.
+	ErrInvalidStratification = errors.New("invalid stratification")
+	// ErrInvalidCompletion indicates that completion criteria of the collection are invalid.
+	ErrInvalidCompletion = errors.New("invalid completion criteria")
+	// ErrInvalidCollectionUpdate indicates that the change of a collection is invalid.
+	ErrInvalidCollectionUpdate = errors.New("invalid collection update")
+	// ErrInvalidLabelSelector indicates that label selector of the collection filter is invalid.
+	ErrInvalidLabelSelector = errors.New("invalid label selector")
+	// ErrScheduleNotFound indicates that requested schedule doesn't exist.
+	ErrScheduleNotFound = errors.New("schedule not found")
+	// ErrInvalidSchedule indicates that schedule of collections is invalid.
+	ErrInvalidSchedule = errors.New("invalid schedule")
 )
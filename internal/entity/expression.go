@@ -0,0 +1,114 @@
+package entity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+)
+
+// Expression is a CEL expression selecting requests.
+// It can use the variables:
+//   - handler: string, the handler name;
+//   - headers: map(string, list(string)), request headers with lower case names;
+//   - body: dyn, the parsed JSON body or null if the body is not a valid JSON;
+//   - timestamp: google.protobuf.Timestamp, the time when the request was received.
+type Expression struct {
+	// Source is the expression text. Empty if the expression is not set.
+	Source string
+
+	program cel.Program
+}
+
+// ExpressionInput contains values of the expression variables.
+type ExpressionInput struct {
+	vars map[string]any
+}
+
+// NewExpressionInput creates an expression input from a request and its parsed JSON body.
+func NewExpressionInput(request RequestContent, body any) ExpressionInput {
+	headers := make(map[string][]string, len(request.Headers))
+	for name, values := range request.Headers {
+		name = strings.ToLower(name)
+		headers[name] = append(headers[name], values...)
+	}
+
+	return ExpressionInput{vars: map[string]any{
+		"handler":   request.Handler,
+		"headers":   headers,
+		"body":      body,
+		"timestamp": request.CreatedAt,
+	}}
+}
+
+// expressionInterruptCheckFrequency is the number of comprehension iterations between context cancellation checks.
+const expressionInterruptCheckFrequency = 100
+
+// expressionEnv declares variables available in expressions.
+var expressionEnv = func() *cel.Env {
+	env, err := cel.NewEnv(
+		cel.Variable("handler", cel.StringType),
+		cel.Variable("headers", cel.MapType(cel.StringType, cel.ListType(cel.StringType))),
+		cel.Variable("body", cel.DynType),
+		cel.Variable("timestamp", cel.TimestampType),
+	)
+	if err != nil {
+		panic(fmt.Errorf("failed to create CEL environment: %w", err))
+	}
+
+	return env
+}()
+
+// CompileExpression compiles a CEL expression. The expression must return bool.
+// The evaluation is aborted when its cost exceeds costLimit.
+func CompileExpression(source string, costLimit uint64) (Expression, error) {
+	ast, issues := expressionEnv.Compile(source)
+	if issues.Err() != nil {
+		return Expression{}, fmt.Errorf("%w: %w", ErrInvalidExpression, issues.Err())
+	}
+
+	if ast.OutputType() != cel.BoolType {
+		return Expression{}, fmt.Errorf("%w: expression must return bool, got %s",
+			ErrInvalidExpression, ast.OutputType())
+	}
+
+	program, err := expressionEnv.Program(ast,
+		cel.CostLimit(costLimit),
+		cel.InterruptCheckFrequency(expressionInterruptCheckFrequency),
+	)
+	if err != nil {
+		return Expression{}, fmt.Errorf("%w: %w", ErrInvalidExpression, err)
+	}
+
+	return Expression{Source: source, program: program}, nil
+}
+
+// IsSet checks if the expression is set.
+func (e Expression) IsSet() bool {
+	return e.Source != ""
+}
+
+// IsCompiled checks if the expression is compiled and can be evaluated.
+func (e Expression) IsCompiled() bool {
+	return e.program != nil
+}
+
+// Eval evaluates the expression.
+func (e Expression) Eval(ctx context.Context, input ExpressionInput) (bool, error) {
+	if e.program == nil {
+		return false, fmt.Errorf("expression %q is not compiled", e.Source)
+	}
+
+	out, _, err := e.program.ContextEval(ctx, input.vars)
+	if err != nil {
+		return false, fmt.Errorf("evaluate expression: %w", err)
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression returned %s instead of bool", out.Type())
+	}
+
+	return result, nil
+}
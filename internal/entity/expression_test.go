@@ -0,0 +1,16 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileExpression_Invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, expression := range []string{`handler +`, `handler`, `unknown == 1`} {
+		_, err := CompileExpression(expression, 1000)
+		require.ErrorIs(t, err, ErrInvalidExpression, expression)
+	}
+}
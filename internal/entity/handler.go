@@ -0,0 +1,109 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// HandlerMatchMode defines how handler patterns are compared with request handlers.
+type HandlerMatchMode int
+
+const (
+	// HandlerMatchExact matches handlers equal to the pattern, case-insensitive.
+	HandlerMatchExact HandlerMatchMode = 0
+	// HandlerMatchPrefix matches handlers starting with the pattern, case-insensitive.
+	HandlerMatchPrefix HandlerMatchMode = 1
+	// HandlerMatchGlob matches handlers with a glob pattern, case-insensitive.
+	// * matches any characters except /, ** matches any characters, ? matches a single character except /.
+	HandlerMatchGlob HandlerMatchMode = 2
+	// HandlerMatchRegex matches handlers with a regular expression.
+	HandlerMatchRegex HandlerMatchMode = 3
+)
+
+// IsValid checks if the mode is valid.
+func (m HandlerMatchMode) IsValid() bool {
+	return m >= HandlerMatchExact && m <= HandlerMatchRegex
+}
+
+// HandlerCriteria defines handlers to match. Use NewHandlerCriteria to create it.
+type HandlerCriteria struct {
+	// Mode defines how the patterns are compared with request handlers.
+	Mode HandlerMatchMode
+	// Patterns are handler names, prefixes, globs or regular expressions depending on the mode.
+	// The request matches if its handler matches any of them.
+	Patterns []string
+
+	matchers []*regexp.Regexp
+}
+
+// NewHandlerCriteria compiles handler patterns.
+func NewHandlerCriteria(mode HandlerMatchMode, patterns []string) (HandlerCriteria, error) {
+	if !mode.IsValid() {
+		return HandlerCriteria{}, fmt.Errorf("%w: unknown match mode %d", ErrInvalidHandlerCriteria, mode)
+	}
+
+	if len(patterns) == 0 {
+		return HandlerCriteria{}, fmt.Errorf("%w: no handlers", ErrInvalidHandlerCriteria)
+	}
+
+	matchers := make([]*regexp.Regexp, len(patterns))
+	for i, pattern := range patterns {
+		var expr string
+		switch mode {
+		case HandlerMatchExact:
+			expr = "(?i)^" + regexp.QuoteMeta(pattern) + "$"
+		case HandlerMatchPrefix:
+			expr = "(?i)^" + regexp.QuoteMeta(pattern)
+		case HandlerMatchGlob:
+			expr = "(?i)^" + globToRegexp(pattern) + "$"
+		case HandlerMatchRegex:
+			expr = pattern
+		}
+
+		matcher, err := regexp.Compile(expr)
+		if err != nil {
+			return HandlerCriteria{}, fmt.Errorf("%w: compile handler %q: %w", ErrInvalidHandlerCriteria, pattern, err)
+		}
+		matchers[i] = matcher
+	}
+
+	return HandlerCriteria{
+		Mode:     mode,
+		Patterns: patterns,
+		matchers: matchers,
+	}, nil
+}
+
+// Match checks if the handler matches any of the patterns.
+func (c HandlerCriteria) Match(handler string) bool {
+	for _, matcher := range c.matchers {
+		if matcher.MatchString(handler) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// globToRegexp converts a glob pattern to a regular expression.
+func globToRegexp(glob string) string {
+	var sb strings.Builder
+	for i := 0; i < len(glob); i++ {
+		switch glob[i] {
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				sb.WriteString(".*")
+				i++
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		}
+	}
+
+	return sb.String()
+}
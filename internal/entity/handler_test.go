@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHandlerCriteria_Invalid(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewHandlerCriteria(HandlerMatchMode(10), []string{"test"})
+	require.ErrorIs(t, err, ErrInvalidHandlerCriteria)
+
+	_, err = NewHandlerCriteria(HandlerMatchRegex, []string{"("})
+	require.ErrorIs(t, err, ErrInvalidHandlerCriteria)
+
+	_, err = NewHandlerCriteria(HandlerMatchExact, nil)
+	require.ErrorIs(t, err, ErrInvalidHandlerCriteria)
+}
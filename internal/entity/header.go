@@ -0,0 +1,126 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// MaxHeaderGroupDepth is the maximum nesting depth of header groups.
+	MaxHeaderGroupDepth = 5
+	// MaxHeaderGroupCriteria is the maximum number of header criteria in a header group including nested groups.
+	MaxHeaderGroupCriteria = 100
+)
+
+// HeaderGroupOperator defines how results of the group members are combined.
+type HeaderGroupOperator int
+
+const (
+	// HeaderGroupUnknown is an unknown operator.
+	HeaderGroupUnknown HeaderGroupOperator = 0
+	// HeaderGroupAll matches if all members match.
+	HeaderGroupAll HeaderGroupOperator = 1
+	// HeaderGroupAny matches if at least one member matches.
+	HeaderGroupAny HeaderGroupOperator = 2
+	// HeaderGroupNone matches if no members match.
+	HeaderGroupNone HeaderGroupOperator = 3
+)
+
+// IsValid checks if the operator is valid.
+func (o HeaderGroupOperator) IsValid() bool {
+	return o >= HeaderGroupAll && o <= HeaderGroupNone
+}
+
+// HeaderGroup is a boolean group of header criteria and nested groups.
+type HeaderGroup struct {
+	// Operator combines results of the members.
+	Operator HeaderGroupOperator
+	// Criteria are header matchers of the group.
+	Criteria []HeaderCriteria
+	// Groups are nested groups.
+	Groups []HeaderGroup
+}
+
+// Validate checks the group operators and limits.
+func (g HeaderGroup) Validate() error {
+	criteria, err := g.validate(1)
+	if err != nil {
+		return err
+	}
+
+	if criteria > MaxHeaderGroupCriteria {
+		return fmt.Errorf("%w: header group has %d criteria, maximum is %d",
+			ErrInvalidHeaderGroup, criteria, MaxHeaderGroupCriteria)
+	}
+
+	return nil
+}
+
+// validate validates the group at the depth and returns the number of criteria in it.
+func (g HeaderGroup) validate(depth int) (int, error) {
+	if depth > MaxHeaderGroupDepth {
+		return 0, fmt.Errorf("%w: header groups are nested deeper than %d", ErrInvalidHeaderGroup, MaxHeaderGroupDepth)
+	}
+
+	if !g.Operator.IsValid() {
+		return 0, fmt.Errorf("%w: unknown operator %d", ErrInvalidHeaderGroup, g.Operator)
+	}
+
+	if len(g.Criteria) == 0 && len(g.Groups) == 0 {
+		return 0, fmt.Errorf("%w: header group is empty", ErrInvalidHeaderGroup)
+	}
+
+	criteria := len(g.Criteria)
+	for _, group := range g.Groups {
+		n, err := group.validate(depth + 1)
+		if err != nil {
+			return 0, err
+		}
+		criteria += n
+	}
+
+	return criteria, nil
+}
+
+// Match checks if the headers match the group.
+func (g HeaderGroup) Match(headers map[string][]string) bool {
+	matches := 0
+	for _, c := range g.Criteria {
+		if c.Match(headers) {
+			matches++
+		}
+	}
+	for _, group := range g.Groups {
+		if group.Match(headers) {
+			matches++
+		}
+	}
+
+	switch g.Operator {
+	case HeaderGroupAll:
+		return matches == len(g.Criteria)+len(g.Groups)
+	case HeaderGroupAny:
+		return matches > 0
+	case HeaderGroupNone:
+		return matches == 0
+	default:
+		return false
+	}
+}
+
+// Match checks if any value of the header matches the pattern. Header names are case-insensitive.
+func (c HeaderCriteria) Match(headers map[string][]string) bool {
+	for header, values := range headers {
+		if !strings.EqualFold(header, c.HeaderName) {
+			continue
+		}
+
+		for _, value := range values {
+			if c.Pattern.MatchString(value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
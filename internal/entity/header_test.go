@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeaderGroup_Validate(t *testing.T) {
+	t.Parallel()
+
+	criteria := HeaderCriteria{HeaderName: "region", Pattern: regexp.MustCompile("eu")}
+
+	deep := HeaderGroup{Operator: HeaderGroupAny, Criteria: []HeaderCriteria{criteria}}
+	for range MaxHeaderGroupDepth {
+		deep = HeaderGroup{Operator: HeaderGroupAll, Groups: []HeaderGroup{deep}}
+	}
+
+	large := HeaderGroup{Operator: HeaderGroupAny}
+	for range MaxHeaderGroupCriteria / 2 {
+		large.Groups = append(large.Groups, HeaderGroup{
+			Operator: HeaderGroupAny,
+			Criteria: []HeaderCriteria{criteria, criteria, criteria},
+		})
+	}
+
+	tests := []struct {
+		name  string
+		group HeaderGroup
+	}{
+		{name: "unknown operator", group: HeaderGroup{Criteria: []HeaderCriteria{criteria}}},
+		{name: "empty group", group: HeaderGroup{Operator: HeaderGroupAll}},
+		{name: "too deep", group: deep},
+		{name: "too many criteria", group: large},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.ErrorIs(t, tt.group.Validate(), ErrInvalidHeaderGroup)
+		})
+	}
+}
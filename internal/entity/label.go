@@ -0,0 +1,81 @@
+package entity
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// labelKeyPattern is the pattern of label keys, the same as in the API validation.
+var labelKeyPattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$`)
+
+// Metadata describes the collection for people, it doesn't affect collecting.
+type Metadata struct {
+	Name        string
+	Description string
+	// Owner is a person or a team responsible for the collection.
+	Owner string
+	// Labels are free-form key-value pairs to filter collections.
+	Labels map[string]string
+}
+
+// LabelOperator is the operator of a label requirement.
+type LabelOperator int
+
+const (
+	// LabelEquals matches collections with the label equal to the value.
+	LabelEquals LabelOperator = iota
+	// LabelNotEquals matches collections without the label or with the label not equal to the value.
+	LabelNotEquals
+	// LabelExists matches collections with the label.
+	LabelExists
+	// LabelNotExists matches collections without the label.
+	LabelNotExists
+)
+
+// LabelRequirement is a condition on a label of the collection.
+type LabelRequirement struct {
+	Key      string
+	Operator LabelOperator
+	// Value is not used by LabelExists and LabelNotExists.
+	Value string
+}
+
+// ParseLabelSelector parses a comma separated list of label requirements:
+// "key=value", "key!=value", "key" (the label exists) and "!key" (the label doesn't exist).
+// All requirements must match.
+func ParseLabelSelector(selector string) ([]LabelRequirement, error) {
+	if strings.TrimSpace(selector) == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(selector, ",")
+	requirements := make([]LabelRequirement, 0, len(parts))
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+
+		var requirement LabelRequirement
+		switch {
+		case strings.Contains(part, "!="):
+			key, value, _ := strings.Cut(part, "!=")
+			requirement = LabelRequirement{Key: key, Operator: LabelNotEquals, Value: value}
+		case strings.Contains(part, "="):
+			key, value, _ := strings.Cut(part, "=")
+			requirement = LabelRequirement{Key: key, Operator: LabelEquals, Value: value}
+		case strings.HasPrefix(part, "!"):
+			requirement = LabelRequirement{Key: strings.TrimPrefix(part, "!"), Operator: LabelNotExists}
+		default:
+			requirement = LabelRequirement{Key: part, Operator: LabelExists}
+		}
+
+		requirement.Key = strings.TrimSpace(requirement.Key)
+		requirement.Value = strings.TrimSpace(requirement.Value)
+		if !labelKeyPattern.MatchString(requirement.Key) {
+			return nil, fmt.Errorf("%w: invalid label key in %q", ErrInvalidLabelSelector, part)
+		}
+
+		requirements = append(requirements, requirement)
+	}
+
+	return requirements, nil
+}
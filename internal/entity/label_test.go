@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	t.Parallel()
+
+	requirements, err := ParseLabelSelector(" env = prod, team!=search,tier, !deprecated ")
+	require.NoError(t, err)
+	require.Equal(t, []LabelRequirement{
+		{Key: "env", Operator: LabelEquals, Value: "prod"},
+		{Key: "team", Operator: LabelNotEquals, Value: "search"},
+		{Key: "tier", Operator: LabelExists},
+		{Key: "deprecated", Operator: LabelNotExists},
+	}, requirements)
+
+	requirements, err = ParseLabelSelector("")
+	require.NoError(t, err)
+	require.Empty(t, requirements)
+
+	for _, selector := range []string{"env=prod,", "=prod", "!", "bad key=1"} {
+		_, err := ParseLabelSelector(selector)
+		require.ErrorIs(t, err, ErrInvalidLabelSelector, selector)
+	}
+}
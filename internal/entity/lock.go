@@ -12,6 +12,10 @@ type LockKey int64
 const (
 	// CleanUpLockKey is a key for cleanup lock.
 	CleanUpLockKey LockKey = -1
+	// PartitionLockKey is a key for requests partitions maintenance lock.
+	PartitionLockKey LockKey = -2
+	// ScheduleLockKey is a key for creating collections by schedules.
+	ScheduleLockKey LockKey = -3
 )
 
 // IUnlocker unlocks the database.
@@ -1,14 +1,27 @@
 package entity
 
 import (
+	"encoding/hex"
 	"time"
+
+	"github.com/samber/mo"
 )
 
+// BodyRef is a key of a request body stored in the object storage.
+type BodyRef string
+
+// NewBodyRef returns the key of a request body in the object storage by its content hash,
+// so the same body is always stored in the same object.
+func NewBodyRef(hash []byte) BodyRef {
+	return BodyRef("bodies/" + hex.EncodeToString(hash) + ".json")
+}
+
 // RequestContent represents stored request content.
 type RequestContent struct {
 	Handler   string              // HTTP/gRPC handler name
 	Headers   map[string][]string // Request headers
 	Body      []byte              // Request body
+	BodyRef   mo.Option[BodyRef]  // Reference to the body if it is stored in the object storage instead of database
 	CreatedAt time.Time           // Timestamp when request was received
 }
 
@@ -16,10 +29,19 @@ type RequestContent struct {
 type MatchResult struct {
 	RequestPos    int            // Position of the request in the batch
 	CollectionIDs []CollectionID // IDs of collections that match the request
+	// RuleIDs are positions of the matching rules of multi-rule collections by collection ID.
+	// After storing, only the rule that collected the request is left.
+	RuleIDs map[CollectionID][]int
+	// Strata are stratification key values of the request by ID of stratified collections.
+	Strata map[CollectionID]string
 }
 
 // RequestChunk is a chunk of collection results.
 type RequestChunk struct {
 	Data []byte
-	Err  error
+	// BodyRef is set instead of Data if the body is stored in the object storage.
+	BodyRef mo.Option[BodyRef]
+	// Tag is the name of the rule that collected the request in a multi-rule collection.
+	Tag mo.Option[string]
+	Err error
 }
@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"fmt"
+
+	"github.com/samber/mo"
+)
+
+// MaxSelectionRules is the maximum number of selection rules of a collection.
+const MaxSelectionRules = 20
+
+// SelectionRule is a named selection criteria of a multi-rule collection.
+// A request is collected by the first matching rule that has not reached its quota.
+type SelectionRule struct {
+	// Name tags requests collected by the rule in the result.
+	Name string
+	// Selection defines requests of the rule.
+	Selection MessageSelectionCriteria
+	// RequestCountLimit is the quota of the rule.
+	RequestCountLimit int
+	// Percent is the share of the collection request count limit, if the quota is set as a share.
+	Percent mo.Option[int]
+}
+
+// ResolveRuleQuotas calculates quotas of the rules set as shares of the request count limit.
+// The request count limit of the task is set to the sum of the quotas,
+// so the collection is completed when all quotas are met.
+func (t *Task) ResolveRuleQuotas() error {
+	if len(t.Rules) == 0 {
+		return nil
+	}
+
+	if t.Completion.Reservoir {
+		return fmt.Errorf("%w: reservoir sampling is not supported with rules", ErrInvalidRules)
+	}
+
+	if len(t.Rules) > MaxSelectionRules {
+		return fmt.Errorf("%w: %d rules, maximum is %d", ErrInvalidRules, len(t.Rules), MaxSelectionRules)
+	}
+
+	var (
+		names   = make(map[string]struct{}, len(t.Rules))
+		total   int
+		percent int
+	)
+	for i := range t.Rules {
+		rule := &t.Rules[i]
+
+		if rule.Name == "" {
+			return fmt.Errorf("%w: rule %d has no name", ErrInvalidRules, i)
+		}
+		if _, ok := names[rule.Name]; ok {
+			return fmt.Errorf("%w: duplicate rule name %q", ErrInvalidRules, rule.Name)
+		}
+		names[rule.Name] = struct{}{}
+
+		if rule.Selection.DistinctBodies {
+			return fmt.Errorf("%w: rule %q: distinct bodies are not supported in rules", ErrInvalidRules, rule.Name)
+		}
+
+		if p, ok := rule.Percent.Get(); ok {
+			if t.Completion.RequestCountLimit <= 0 {
+				return fmt.Errorf("%w: rule %q: share requires the request count limit", ErrInvalidRules, rule.Name)
+			}
+			percent += p
+			rule.RequestCountLimit = t.Completion.RequestCountLimit * p / 100 //nolint:mnd // percent
+		}
+
+		if rule.RequestCountLimit <= 0 {
+			return fmt.Errorf("%w: rule %q has no quota", ErrInvalidRules, rule.Name)
+		}
+
+		total += rule.RequestCountLimit
+	}
+
+	if percent > 100 { //nolint:mnd // percent
+		return fmt.Errorf("%w: rule shares sum up to %d%%", ErrInvalidRules, percent)
+	}
+
+	t.Completion.RequestCountLimit = total
+
+	return nil
+}
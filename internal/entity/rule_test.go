@@ -0,0 +1,42 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTask_ResolveRuleQuotas(t *testing.T) {
+	t.Parallel()
+
+	task := Task{
+		Rules: []SelectionRule{
+			{Name: "search", Percent: mo.Some(60)},
+			{Name: "product", Percent: mo.Some(30)},
+			{Name: "checkout", RequestCountLimit: 5},
+		},
+		Completion: CompletionCriteria{RequestCountLimit: 100},
+	}
+	require.NoError(t, task.ResolveRuleQuotas())
+	require.Equal(t, 60, task.Rules[0].RequestCountLimit)
+	require.Equal(t, 30, task.Rules[1].RequestCountLimit)
+	require.Equal(t, 5, task.Rules[2].RequestCountLimit)
+	require.Equal(t, 95, task.Completion.RequestCountLimit)
+
+	invalid := []Task{
+		{Rules: []SelectionRule{{RequestCountLimit: 1}}},
+		{Rules: []SelectionRule{{Name: "a", RequestCountLimit: 1}, {Name: "a", RequestCountLimit: 1}}},
+		{Rules: []SelectionRule{{Name: "a", Percent: mo.Some(50)}}},
+		{
+			Rules:      []SelectionRule{{Name: "a", Percent: mo.Some(60)}, {Name: "b", Percent: mo.Some(50)}},
+			Completion: CompletionCriteria{RequestCountLimit: 100},
+		},
+		{Rules: []SelectionRule{{
+			Name: "a", RequestCountLimit: 1, Selection: MessageSelectionCriteria{DistinctBodies: true},
+		}}},
+	}
+	for _, task := range invalid {
+		require.ErrorIs(t, task.ResolveRuleQuotas(), ErrInvalidRules)
+	}
+}
@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	"github.com/samber/mo"
+)
+
+// ScheduleID is a unique identifier for a schedule.
+type ScheduleID int64
+
+// String returns a string representation of the ScheduleID.
+func (id ScheduleID) String() string {
+	return strconv.FormatInt(int64(id), 10)
+}
+
+// Schedule creates collections from a task template by a cron expression.
+type Schedule struct {
+	// ID is a unique identifier of the schedule
+	ID ScheduleID
+	// Cron defines when collections are created
+	Cron CronExpression
+	// Task is the template of the created collections
+	Task Task
+	// NextRunAt is the time of the next collection
+	NextRunAt time.Time
+	// LastRunAt is the time the last collection was created
+	LastRunAt mo.Option[time.Time]
+	// LastCollectionID is the ID of the last created collection
+	LastCollectionID mo.Option[CollectionID]
+	// CreatedAt is the timestamp when schedule was created
+	CreatedAt time.Time
+}
+
+// Validate checks that collections can be created from the schedule template.
+func (s *Schedule) Validate() error {
+	if s.Task.StartAt.IsPresent() {
+		return fmt.Errorf("%w: start time is set by the schedule", ErrInvalidSchedule)
+	}
+
+	return nil
+}
+
+// CronExpression is a parsed cron expression. Use ParseCronExpression to create it.
+type CronExpression struct {
+	source   string
+	schedule cron.Schedule
+}
+
+// ParseCronExpression parses a standard cron expression with five fields (minute, hour, day of month, month,
+// day of week) or a descriptor like @daily. The expression is evaluated in UTC,
+// unless it starts with CRON_TZ=<time zone>.
+func ParseCronExpression(expression string) (CronExpression, error) {
+	spec := expression
+	if !strings.HasPrefix(spec, "CRON_TZ=") && !strings.HasPrefix(spec, "TZ=") {
+		spec = "CRON_TZ=UTC " + spec
+	}
+
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return CronExpression{}, fmt.Errorf("%w: %w", ErrInvalidSchedule, err)
+	}
+
+	return CronExpression{source: expression, schedule: schedule}, nil
+}
+
+// String returns the source of the expression.
+func (e CronExpression) String() string {
+	return e.source
+}
+
+// Next returns the first activation time after the given time.
+// Returns zero time if the expression is never activated.
+func (e CronExpression) Next(after time.Time) time.Time {
+	if e.schedule == nil {
+		return time.Time{}
+	}
+
+	return e.schedule.Next(after)
+}
+
+// ScheduleRun is a collection created by a schedule.
+type ScheduleRun struct {
+	ScheduleID   ScheduleID
+	CollectionID CollectionID
+	RunAt        time.Time
+	NextRunAt    time.Time
+}
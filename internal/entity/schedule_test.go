@@ -0,0 +1,32 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCronExpression(t *testing.T) {
+	t.Parallel()
+
+	after := time.Date(2026, 10, 19, 10, 30, 0, 0, time.UTC)
+
+	hourly, err := ParseCronExpression("0 * * * *")
+	require.NoError(t, err)
+	require.Equal(t, "0 * * * *", hourly.String())
+	require.True(t, hourly.Next(after).Equal(time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC)))
+
+	daily, err := ParseCronExpression("@daily")
+	require.NoError(t, err)
+	require.True(t, daily.Next(after).Equal(time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)))
+
+	moscow, err := ParseCronExpression("CRON_TZ=Europe/Moscow 0 9 * * *")
+	require.NoError(t, err)
+	require.True(t, moscow.Next(after).Equal(time.Date(2026, 10, 20, 6, 0, 0, 0, time.UTC)))
+
+	_, err = ParseCronExpression("* * *")
+	require.ErrorIs(t, err, ErrInvalidSchedule)
+
+	require.True(t, CronExpression{}.Next(after).IsZero())
+}
@@ -18,6 +18,8 @@ const (
 	StatusFailed
 	// StatusCancelled indicates that collection was cancelled by user.
 	StatusCancelled
+	// StatusPaused indicates that collection was paused by user and doesn't collect requests until resumed.
+	StatusPaused
 )
 
 var statusNames = [...]string{ //nolint:gochecknoglobals // ok
@@ -28,6 +30,7 @@ var statusNames = [...]string{ //nolint:gochecknoglobals // ok
 	"completed",
 	"failed",
 	"cancelled",
+	"paused",
 }
 
 func (s CollectionStatus) String() string {
@@ -40,7 +43,7 @@ func (s CollectionStatus) String() string {
 
 // IsValid checks if the status is one of the defined constants.
 func (s CollectionStatus) IsValid() bool {
-	return s > StatusUnknown && s <= StatusCancelled
+	return s > StatusUnknown && s <= StatusPaused
 }
 
 // IsTerminal returns true if the status represents a terminal state.
@@ -69,8 +72,9 @@ func CollectingCollectionStatuses() []CollectionStatus {
 }
 
 // ActiveCollectionStatuses returns a slice of collections in active states.
+// Paused collections are active, because their time limit may expire.
 func ActiveCollectionStatuses() []CollectionStatus {
-	return []CollectionStatus{StatusPending, StatusInProgress, StatusFinalizing}
+	return []CollectionStatus{StatusPending, StatusInProgress, StatusFinalizing, StatusPaused}
 }
 
 // TerminalCollectionStatuses returns a slice of collections in terminal states.
@@ -78,6 +82,18 @@ func TerminalCollectionStatuses() []CollectionStatus {
 	return []CollectionStatus{StatusCompleted, StatusFailed, StatusCancelled}
 }
 
+// ErrorCode is the reason why the collection failed.
+type ErrorCode int
+
+const (
+	// ErrorCodeUnspecified represents an unknown reason.
+	ErrorCodeUnspecified ErrorCode = iota
+	// ErrorCodeNotEnoughRequests indicates that collection has fewer requests than the minimum request count.
+	ErrorCodeNotEnoughRequests
+	// ErrorCodeFinalizationFailed indicates that the result of the collection could not be saved.
+	ErrorCodeFinalizationFailed
+)
+
 // CollectionStatusFromInt returns the CollectionStatus corresponding to the provided integer value.
 func CollectionStatusFromInt(i int) (CollectionStatus, bool) {
 	//nolint:mnd // ok
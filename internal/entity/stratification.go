@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/samber/mo"
+)
+
+// Stratification limits the number of requests collected per distinct value of a header or a body field,
+// so a single heavy client can't take the whole collection.
+type Stratification struct {
+	// HeaderName is the header that contains the stratification key. Only its first value is used.
+	HeaderName string
+	// BodyPath is the path to the stratification key in the JSON body. It is used instead of HeaderName.
+	BodyPath mo.Option[JSONPath]
+	// MaxPerValue is the maximum number of requests with the same key value.
+	MaxPerValue int
+	// MinDistinctValues is the number of distinct key values the collection must have
+	// to be completed by the request count limit. Zero means no minimum.
+	MinDistinctValues int
+}
+
+// ValidateStratification checks that the stratification can be applied to the task.
+func (t *Task) ValidateStratification() error {
+	s, ok := t.Stratification.Get()
+	if !ok {
+		return nil
+	}
+
+	if len(t.Rules) > 0 {
+		return fmt.Errorf("%w: stratification is not supported with rules", ErrInvalidStratification)
+	}
+
+	if t.Completion.Reservoir {
+		return fmt.Errorf("%w: stratification is not supported with reservoir sampling", ErrInvalidStratification)
+	}
+
+	if (s.HeaderName == "") == s.BodyPath.IsAbsent() {
+		return fmt.Errorf("%w: either header name or body path must be set", ErrInvalidStratification)
+	}
+
+	if s.MaxPerValue <= 0 {
+		return fmt.Errorf("%w: max per value must be positive", ErrInvalidStratification)
+	}
+
+	if s.MinDistinctValues < 0 || s.MinDistinctValues > t.Completion.RequestCountLimit {
+		return fmt.Errorf("%w: min distinct values %d must not exceed request count limit %d",
+			ErrInvalidStratification, s.MinDistinctValues, t.Completion.RequestCountLimit)
+	}
+
+	return nil
+}
+
+// Value returns the stratification key of the request. The body is decoded only if the key is in the body.
+// Requests without the key share the empty value.
+func (s Stratification) Value(headers map[string][]string, body func() (any, bool)) string {
+	path, ok := s.BodyPath.Get()
+	if !ok {
+		for header, values := range headers {
+			if strings.EqualFold(header, s.HeaderName) && len(values) > 0 {
+				return values[0]
+			}
+		}
+
+		return ""
+	}
+
+	doc, ok := body()
+	if !ok {
+		return ""
+	}
+
+	value, ok := path.Lookup(doc)
+	if !ok || value == nil {
+		return ""
+	}
+
+	if str, ok := value.(string); ok {
+		return str
+	}
+
+	// numbers, booleans and nested values are keyed by their JSON representation
+	data, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+
+	return string(data)
+}
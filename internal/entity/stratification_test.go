@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTask_ValidateStratification(t *testing.T) {
+	t.Parallel()
+
+	path, err := ParseJSONPath("$.user")
+	require.NoError(t, err)
+
+	completion := CompletionCriteria{RequestCountLimit: 10}
+	valid := Task{
+		Stratification: mo.Some(Stratification{HeaderName: "X-User", MaxPerValue: 2, MinDistinctValues: 5}),
+		Completion:     completion,
+	}
+	require.NoError(t, valid.ValidateStratification())
+
+	invalid := []Task{
+		{Stratification: mo.Some(Stratification{MaxPerValue: 1}), Completion: completion},
+		{
+			Stratification: mo.Some(Stratification{HeaderName: "X-User", BodyPath: mo.Some(path), MaxPerValue: 1}),
+			Completion:     completion,
+		},
+		{Stratification: mo.Some(Stratification{HeaderName: "X-User"}), Completion: completion},
+		{
+			Stratification: mo.Some(Stratification{HeaderName: "X-User", MaxPerValue: 1, MinDistinctValues: 11}),
+			Completion:     completion,
+		},
+		{
+			Stratification: mo.Some(Stratification{HeaderName: "X-User", MaxPerValue: 1}),
+			Completion:     CompletionCriteria{RequestCountLimit: 10, Reservoir: true},
+		},
+		{
+			Rules:          []SelectionRule{{Name: "a", RequestCountLimit: 1}},
+			Stratification: mo.Some(Stratification{HeaderName: "X-User", MaxPerValue: 1}),
+			Completion:     completion,
+		},
+	}
+	for _, task := range invalid {
+		require.ErrorIs(t, task.ValidateStratification(), ErrInvalidStratification)
+	}
+}
+
+func TestStratification_Value(t *testing.T) {
+	t.Parallel()
+
+	path, err := ParseJSONPath("$.user")
+	require.NoError(t, err)
+
+	byHeader := Stratification{HeaderName: "X-User"}
+	byBody := Stratification{BodyPath: mo.Some(path)}
+	decode := func(body any) func() (any, bool) {
+		return func() (any, bool) { return body, body != nil }
+	}
+
+	headers := map[string][]string{"x-user": {"alice", "bob"}}
+	require.Equal(t, "alice", byHeader.Value(headers, decode(nil)))
+	require.Empty(t, byHeader.Value(nil, decode(nil)))
+
+	require.Equal(t, "bob", byBody.Value(nil, decode(map[string]any{"user": "bob"})))
+	require.Equal(t, "7", byBody.Value(nil, decode(map[string]any{"user": float64(7)})))
+	require.Equal(t, `{"id":1}`, byBody.Value(nil, decode(map[string]any{"user": map[string]any{"id": float64(1)}})))
+	require.Empty(t, byBody.Value(nil, decode(map[string]any{})))
+	require.Empty(t, byBody.Value(headers, decode(nil)))
+}
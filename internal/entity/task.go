@@ -1,22 +1,78 @@
 package entity
 
 import (
+	"fmt"
 	"regexp"
+	"strings"
 	"time"
+
+	"github.com/samber/mo"
 )
 
 // Task contains parameters for creating a new collection.
 type Task struct {
 	MessageSelection MessageSelectionCriteria
-	Completion       CompletionCriteria
+	// Rules replace MessageSelection for collections of a traffic mix. Each rule has its own quota.
+	Rules []SelectionRule
+	// Stratification limits the number of requests per distinct value of a header or a body field.
+	Stratification mo.Option[Stratification]
+	// StartAt delays the start of collecting. The time limit is counted from it.
+	StartAt    mo.Option[time.Time]
+	Completion CompletionCriteria
+	Retention  RetentionPolicy
+	// Metadata describes the collection for people.
+	Metadata Metadata
+}
+
+// ValidateRetention checks that the collection can't be cleaned up before it is completed.
+// The retention period is counted from StartAt and extended by pauses that freeze the time limit,
+// so only the duration of collecting is checked.
+func (t *Task) ValidateRetention() error {
+	if period, ok := t.Retention.Period.Get(); ok && period <= t.Completion.MaxDuration() {
+		return fmt.Errorf("%w: retention period %s must be greater than time limit %s",
+			ErrInvalidRetention, period, t.Completion.MaxDuration())
+	}
+
+	return nil
 }
 
 // MessageSelectionCriteria defines criteria for selecting messages to collect.
 type MessageSelectionCriteria struct {
 	// Handler is the HTTP/gRPC handler to match.
 	Handler string
+	// HandlerCriteria replaces exact matching of Handler with other match modes and more handlers.
+	// Its first pattern is Handler.
+	HandlerCriteria mo.Option[HandlerCriteria]
 	// HeaderCriteria is a list of header criteria to match against request headers.
+	// The request matches if at least one of them matches.
 	HeaderCriteria []HeaderCriteria
+	// HeaderGroup is a boolean tree of header criteria. It must match in addition to HeaderCriteria.
+	HeaderGroup mo.Option[HeaderGroup]
+	// BodyCriteria is a list of criteria to match against the JSON request body. All of them must match.
+	BodyCriteria []BodyCriteria
+	// Expression is a CEL expression the request must match. It is compiled by the collection cache.
+	Expression Expression
+	// DistinctBodies collects every request body only once. Duplicates are counted, but not collected.
+	DistinctBodies bool
+	// SampleRate is the share of matching requests to collect, greater than 0 and up to 1.
+	// All matching requests are collected if it is absent.
+	SampleRate mo.Option[float64]
+	// MaxRequestsPerSecond limits the rate of collected requests on each collector instance. Zero means no limit.
+	MaxRequestsPerSecond int
+}
+
+// IsSampled checks if only a part of matching requests is collected.
+func (c MessageSelectionCriteria) IsSampled() bool {
+	return c.SampleRate.IsPresent() || c.MaxRequestsPerSecond > 0
+}
+
+// MatchHandler checks if the request handler matches the criteria.
+func (c MessageSelectionCriteria) MatchHandler(handler string) bool {
+	if hc, ok := c.HandlerCriteria.Get(); ok {
+		return hc.Match(handler)
+	}
+
+	return strings.EqualFold(handler, c.Handler)
 }
 
 // HeaderCriteria defines a single header matching Criteria.
@@ -33,4 +89,72 @@ type CompletionCriteria struct {
 	TimeLimit time.Duration
 	// RequestCountLimit defines the maximum number of requests to collect.
 	RequestCountLimit int
+	// Reservoir keeps a uniform sample of RequestCountLimit requests of the whole time limit.
+	// Collected requests are replaced by new ones according to reservoir sampling,
+	// and the collection is completed when the time limit expires.
+	Reservoir bool
+	// IdleTimeout completes the collection when no matching request arrives for this duration.
+	// Zero means no idle timeout.
+	IdleTimeout time.Duration
+	// ByteSizeLimit completes the collection when the body bytes of collected requests reach it.
+	// Zero means no limit.
+	ByteSizeLimit int64
+	// MinRequestCount is the number of requests the collection must have to be completed.
+	// Otherwise the collection fails. Zero means no minimum.
+	MinRequestCount int
+	// TimeLimitFromFirstRequest counts the time limit from the first matching request instead of the start.
+	TimeLimitFromFirstRequest bool
+	// MaxWait is the maximum time to wait for the first request, if the time limit is counted from it.
+	MaxWait time.Duration
+}
+
+// MaxDuration returns the maximum duration of collecting.
+func (c CompletionCriteria) MaxDuration() time.Duration {
+	if c.TimeLimitFromFirstRequest {
+		return c.MaxWait + c.TimeLimit
+	}
+
+	return c.TimeLimit
+}
+
+// Validate checks that the completion criteria can be combined.
+func (c CompletionCriteria) Validate() error {
+	if c.IdleTimeout < 0 || c.ByteSizeLimit < 0 {
+		return fmt.Errorf("%w: idle timeout and byte size limit must not be negative", ErrInvalidCompletion)
+	}
+
+	if c.MinRequestCount < 0 || c.MinRequestCount > c.RequestCountLimit {
+		return fmt.Errorf("%w: min request count %d must not exceed request count limit %d",
+			ErrInvalidCompletion, c.MinRequestCount, c.RequestCountLimit)
+	}
+
+	if c.TimeLimitFromFirstRequest != (c.MaxWait > 0) {
+		return fmt.Errorf("%w: max wait must be positive if and only if the time limit is counted from the first request",
+			ErrInvalidCompletion)
+	}
+
+	if c.Reservoir && c.ByteSizeLimit > 0 {
+		return fmt.Errorf("%w: byte size limit is not supported with reservoir sampling", ErrInvalidCompletion)
+	}
+
+	return nil
+}
+
+// RetentionPolicy defines how long the collection is kept before cleanup.
+type RetentionPolicy struct {
+	// Period is the retention period counted from the start of collecting (the start time of scheduled
+	// collections, the creation time of others) and extended by pauses that froze the time limit.
+	// If absent, the global retention period is used.
+	Period mo.Option[time.Duration]
+	// Pinned collections are kept indefinitely.
+	Pinned bool
+}
+
+// RetentionSettings contains global retention settings.
+// They are used for collections without own retention period.
+type RetentionSettings struct {
+	// Default is the retention period counted the same way as RetentionPolicy.Period.
+	Default time.Duration
+	// ByStatus overrides Default for terminal statuses. It is counted from the collection completion.
+	ByStatus map[CollectionStatus]time.Duration
 }
@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompletionCriteria_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, CompletionCriteria{IdleTimeout: time.Minute, ByteSizeLimit: 1024}.Validate())
+	require.NoError(t, CompletionCriteria{IdleTimeout: time.Minute, Reservoir: true}.Validate())
+	require.NoError(t, CompletionCriteria{RequestCountLimit: 10, MinRequestCount: 10}.Validate())
+	require.NoError(t, CompletionCriteria{TimeLimitFromFirstRequest: true, MaxWait: time.Minute}.Validate())
+
+	invalid := []CompletionCriteria{
+		{IdleTimeout: -time.Minute},
+		{ByteSizeLimit: -1},
+		{ByteSizeLimit: 1024, Reservoir: true},
+		{RequestCountLimit: 10, MinRequestCount: 11},
+		{RequestCountLimit: 10, MinRequestCount: -1},
+		{TimeLimitFromFirstRequest: true},
+		{MaxWait: time.Minute},
+	}
+	for _, criteria := range invalid {
+		require.ErrorIs(t, criteria.Validate(), ErrInvalidCompletion)
+	}
+}
@@ -0,0 +1,130 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/samber/mo"
+)
+
+// Fields of a collection that can be changed while it is collecting.
+const (
+	CollectionFieldRequestCountLimit = "request_count_limit"
+	CollectionFieldTimeLimit         = "time_limit"
+	CollectionFieldIdleTimeout       = "idle_timeout"
+	CollectionFieldByteSizeLimit     = "byte_size_limit"
+	CollectionFieldSelection         = "selection_criteria"
+	CollectionFieldExpression        = "expression"
+)
+
+// CollectionUpdate is a change of a collecting or paused collection. Only present fields are changed.
+type CollectionUpdate struct {
+	// Author is who requested the change. It is reported by the caller and isn't verified.
+	Author            string
+	RequestCountLimit mo.Option[int]
+	TimeLimit         mo.Option[time.Duration]
+	IdleTimeout       mo.Option[time.Duration]
+	ByteSizeLimit     mo.Option[int64]
+	// MessageSelection replaces the selection criteria. The expression is kept unless Expression is present.
+	MessageSelection mo.Option[MessageSelectionCriteria]
+	Expression       mo.Option[Expression]
+}
+
+// Fields returns the names of the changed fields.
+func (u CollectionUpdate) Fields() []string {
+	changed := []struct {
+		name    string
+		present bool
+	}{
+		{CollectionFieldRequestCountLimit, u.RequestCountLimit.IsPresent()},
+		{CollectionFieldTimeLimit, u.TimeLimit.IsPresent()},
+		{CollectionFieldIdleTimeout, u.IdleTimeout.IsPresent()},
+		{CollectionFieldByteSizeLimit, u.ByteSizeLimit.IsPresent()},
+		{CollectionFieldSelection, u.MessageSelection.IsPresent()},
+		{CollectionFieldExpression, u.Expression.IsPresent()},
+	}
+
+	var fields []string
+	for _, field := range changed {
+		if field.present {
+			fields = append(fields, field.name)
+		}
+	}
+
+	return fields
+}
+
+// Apply returns the task with the changed fields. The changed task is validated.
+func (u CollectionUpdate) Apply(task Task) (Task, error) {
+	if len(u.Fields()) == 0 {
+		return Task{}, fmt.Errorf("%w: no fields to change", ErrInvalidCollectionUpdate)
+	}
+
+	if limit, ok := u.RequestCountLimit.Get(); ok {
+		if limit <= 0 {
+			return Task{}, fmt.Errorf("%w: request count limit must be positive", ErrInvalidCollectionUpdate)
+		}
+		// the limit of a multi-rule collection is the sum of the rule quotas
+		if len(task.Rules) > 0 || task.Completion.Reservoir {
+			return Task{}, fmt.Errorf(
+				"%w: request count limit of collections with rules or reservoir sampling can't be changed",
+				ErrInvalidCollectionUpdate)
+		}
+		task.Completion.RequestCountLimit = limit
+	}
+
+	if timeLimit, ok := u.TimeLimit.Get(); ok {
+		if timeLimit <= 0 {
+			return Task{}, fmt.Errorf("%w: time limit must be positive", ErrInvalidCollectionUpdate)
+		}
+		task.Completion.TimeLimit = timeLimit
+	}
+
+	if idleTimeout, ok := u.IdleTimeout.Get(); ok {
+		task.Completion.IdleTimeout = idleTimeout
+	}
+
+	if byteSizeLimit, ok := u.ByteSizeLimit.Get(); ok {
+		task.Completion.ByteSizeLimit = byteSizeLimit
+	}
+
+	if len(task.Rules) > 0 && (u.MessageSelection.IsPresent() || u.Expression.IsPresent()) {
+		return Task{}, fmt.Errorf("%w: selection criteria of collections with rules can't be changed",
+			ErrInvalidCollectionUpdate)
+	}
+
+	if selection, ok := u.MessageSelection.Get(); ok {
+		selection.Expression = task.MessageSelection.Expression
+		task.MessageSelection = selection
+	}
+
+	if expression, ok := u.Expression.Get(); ok {
+		task.MessageSelection.Expression = expression
+	}
+
+	if err := task.Completion.Validate(); err != nil {
+		return Task{}, err
+	}
+
+	if err := task.ValidateStratification(); err != nil {
+		return Task{}, err
+	}
+
+	if err := task.ValidateRetention(); err != nil {
+		return Task{}, err
+	}
+
+	return task, nil
+}
+
+// CollectionChange is an audit record of a change of a collection.
+type CollectionChange struct {
+	CollectionID CollectionID
+	// Author is who requested the change. It is reported by the caller and isn't verified.
+	Author string
+	// Fields are the names of the changed fields.
+	Fields []string
+	// Previous and Current are the task of the collection before and after the change.
+	Previous Task
+	Current  Task
+}
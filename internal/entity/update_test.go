@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollectionUpdate_Apply(t *testing.T) {
+	t.Parallel()
+
+	expression, err := CompileExpression(`handler == "test"`, 1000)
+	require.NoError(t, err)
+
+	task := Task{
+		MessageSelection: MessageSelectionCriteria{Handler: "test", Expression: expression},
+		Completion: CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 100,
+		},
+		Retention: RetentionPolicy{Period: mo.Some(time.Hour * 3)},
+	}
+
+	update := CollectionUpdate{
+		RequestCountLimit: mo.Some(200),
+		TimeLimit:         mo.Some(time.Hour * 2),
+		MessageSelection:  mo.Some(MessageSelectionCriteria{Handler: "other"}),
+	}
+	require.Equal(t, []string{
+		CollectionFieldRequestCountLimit, CollectionFieldTimeLimit, CollectionFieldSelection,
+	}, update.Fields())
+
+	changed, err := update.Apply(task)
+	require.NoError(t, err)
+	require.Equal(t, 200, changed.Completion.RequestCountLimit)
+	require.Equal(t, time.Hour*2, changed.Completion.TimeLimit)
+	require.Equal(t, "other", changed.MessageSelection.Handler)
+	// the expression is kept
+	require.Equal(t, expression.Source, changed.MessageSelection.Expression.Source)
+	// the original task is not changed
+	require.Equal(t, 100, task.Completion.RequestCountLimit)
+
+	changed, err = CollectionUpdate{Expression: mo.Some(Expression{})}.Apply(task)
+	require.NoError(t, err)
+	require.False(t, changed.MessageSelection.Expression.IsSet())
+
+	_, err = CollectionUpdate{}.Apply(task)
+	require.ErrorIs(t, err, ErrInvalidCollectionUpdate)
+
+	_, err = CollectionUpdate{RequestCountLimit: mo.Some(0)}.Apply(task)
+	require.ErrorIs(t, err, ErrInvalidCollectionUpdate)
+
+	_, err = CollectionUpdate{IdleTimeout: mo.Some(-time.Minute)}.Apply(task)
+	require.ErrorIs(t, err, ErrInvalidCompletion)
+
+	// the collection must be kept until the end of the time limit
+	_, err = CollectionUpdate{TimeLimit: mo.Some(time.Hour * 3)}.Apply(task)
+	require.ErrorIs(t, err, ErrInvalidRetention)
+
+	reservoir := task
+	reservoir.Completion.Reservoir = true
+	_, err = CollectionUpdate{RequestCountLimit: mo.Some(10)}.Apply(reservoir)
+	require.ErrorIs(t, err, ErrInvalidCollectionUpdate)
+
+	withRules := task
+	withRules.MessageSelection = MessageSelectionCriteria{}
+	withRules.Rules = []SelectionRule{{Name: "rule", RequestCountLimit: 100}}
+	_, err = CollectionUpdate{RequestCountLimit: mo.Some(10)}.Apply(withRules)
+	require.ErrorIs(t, err, ErrInvalidCollectionUpdate)
+	_, err = CollectionUpdate{Expression: mo.Some(expression)}.Apply(withRules)
+	require.ErrorIs(t, err, ErrInvalidCollectionUpdate)
+}
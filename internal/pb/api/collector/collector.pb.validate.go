@@ -57,17 +57,6 @@ func (m *CreateTaskRequest) validate(all bool) error {
 
 	var errors []error
 
-	if m.GetSelectionCriteria() == nil {
-		err := CreateTaskRequestValidationError{
-			field:  "SelectionCriteria",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if all {
 		switch v := interface{}(m.GetSelectionCriteria()).(type) {
 		case interface{ ValidateAll() error }:
@@ -137,6 +126,241 @@ func (m *CreateTaskRequest) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetRetention()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTaskRequestValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTaskRequestValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetention()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTaskRequestValidationError{
+				field:  "Retention",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetExpression()) > 4096 {
+		err := CreateTaskRequestValidationError{
+			field:  "Expression",
+			reason: "value length must be at most 4096 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRules()) > 20 {
+		err := CreateTaskRequestValidationError{
+			field:  "Rules",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTaskRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTaskRequestValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTaskRequestValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetStratification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTaskRequestValidationError{
+					field:  "Stratification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTaskRequestValidationError{
+					field:  "Stratification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStratification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTaskRequestValidationError{
+				field:  "Stratification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTaskRequestValidationError{
+					field:  "StartAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTaskRequestValidationError{
+					field:  "StartAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTaskRequestValidationError{
+				field:  "StartAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetName()) > 256 {
+		err := CreateTaskRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 4096 {
+		err := CreateTaskRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 4096 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetOwner()) > 256 {
+		err := CreateTaskRequestValidationError{
+			field:  "Owner",
+			reason: "value length must be at most 256 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetLabels()) > 64 {
+		err := CreateTaskRequestValidationError{
+			field:  "Labels",
+			reason: "value must contain no more than 64 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetLabels()))
+		i := 0
+		for key := range m.GetLabels() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetLabels()[key]
+			_ = val
+
+			if utf8.RuneCountInString(key) > 63 {
+				err := CreateTaskRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at most 63 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if !_CreateTaskRequest_Labels_Pattern.MatchString(key) {
+				err := CreateTaskRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value does not match regex pattern \"^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$\"",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			if utf8.RuneCountInString(val) > 256 {
+				err := CreateTaskRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", key),
+					reason: "value length must be at most 256 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
 	if len(errors) > 0 {
 		return CreateTaskRequestMultiError(errors)
 	}
@@ -151,7 +375,7 @@ type CreateTaskRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
 func (m CreateTaskRequestMultiError) Error() string {
-	var msgs []string
+	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -217,32 +441,34 @@ var _ interface {
 	ErrorName() string
 } = CreateTaskRequestValidationError{}
 
-// Validate checks the field values on MessageSelectionCriteria with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *MessageSelectionCriteria) Validate() error {
+var _CreateTaskRequest_Labels_Pattern = regexp.MustCompile("^[a-zA-Z0-9]([a-zA-Z0-9._/-]*[a-zA-Z0-9])?$")
+
+// Validate checks the field values on SelectionRule with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SelectionRule) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on MessageSelectionCriteria with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// MessageSelectionCriteriaMultiError, or nil if none found.
-func (m *MessageSelectionCriteria) ValidateAll() error {
+// ValidateAll checks the field values on SelectionRule with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SelectionRuleMultiError, or
+// nil if none found.
+func (m *SelectionRule) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *MessageSelectionCriteria) validate(all bool) error {
+func (m *SelectionRule) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetHandler()); l < 1 || l > 1024 {
-		err := MessageSelectionCriteriaValidationError{
-			field:  "Handler",
-			reason: "value length must be between 1 and 1024 runes, inclusive",
+	if l := utf8.RuneCountInString(m.GetName()); l < 1 || l > 256 {
+		err := SelectionRuleValidationError{
+			field:  "Name",
+			reason: "value length must be between 1 and 256 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -250,10 +476,10 @@ func (m *MessageSelectionCriteria) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetHeaderCriteria()) > 100 {
-		err := MessageSelectionCriteriaValidationError{
-			field:  "HeaderCriteria",
-			reason: "value must contain no more than 100 item(s)",
+	if m.GetSelectionCriteria() == nil {
+		err := SelectionRuleValidationError{
+			field:  "SelectionCriteria",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -261,55 +487,114 @@ func (m *MessageSelectionCriteria) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetHeaderCriteria() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, MessageSelectionCriteriaValidationError{
-						field:  fmt.Sprintf("HeaderCriteria[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, MessageSelectionCriteriaValidationError{
-						field:  fmt.Sprintf("HeaderCriteria[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetSelectionCriteria()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SelectionRuleValidationError{
+					field:  "SelectionCriteria",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return MessageSelectionCriteriaValidationError{
-					field:  fmt.Sprintf("HeaderCriteria[%v]", idx),
+				errors = append(errors, SelectionRuleValidationError{
+					field:  "SelectionCriteria",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSelectionCriteria()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SelectionRuleValidationError{
+				field:  "SelectionCriteria",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
-	}
-
-	if len(errors) > 0 {
-		return MessageSelectionCriteriaMultiError(errors)
+	}
+
+	oneofQuotaPresent := false
+	switch v := m.Quota.(type) {
+	case *SelectionRule_RequestCountLimit:
+		if v == nil {
+			err := SelectionRuleValidationError{
+				field:  "Quota",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofQuotaPresent = true
+
+		if m.GetRequestCountLimit() <= 0 {
+			err := SelectionRuleValidationError{
+				field:  "RequestCountLimit",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *SelectionRule_Percent:
+		if v == nil {
+			err := SelectionRuleValidationError{
+				field:  "Quota",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofQuotaPresent = true
+
+		if val := m.GetPercent(); val <= 0 || val > 100 {
+			err := SelectionRuleValidationError{
+				field:  "Percent",
+				reason: "value must be inside range (0, 100]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofQuotaPresent {
+		err := SelectionRuleValidationError{
+			field:  "Quota",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SelectionRuleMultiError(errors)
 	}
 
 	return nil
 }
 
-// MessageSelectionCriteriaMultiError is an error wrapping multiple validation
-// errors returned by MessageSelectionCriteria.ValidateAll() if the designated
-// constraints aren't met.
-type MessageSelectionCriteriaMultiError []error
+// SelectionRuleMultiError is an error wrapping multiple validation errors
+// returned by SelectionRule.ValidateAll() if the designated constraints
+// aren't met.
+type SelectionRuleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m MessageSelectionCriteriaMultiError) Error() string {
-	var msgs []string
+func (m SelectionRuleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -317,11 +602,11 @@ func (m MessageSelectionCriteriaMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m MessageSelectionCriteriaMultiError) AllErrors() []error { return m }
+func (m SelectionRuleMultiError) AllErrors() []error { return m }
 
-// MessageSelectionCriteriaValidationError is the validation error returned by
-// MessageSelectionCriteria.Validate if the designated constraints aren't met.
-type MessageSelectionCriteriaValidationError struct {
+// SelectionRuleValidationError is the validation error returned by
+// SelectionRule.Validate if the designated constraints aren't met.
+type SelectionRuleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -329,24 +614,22 @@ type MessageSelectionCriteriaValidationError struct {
 }
 
 // Field function returns field value.
-func (e MessageSelectionCriteriaValidationError) Field() string { return e.field }
+func (e SelectionRuleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e MessageSelectionCriteriaValidationError) Reason() string { return e.reason }
+func (e SelectionRuleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e MessageSelectionCriteriaValidationError) Cause() error { return e.cause }
+func (e SelectionRuleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e MessageSelectionCriteriaValidationError) Key() bool { return e.key }
+func (e SelectionRuleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e MessageSelectionCriteriaValidationError) ErrorName() string {
-	return "MessageSelectionCriteriaValidationError"
-}
+func (e SelectionRuleValidationError) ErrorName() string { return "SelectionRuleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e MessageSelectionCriteriaValidationError) Error() string {
+func (e SelectionRuleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -358,14 +641,14 @@ func (e MessageSelectionCriteriaValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sMessageSelectionCriteria.%s: %s%s",
+		"invalid %sSelectionRule.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = MessageSelectionCriteriaValidationError{}
+var _ error = SelectionRuleValidationError{}
 
 var _ interface {
 	Field() string
@@ -373,33 +656,34 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = MessageSelectionCriteriaValidationError{}
+} = SelectionRuleValidationError{}
 
-// Validate checks the field values on Header with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Header) Validate() error {
+// Validate checks the field values on MessageSelectionCriteria with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MessageSelectionCriteria) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Header with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in HeaderMultiError, or nil if none found.
-func (m *Header) ValidateAll() error {
+// ValidateAll checks the field values on MessageSelectionCriteria with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MessageSelectionCriteriaMultiError, or nil if none found.
+func (m *MessageSelectionCriteria) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Header) validate(all bool) error {
+func (m *MessageSelectionCriteria) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if l := utf8.RuneCountInString(m.GetHeaderName()); l < 1 || l > 255 {
-		err := HeaderValidationError{
-			field:  "HeaderName",
-			reason: "value length must be between 1 and 255 runes, inclusive",
+	if l := utf8.RuneCountInString(m.GetHandler()); l < 1 || l > 1024 {
+		err := MessageSelectionCriteriaValidationError{
+			field:  "Handler",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -407,10 +691,169 @@ func (m *Header) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if l := utf8.RuneCountInString(m.GetPattern()); l < 1 || l > 1024 {
-		err := HeaderValidationError{
-			field:  "Pattern",
-			reason: "value length must be between 1 and 1024 runes, inclusive",
+	if len(m.GetHeaderCriteria()) > 100 {
+		err := MessageSelectionCriteriaValidationError{
+			field:  "HeaderCriteria",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetHeaderCriteria() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageSelectionCriteriaValidationError{
+						field:  fmt.Sprintf("HeaderCriteria[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageSelectionCriteriaValidationError{
+						field:  fmt.Sprintf("HeaderCriteria[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageSelectionCriteriaValidationError{
+					field:  fmt.Sprintf("HeaderCriteria[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for DistinctBodies
+
+	if len(m.GetBodyCriteria()) > 100 {
+		err := MessageSelectionCriteriaValidationError{
+			field:  "BodyCriteria",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetBodyCriteria() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MessageSelectionCriteriaValidationError{
+						field:  fmt.Sprintf("BodyCriteria[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MessageSelectionCriteriaValidationError{
+						field:  fmt.Sprintf("BodyCriteria[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MessageSelectionCriteriaValidationError{
+					field:  fmt.Sprintf("BodyCriteria[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetHeaderGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageSelectionCriteriaValidationError{
+					field:  "HeaderGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageSelectionCriteriaValidationError{
+					field:  "HeaderGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHeaderGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageSelectionCriteriaValidationError{
+				field:  "HeaderGroup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := HandlerMatchMode_name[int32(m.GetHandlerMatchMode())]; !ok {
+		err := MessageSelectionCriteriaValidationError{
+			field:  "HandlerMatchMode",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetHandlers()) > 100 {
+		err := MessageSelectionCriteriaValidationError{
+			field:  "Handlers",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetHandlers() {
+		_, _ = idx, item
+
+		if l := utf8.RuneCountInString(item); l < 1 || l > 1024 {
+			err := MessageSelectionCriteriaValidationError{
+				field:  fmt.Sprintf("Handlers[%v]", idx),
+				reason: "value length must be between 1 and 1024 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if val := m.GetSampleRate(); val < 0 || val > 1 {
+		err := MessageSelectionCriteriaValidationError{
+			field:  "SampleRate",
+			reason: "value must be inside range [0, 1]",
 		}
 		if !all {
 			return err
@@ -418,20 +861,23 @@ func (m *Header) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for MaxRequestsPerSecond
+
 	if len(errors) > 0 {
-		return HeaderMultiError(errors)
+		return MessageSelectionCriteriaMultiError(errors)
 	}
 
 	return nil
 }
 
-// HeaderMultiError is an error wrapping multiple validation errors returned by
-// Header.ValidateAll() if the designated constraints aren't met.
-type HeaderMultiError []error
+// MessageSelectionCriteriaMultiError is an error wrapping multiple validation
+// errors returned by MessageSelectionCriteria.ValidateAll() if the designated
+// constraints aren't met.
+type MessageSelectionCriteriaMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m HeaderMultiError) Error() string {
-	var msgs []string
+func (m MessageSelectionCriteriaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -439,11 +885,11 @@ func (m HeaderMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m HeaderMultiError) AllErrors() []error { return m }
+func (m MessageSelectionCriteriaMultiError) AllErrors() []error { return m }
 
-// HeaderValidationError is the validation error returned by Header.Validate if
-// the designated constraints aren't met.
-type HeaderValidationError struct {
+// MessageSelectionCriteriaValidationError is the validation error returned by
+// MessageSelectionCriteria.Validate if the designated constraints aren't met.
+type MessageSelectionCriteriaValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -451,22 +897,24 @@ type HeaderValidationError struct {
 }
 
 // Field function returns field value.
-func (e HeaderValidationError) Field() string { return e.field }
+func (e MessageSelectionCriteriaValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e HeaderValidationError) Reason() string { return e.reason }
+func (e MessageSelectionCriteriaValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e HeaderValidationError) Cause() error { return e.cause }
+func (e MessageSelectionCriteriaValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e HeaderValidationError) Key() bool { return e.key }
+func (e MessageSelectionCriteriaValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e HeaderValidationError) ErrorName() string { return "HeaderValidationError" }
+func (e MessageSelectionCriteriaValidationError) ErrorName() string {
+	return "MessageSelectionCriteriaValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e HeaderValidationError) Error() string {
+func (e MessageSelectionCriteriaValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -478,14 +926,14 @@ func (e HeaderValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sHeader.%s: %s%s",
+		"invalid %sMessageSelectionCriteria.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = HeaderValidationError{}
+var _ error = MessageSelectionCriteriaValidationError{}
 
 var _ interface {
 	Field() string
@@ -493,65 +941,101 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = HeaderValidationError{}
+} = MessageSelectionCriteriaValidationError{}
 
-// Validate checks the field values on CompletionCriteria with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CompletionCriteria) Validate() error {
+// Validate checks the field values on HeaderGroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HeaderGroup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CompletionCriteria with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CompletionCriteriaMultiError, or nil if none found.
-func (m *CompletionCriteria) ValidateAll() error {
+// ValidateAll checks the field values on HeaderGroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HeaderGroupMultiError, or
+// nil if none found.
+func (m *HeaderGroup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CompletionCriteria) validate(all bool) error {
+func (m *HeaderGroup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if d := m.GetTimeLimit(); d != nil {
-		dur, err := d.AsDuration(), d.CheckValid()
-		if err != nil {
-			err = CompletionCriteriaValidationError{
-				field:  "TimeLimit",
-				reason: "value is not a valid duration",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
+	if _, ok := _HeaderGroup_Operator_NotInLookup[m.GetOperator()]; ok {
+		err := HeaderGroupValidationError{
+			field:  "Operator",
+			reason: "value must not be in list [HEADER_GROUP_OPERATOR_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-			lte := time.Duration(86400*time.Second + 0*time.Nanosecond)
-			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+	if _, ok := HeaderGroupOperator_name[int32(m.GetOperator())]; !ok {
+		err := HeaderGroupValidationError{
+			field:  "Operator",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-			if dur <= gt || dur > lte {
-				err := CompletionCriteriaValidationError{
-					field:  "TimeLimit",
-					reason: "value must be inside range (0s, 24h0m0s]",
+	if len(m.GetHeaders()) > 100 {
+		err := HeaderGroupValidationError{
+			field:  "Headers",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetHeaders() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HeaderGroupValidationError{
+						field:  fmt.Sprintf("Headers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
 				}
-				if !all {
-					return err
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HeaderGroupValidationError{
+						field:  fmt.Sprintf("Headers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HeaderGroupValidationError{
+					field:  fmt.Sprintf("Headers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
 				}
-				errors = append(errors, err)
 			}
-
 		}
+
 	}
 
-	if m.GetRequestCountLimit() <= 0 {
-		err := CompletionCriteriaValidationError{
-			field:  "RequestCountLimit",
-			reason: "value must be greater than 0",
+	if len(m.GetGroups()) > 100 {
+		err := HeaderGroupValidationError{
+			field:  "Groups",
+			reason: "value must contain no more than 100 item(s)",
 		}
 		if !all {
 			return err
@@ -559,21 +1043,54 @@ func (m *CompletionCriteria) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	for idx, item := range m.GetGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HeaderGroupValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HeaderGroupValidationError{
+						field:  fmt.Sprintf("Groups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HeaderGroupValidationError{
+					field:  fmt.Sprintf("Groups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return CompletionCriteriaMultiError(errors)
+		return HeaderGroupMultiError(errors)
 	}
 
 	return nil
 }
 
-// CompletionCriteriaMultiError is an error wrapping multiple validation errors
-// returned by CompletionCriteria.ValidateAll() if the designated constraints
-// aren't met.
-type CompletionCriteriaMultiError []error
+// HeaderGroupMultiError is an error wrapping multiple validation errors
+// returned by HeaderGroup.ValidateAll() if the designated constraints aren't met.
+type HeaderGroupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CompletionCriteriaMultiError) Error() string {
-	var msgs []string
+func (m HeaderGroupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -581,11 +1098,11 @@ func (m CompletionCriteriaMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CompletionCriteriaMultiError) AllErrors() []error { return m }
+func (m HeaderGroupMultiError) AllErrors() []error { return m }
 
-// CompletionCriteriaValidationError is the validation error returned by
-// CompletionCriteria.Validate if the designated constraints aren't met.
-type CompletionCriteriaValidationError struct {
+// HeaderGroupValidationError is the validation error returned by
+// HeaderGroup.Validate if the designated constraints aren't met.
+type HeaderGroupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -593,24 +1110,22 @@ type CompletionCriteriaValidationError struct {
 }
 
 // Field function returns field value.
-func (e CompletionCriteriaValidationError) Field() string { return e.field }
+func (e HeaderGroupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CompletionCriteriaValidationError) Reason() string { return e.reason }
+func (e HeaderGroupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CompletionCriteriaValidationError) Cause() error { return e.cause }
+func (e HeaderGroupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CompletionCriteriaValidationError) Key() bool { return e.key }
+func (e HeaderGroupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CompletionCriteriaValidationError) ErrorName() string {
-	return "CompletionCriteriaValidationError"
-}
+func (e HeaderGroupValidationError) ErrorName() string { return "HeaderGroupValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CompletionCriteriaValidationError) Error() string {
+func (e HeaderGroupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -622,14 +1137,14 @@ func (e CompletionCriteriaValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCompletionCriteria.%s: %s%s",
+		"invalid %sHeaderGroup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CompletionCriteriaValidationError{}
+var _ error = HeaderGroupValidationError{}
 
 var _ interface {
 	Field() string
@@ -637,47 +1152,93 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CompletionCriteriaValidationError{}
+} = HeaderGroupValidationError{}
 
-// Validate checks the field values on CreateTaskResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateTaskResponse) Validate() error {
+var _HeaderGroup_Operator_NotInLookup = map[HeaderGroupOperator]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on BodyPredicate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BodyPredicate) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateTaskResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateTaskResponseMultiError, or nil if none found.
-func (m *CreateTaskResponse) ValidateAll() error {
+// ValidateAll checks the field values on BodyPredicate with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BodyPredicateMultiError, or
+// nil if none found.
+func (m *BodyPredicate) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateTaskResponse) validate(all bool) error {
+func (m *BodyPredicate) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CollectionId
+	if l := utf8.RuneCountInString(m.GetPath()); l < 1 || l > 1024 {
+		err := BodyPredicateValidationError{
+			field:  "Path",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _BodyPredicate_Operator_NotInLookup[m.GetOperator()]; ok {
+		err := BodyPredicateValidationError{
+			field:  "Operator",
+			reason: "value must not be in list [BODY_OPERATOR_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := BodyOperator_name[int32(m.GetOperator())]; !ok {
+		err := BodyPredicateValidationError{
+			field:  "Operator",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetValue()) > 1024 {
+		err := BodyPredicateValidationError{
+			field:  "Value",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return CreateTaskResponseMultiError(errors)
+		return BodyPredicateMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateTaskResponseMultiError is an error wrapping multiple validation errors
-// returned by CreateTaskResponse.ValidateAll() if the designated constraints
+// BodyPredicateMultiError is an error wrapping multiple validation errors
+// returned by BodyPredicate.ValidateAll() if the designated constraints
 // aren't met.
-type CreateTaskResponseMultiError []error
+type BodyPredicateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateTaskResponseMultiError) Error() string {
-	var msgs []string
+func (m BodyPredicateMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -685,11 +1246,11 @@ func (m CreateTaskResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateTaskResponseMultiError) AllErrors() []error { return m }
+func (m BodyPredicateMultiError) AllErrors() []error { return m }
 
-// CreateTaskResponseValidationError is the validation error returned by
-// CreateTaskResponse.Validate if the designated constraints aren't met.
-type CreateTaskResponseValidationError struct {
+// BodyPredicateValidationError is the validation error returned by
+// BodyPredicate.Validate if the designated constraints aren't met.
+type BodyPredicateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -697,24 +1258,22 @@ type CreateTaskResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateTaskResponseValidationError) Field() string { return e.field }
+func (e BodyPredicateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateTaskResponseValidationError) Reason() string { return e.reason }
+func (e BodyPredicateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateTaskResponseValidationError) Cause() error { return e.cause }
+func (e BodyPredicateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateTaskResponseValidationError) Key() bool { return e.key }
+func (e BodyPredicateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateTaskResponseValidationError) ErrorName() string {
-	return "CreateTaskResponseValidationError"
-}
+func (e BodyPredicateValidationError) ErrorName() string { return "BodyPredicateValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateTaskResponseValidationError) Error() string {
+func (e BodyPredicateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -726,14 +1285,14 @@ func (e CreateTaskResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateTaskResponse.%s: %s%s",
+		"invalid %sBodyPredicate.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateTaskResponseValidationError{}
+var _ error = BodyPredicateValidationError{}
 
 var _ interface {
 	Field() string
@@ -741,34 +1300,37 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateTaskResponseValidationError{}
+} = BodyPredicateValidationError{}
 
-// Validate checks the field values on GetCollectionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCollectionsRequest) Validate() error {
+var _BodyPredicate_Operator_NotInLookup = map[BodyOperator]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on Header with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Header) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCollectionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetCollectionsRequestMultiError, or nil if none found.
-func (m *GetCollectionsRequest) ValidateAll() error {
+// ValidateAll checks the field values on Header with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in HeaderMultiError, or nil if none found.
+func (m *Header) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCollectionsRequest) validate(all bool) error {
+func (m *Header) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(m.GetStatuses()) > 100 {
-		err := GetCollectionsRequestValidationError{
-			field:  "Statuses",
-			reason: "value must contain no more than 100 item(s)",
+	if l := utf8.RuneCountInString(m.GetHeaderName()); l < 1 || l > 255 {
+		err := HeaderValidationError{
+			field:  "HeaderName",
+			reason: "value length must be between 1 and 255 runes, inclusive",
 		}
 		if !all {
 			return err
@@ -776,59 +1338,31 @@ func (m *GetCollectionsRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetStatuses() {
-		_, _ = idx, item
-
-		if _, ok := _GetCollectionsRequest_Statuses_NotInLookup[item]; ok {
-			err := GetCollectionsRequestValidationError{
-				field:  fmt.Sprintf("Statuses[%v]", idx),
-				reason: "value must not be in list [0]",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if l := utf8.RuneCountInString(m.GetPattern()); l < 1 || l > 1024 {
+		err := HeaderValidationError{
+			field:  "Pattern",
+			reason: "value length must be between 1 and 1024 runes, inclusive",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-	}
-
-	if m.GetFromTime() == nil {
-		err := GetCollectionsRequestValidationError{
-			field:  "FromTime",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if m.GetToTime() == nil {
-		err := GetCollectionsRequestValidationError{
-			field:  "ToTime",
-			reason: "value is required",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(errors) > 0 {
-		return GetCollectionsRequestMultiError(errors)
+	if len(errors) > 0 {
+		return HeaderMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCollectionsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetCollectionsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetCollectionsRequestMultiError []error
+// HeaderMultiError is an error wrapping multiple validation errors returned by
+// Header.ValidateAll() if the designated constraints aren't met.
+type HeaderMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCollectionsRequestMultiError) Error() string {
-	var msgs []string
+func (m HeaderMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -836,11 +1370,11 @@ func (m GetCollectionsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCollectionsRequestMultiError) AllErrors() []error { return m }
+func (m HeaderMultiError) AllErrors() []error { return m }
 
-// GetCollectionsRequestValidationError is the validation error returned by
-// GetCollectionsRequest.Validate if the designated constraints aren't met.
-type GetCollectionsRequestValidationError struct {
+// HeaderValidationError is the validation error returned by Header.Validate if
+// the designated constraints aren't met.
+type HeaderValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -848,24 +1382,22 @@ type GetCollectionsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCollectionsRequestValidationError) Field() string { return e.field }
+func (e HeaderValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCollectionsRequestValidationError) Reason() string { return e.reason }
+func (e HeaderValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCollectionsRequestValidationError) Cause() error { return e.cause }
+func (e HeaderValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCollectionsRequestValidationError) Key() bool { return e.key }
+func (e HeaderValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCollectionsRequestValidationError) ErrorName() string {
-	return "GetCollectionsRequestValidationError"
-}
+func (e HeaderValidationError) ErrorName() string { return "HeaderValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetCollectionsRequestValidationError) Error() string {
+func (e HeaderValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -877,14 +1409,14 @@ func (e GetCollectionsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCollectionsRequest.%s: %s%s",
+		"invalid %sHeader.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCollectionsRequestValidationError{}
+var _ error = HeaderValidationError{}
 
 var _ interface {
 	Field() string
@@ -892,83 +1424,148 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCollectionsRequestValidationError{}
-
-var _GetCollectionsRequest_Statuses_NotInLookup = map[Status]struct{}{
-	0: {},
-}
+} = HeaderValidationError{}
 
-// Validate checks the field values on GetCollectionsResponse with the rules
+// Validate checks the field values on CompletionCriteria with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCollectionsResponse) Validate() error {
+func (m *CompletionCriteria) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCollectionsResponse with the rules
+// ValidateAll checks the field values on CompletionCriteria with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetCollectionsResponseMultiError, or nil if none found.
-func (m *GetCollectionsResponse) ValidateAll() error {
+// CompletionCriteriaMultiError, or nil if none found.
+func (m *CompletionCriteria) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCollectionsResponse) validate(all bool) error {
+func (m *CompletionCriteria) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetCollections() {
-		_, _ = idx, item
+	if d := m.GetTimeLimit(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = CompletionCriteriaValidationError{
+				field:  "TimeLimit",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetCollectionsResponseValidationError{
-						field:  fmt.Sprintf("Collections[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
+			lte := time.Duration(86400*time.Second + 0*time.Nanosecond)
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt || dur > lte {
+				err := CompletionCriteriaValidationError{
+					field:  "TimeLimit",
+					reason: "value must be inside range (0s, 24h0m0s]",
 				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetCollectionsResponseValidationError{
-						field:  fmt.Sprintf("Collections[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
+				if !all {
+					return err
 				}
+				errors = append(errors, err)
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetCollectionsResponseValidationError{
-					field:  fmt.Sprintf("Collections[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
+
+		}
+	}
+
+	// no validation rules for RequestCountLimit
+
+	// no validation rules for Reservoir
+
+	if d := m.GetIdleTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = CompletionCriteriaValidationError{
+				field:  "IdleTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			lte := time.Duration(86400*time.Second + 0*time.Nanosecond)
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte || dur > lte {
+				err := CompletionCriteriaValidationError{
+					field:  "IdleTimeout",
+					reason: "value must be inside range [0s, 24h0m0s]",
+				}
+				if !all {
+					return err
 				}
+				errors = append(errors, err)
 			}
+
 		}
+	}
+
+	// no validation rules for ByteSizeLimit
+
+	// no validation rules for MinRequestCount
+
+	// no validation rules for TimeLimitFromFirstRequest
+
+	if d := m.GetMaxWait(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = CompletionCriteriaValidationError{
+				field:  "MaxWait",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			lte := time.Duration(86400*time.Second + 0*time.Nanosecond)
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte || dur > lte {
+				err := CompletionCriteriaValidationError{
+					field:  "MaxWait",
+					reason: "value must be inside range [0s, 24h0m0s]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
 
+		}
 	}
 
 	if len(errors) > 0 {
-		return GetCollectionsResponseMultiError(errors)
+		return CompletionCriteriaMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCollectionsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetCollectionsResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetCollectionsResponseMultiError []error
+// CompletionCriteriaMultiError is an error wrapping multiple validation errors
+// returned by CompletionCriteria.ValidateAll() if the designated constraints
+// aren't met.
+type CompletionCriteriaMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCollectionsResponseMultiError) Error() string {
-	var msgs []string
+func (m CompletionCriteriaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -976,11 +1573,11 @@ func (m GetCollectionsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCollectionsResponseMultiError) AllErrors() []error { return m }
+func (m CompletionCriteriaMultiError) AllErrors() []error { return m }
 
-// GetCollectionsResponseValidationError is the validation error returned by
-// GetCollectionsResponse.Validate if the designated constraints aren't met.
-type GetCollectionsResponseValidationError struct {
+// CompletionCriteriaValidationError is the validation error returned by
+// CompletionCriteria.Validate if the designated constraints aren't met.
+type CompletionCriteriaValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -988,24 +1585,24 @@ type GetCollectionsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCollectionsResponseValidationError) Field() string { return e.field }
+func (e CompletionCriteriaValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCollectionsResponseValidationError) Reason() string { return e.reason }
+func (e CompletionCriteriaValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCollectionsResponseValidationError) Cause() error { return e.cause }
+func (e CompletionCriteriaValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCollectionsResponseValidationError) Key() bool { return e.key }
+func (e CompletionCriteriaValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCollectionsResponseValidationError) ErrorName() string {
-	return "GetCollectionsResponseValidationError"
+func (e CompletionCriteriaValidationError) ErrorName() string {
+	return "CompletionCriteriaValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetCollectionsResponseValidationError) Error() string {
+func (e CompletionCriteriaValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1017,14 +1614,14 @@ func (e GetCollectionsResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCollectionsResponse.%s: %s%s",
+		"invalid %sCompletionCriteria.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCollectionsResponseValidationError{}
+var _ error = CompletionCriteriaValidationError{}
 
 var _ interface {
 	Field() string
@@ -1032,59 +1629,134 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCollectionsResponseValidationError{}
+} = CompletionCriteriaValidationError{}
 
-// Validate checks the field values on GetCollectionRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCollectionRequest) Validate() error {
+// Validate checks the field values on Stratification with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Stratification) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCollectionRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetCollectionRequestMultiError, or nil if none found.
-func (m *GetCollectionRequest) ValidateAll() error {
+// ValidateAll checks the field values on Stratification with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StratificationMultiError,
+// or nil if none found.
+func (m *Stratification) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCollectionRequest) validate(all bool) error {
+func (m *Stratification) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CollectionId
-
-	if len(errors) > 0 {
-		return GetCollectionRequestMultiError(errors)
+	if m.GetMaxPerValue() <= 0 {
+		err := StratificationValidationError{
+			field:  "MaxPerValue",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
-	return nil
-}
+	// no validation rules for MinDistinctValues
 
-// GetCollectionRequestMultiError is an error wrapping multiple validation
-// errors returned by GetCollectionRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetCollectionRequestMultiError []error
+	oneofKeyPresent := false
+	switch v := m.Key.(type) {
+	case *Stratification_HeaderName:
+		if v == nil {
+			err := StratificationValidationError{
+				field:  "Key",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofKeyPresent = true
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m GetCollectionRequestMultiError) Error() string {
-	var msgs []string
-	for _, err := range m {
+		if l := utf8.RuneCountInString(m.GetHeaderName()); l < 1 || l > 255 {
+			err := StratificationValidationError{
+				field:  "HeaderName",
+				reason: "value length must be between 1 and 255 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *Stratification_BodyPath:
+		if v == nil {
+			err := StratificationValidationError{
+				field:  "Key",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofKeyPresent = true
+
+		if l := utf8.RuneCountInString(m.GetBodyPath()); l < 1 || l > 1024 {
+			err := StratificationValidationError{
+				field:  "BodyPath",
+				reason: "value length must be between 1 and 1024 runes, inclusive",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofKeyPresent {
+		err := StratificationValidationError{
+			field:  "Key",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StratificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// StratificationMultiError is an error wrapping multiple validation errors
+// returned by Stratification.ValidateAll() if the designated constraints
+// aren't met.
+type StratificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StratificationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
 	return strings.Join(msgs, "; ")
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCollectionRequestMultiError) AllErrors() []error { return m }
+func (m StratificationMultiError) AllErrors() []error { return m }
 
-// GetCollectionRequestValidationError is the validation error returned by
-// GetCollectionRequest.Validate if the designated constraints aren't met.
-type GetCollectionRequestValidationError struct {
+// StratificationValidationError is the validation error returned by
+// Stratification.Validate if the designated constraints aren't met.
+type StratificationValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1092,24 +1764,22 @@ type GetCollectionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCollectionRequestValidationError) Field() string { return e.field }
+func (e StratificationValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCollectionRequestValidationError) Reason() string { return e.reason }
+func (e StratificationValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCollectionRequestValidationError) Cause() error { return e.cause }
+func (e StratificationValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCollectionRequestValidationError) Key() bool { return e.key }
+func (e StratificationValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCollectionRequestValidationError) ErrorName() string {
-	return "GetCollectionRequestValidationError"
-}
+func (e StratificationValidationError) ErrorName() string { return "StratificationValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetCollectionRequestValidationError) Error() string {
+func (e StratificationValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1121,14 +1791,14 @@ func (e GetCollectionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCollectionRequest.%s: %s%s",
+		"invalid %sStratification.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCollectionRequestValidationError{}
+var _ error = StratificationValidationError{}
 
 var _ interface {
 	Field() string
@@ -1136,74 +1806,76 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCollectionRequestValidationError{}
+} = StratificationValidationError{}
 
-// Validate checks the field values on GetCollectionResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetCollectionResponse) Validate() error {
+// Validate checks the field values on Retention with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Retention) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetCollectionResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetCollectionResponseMultiError, or nil if none found.
-func (m *GetCollectionResponse) ValidateAll() error {
+// ValidateAll checks the field values on Retention with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RetentionMultiError, or nil
+// if none found.
+func (m *Retention) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetCollectionResponse) validate(all bool) error {
+func (m *Retention) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetCollection()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, GetCollectionResponseValidationError{
-					field:  "Collection",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if d := m.GetPeriod(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = RetentionValidationError{
+				field:  "Period",
+				reason: "value is not a valid duration",
+				cause:  err,
 			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, GetCollectionResponseValidationError{
-					field:  "Collection",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+			if !all {
+				return err
 			}
-		}
-	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return GetCollectionResponseValidationError{
-				field:  "Collection",
-				reason: "embedded message failed validation",
-				cause:  err,
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := RetentionValidationError{
+					field:  "Period",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
 			}
+
 		}
 	}
 
+	// no validation rules for Pinned
+
 	if len(errors) > 0 {
-		return GetCollectionResponseMultiError(errors)
+		return RetentionMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetCollectionResponseMultiError is an error wrapping multiple validation
-// errors returned by GetCollectionResponse.ValidateAll() if the designated
-// constraints aren't met.
-type GetCollectionResponseMultiError []error
+// RetentionMultiError is an error wrapping multiple validation errors returned
+// by Retention.ValidateAll() if the designated constraints aren't met.
+type RetentionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetCollectionResponseMultiError) Error() string {
-	var msgs []string
+func (m RetentionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -1211,11 +1883,11 @@ func (m GetCollectionResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetCollectionResponseMultiError) AllErrors() []error { return m }
+func (m RetentionMultiError) AllErrors() []error { return m }
 
-// GetCollectionResponseValidationError is the validation error returned by
-// GetCollectionResponse.Validate if the designated constraints aren't met.
-type GetCollectionResponseValidationError struct {
+// RetentionValidationError is the validation error returned by
+// Retention.Validate if the designated constraints aren't met.
+type RetentionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1223,24 +1895,22 @@ type GetCollectionResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetCollectionResponseValidationError) Field() string { return e.field }
+func (e RetentionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetCollectionResponseValidationError) Reason() string { return e.reason }
+func (e RetentionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetCollectionResponseValidationError) Cause() error { return e.cause }
+func (e RetentionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetCollectionResponseValidationError) Key() bool { return e.key }
+func (e RetentionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetCollectionResponseValidationError) ErrorName() string {
-	return "GetCollectionResponseValidationError"
-}
+func (e RetentionValidationError) ErrorName() string { return "RetentionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetCollectionResponseValidationError) Error() string {
+func (e RetentionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1252,14 +1922,14 @@ func (e GetCollectionResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetCollectionResponse.%s: %s%s",
+		"invalid %sRetention.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetCollectionResponseValidationError{}
+var _ error = RetentionValidationError{}
 
 var _ interface {
 	Field() string
@@ -1267,101 +1937,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetCollectionResponseValidationError{}
+} = RetentionValidationError{}
 
-// Validate checks the field values on Task with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *Task) Validate() error {
+// Validate checks the field values on CreateTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTaskResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Task with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in TaskMultiError, or nil if none found.
-func (m *Task) ValidateAll() error {
+// ValidateAll checks the field values on CreateTaskResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTaskResponseMultiError, or nil if none found.
+func (m *CreateTaskResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Task) validate(all bool) error {
+func (m *CreateTaskResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetMessageSelection()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TaskValidationError{
-					field:  "MessageSelection",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TaskValidationError{
-					field:  "MessageSelection",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMessageSelection()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TaskValidationError{
-				field:  "MessageSelection",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if all {
-		switch v := interface{}(m.GetCompletion()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, TaskValidationError{
-					field:  "Completion",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, TaskValidationError{
-					field:  "Completion",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCompletion()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return TaskValidationError{
-				field:  "Completion",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for CollectionId
 
 	if len(errors) > 0 {
-		return TaskMultiError(errors)
+		return CreateTaskResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// TaskMultiError is an error wrapping multiple validation errors returned by
-// Task.ValidateAll() if the designated constraints aren't met.
-type TaskMultiError []error
+// CreateTaskResponseMultiError is an error wrapping multiple validation errors
+// returned by CreateTaskResponse.ValidateAll() if the designated constraints
+// aren't met.
+type CreateTaskResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TaskMultiError) Error() string {
-	var msgs []string
+func (m CreateTaskResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -1369,11 +1985,11 @@ func (m TaskMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TaskMultiError) AllErrors() []error { return m }
+func (m CreateTaskResponseMultiError) AllErrors() []error { return m }
 
-// TaskValidationError is the validation error returned by Task.Validate if the
-// designated constraints aren't met.
-type TaskValidationError struct {
+// CreateTaskResponseValidationError is the validation error returned by
+// CreateTaskResponse.Validate if the designated constraints aren't met.
+type CreateTaskResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1381,22 +1997,24 @@ type TaskValidationError struct {
 }
 
 // Field function returns field value.
-func (e TaskValidationError) Field() string { return e.field }
+func (e CreateTaskResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TaskValidationError) Reason() string { return e.reason }
+func (e CreateTaskResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TaskValidationError) Cause() error { return e.cause }
+func (e CreateTaskResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TaskValidationError) Key() bool { return e.key }
+func (e CreateTaskResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TaskValidationError) ErrorName() string { return "TaskValidationError" }
+func (e CreateTaskResponseValidationError) ErrorName() string {
+	return "CreateTaskResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e TaskValidationError) Error() string {
+func (e CreateTaskResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1408,14 +2026,14 @@ func (e TaskValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTask.%s: %s%s",
+		"invalid %sCreateTaskResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TaskValidationError{}
+var _ error = CreateTaskResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1423,148 +2041,135 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TaskValidationError{}
+} = CreateTaskResponseValidationError{}
 
-// Validate checks the field values on Collection with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Collection) Validate() error {
+// Validate checks the field values on GetCollectionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCollectionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Collection with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in CollectionMultiError, or
-// nil if none found.
-func (m *Collection) ValidateAll() error {
+// ValidateAll checks the field values on GetCollectionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCollectionsRequestMultiError, or nil if none found.
+func (m *GetCollectionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Collection) validate(all bool) error {
+func (m *GetCollectionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for CollectionId
+	if len(m.GetStatuses()) > 100 {
+		err := GetCollectionsRequestValidationError{
+			field:  "Statuses",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Status
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
 
-	if all {
-		switch v := interface{}(m.GetTask()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CollectionValidationError{
-					field:  "Task",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+		if _, ok := _GetCollectionsRequest_Statuses_NotInLookup[item]; ok {
+			err := GetCollectionsRequestValidationError{
+				field:  fmt.Sprintf("Statuses[%v]", idx),
+				reason: "value must not be in list [0]",
 			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CollectionValidationError{
-					field:  "Task",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
-	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CollectionValidationError{
-				field:  "Task",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+
+	}
+
+	if m.GetFromTime() == nil {
+		err := GetCollectionsRequestValidationError{
+			field:  "FromTime",
+			reason: "value is required",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	// no validation rules for RequestCount
+	if m.GetToTime() == nil {
+		err := GetCollectionsRequestValidationError{
+			field:  "ToTime",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for ResultId
+	if utf8.RuneCountInString(m.GetLabelSelector()) > 4096 {
+		err := GetCollectionsRequestValidationError{
+			field:  "LabelSelector",
+			reason: "value length must be at most 4096 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CollectionValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CollectionValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetOwner()) > 256 {
+		err := GetCollectionsRequestValidationError{
+			field:  "Owner",
+			reason: "value length must be at most 256 runes",
 		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CollectionValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetStartedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CollectionValidationError{
-					field:  "StartedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, CollectionValidationError{
-					field:  "StartedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	if utf8.RuneCountInString(m.GetHandler()) > 1024 {
+		err := GetCollectionsRequestValidationError{
+			field:  "Handler",
+			reason: "value length must be at most 1024 runes",
 		}
-	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return CollectionValidationError{
-				field:  "StartedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
 	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		switch v := interface{}(m.GetCompletedFrom()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CollectionValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, GetCollectionsRequestValidationError{
+					field:  "CompletedFrom",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CollectionValidationError{
-					field:  "UpdatedAt",
+				errors = append(errors, GetCollectionsRequestValidationError{
+					field:  "CompletedFrom",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCompletedFrom()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CollectionValidationError{
-				field:  "UpdatedAt",
+			return GetCollectionsRequestValidationError{
+				field:  "CompletedFrom",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1572,52 +2177,82 @@ func (m *Collection) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetCompletedAt()).(type) {
+		switch v := interface{}(m.GetCompletedTo()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, CollectionValidationError{
-					field:  "CompletedAt",
+				errors = append(errors, GetCollectionsRequestValidationError{
+					field:  "CompletedTo",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, CollectionValidationError{
-					field:  "CompletedAt",
+				errors = append(errors, GetCollectionsRequestValidationError{
+					field:  "CompletedTo",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCompletedTo()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return CollectionValidationError{
-				field:  "CompletedAt",
+			return GetCollectionsRequestValidationError{
+				field:  "CompletedTo",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for ErrorMessage
+	if m.GetPageSize() > 1000 {
+		err := GetCollectionsRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 1000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for ErrorCode
+	if utf8.RuneCountInString(m.GetPageToken()) > 1024 {
+		err := GetCollectionsRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 1024 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := CollectionOrder_name[int32(m.GetOrder())]; !ok {
+		err := GetCollectionsRequestValidationError{
+			field:  "Order",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return CollectionMultiError(errors)
+		return GetCollectionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// CollectionMultiError is an error wrapping multiple validation errors
-// returned by Collection.ValidateAll() if the designated constraints aren't met.
-type CollectionMultiError []error
+// GetCollectionsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCollectionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCollectionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CollectionMultiError) Error() string {
-	var msgs []string
+func (m GetCollectionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -1625,11 +2260,2466 @@ func (m CollectionMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CollectionMultiError) AllErrors() []error { return m }
+func (m GetCollectionsRequestMultiError) AllErrors() []error { return m }
 
-// CollectionValidationError is the validation error returned by
-// Collection.Validate if the designated constraints aren't met.
-type CollectionValidationError struct {
+// GetCollectionsRequestValidationError is the validation error returned by
+// GetCollectionsRequest.Validate if the designated constraints aren't met.
+type GetCollectionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCollectionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCollectionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCollectionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCollectionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCollectionsRequestValidationError) ErrorName() string {
+	return "GetCollectionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCollectionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCollectionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCollectionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCollectionsRequestValidationError{}
+
+var _GetCollectionsRequest_Statuses_NotInLookup = map[Status]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on GetCollectionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCollectionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCollectionsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCollectionsResponseMultiError, or nil if none found.
+func (m *GetCollectionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCollectionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCollections() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetCollectionsResponseValidationError{
+						field:  fmt.Sprintf("Collections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetCollectionsResponseValidationError{
+						field:  fmt.Sprintf("Collections[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetCollectionsResponseValidationError{
+					field:  fmt.Sprintf("Collections[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for NextPageToken
+
+	if len(errors) > 0 {
+		return GetCollectionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCollectionsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCollectionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCollectionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCollectionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCollectionsResponseMultiError) AllErrors() []error { return m }
+
+// GetCollectionsResponseValidationError is the validation error returned by
+// GetCollectionsResponse.Validate if the designated constraints aren't met.
+type GetCollectionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCollectionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCollectionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCollectionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCollectionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCollectionsResponseValidationError) ErrorName() string {
+	return "GetCollectionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCollectionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCollectionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCollectionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCollectionsResponseValidationError{}
+
+// Validate checks the field values on GetCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCollectionRequestMultiError, or nil if none found.
+func (m *GetCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CollectionId
+
+	if len(errors) > 0 {
+		return GetCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by GetCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCollectionRequestMultiError) AllErrors() []error { return m }
+
+// GetCollectionRequestValidationError is the validation error returned by
+// GetCollectionRequest.Validate if the designated constraints aren't met.
+type GetCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCollectionRequestValidationError) ErrorName() string {
+	return "GetCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCollectionRequestValidationError{}
+
+// Validate checks the field values on GetCollectionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCollectionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCollectionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCollectionResponseMultiError, or nil if none found.
+func (m *GetCollectionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCollectionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCollection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetCollectionResponseValidationError{
+					field:  "Collection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCollection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetCollectionResponseValidationError{
+				field:  "Collection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetCollectionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCollectionResponseMultiError is an error wrapping multiple validation
+// errors returned by GetCollectionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetCollectionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCollectionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCollectionResponseMultiError) AllErrors() []error { return m }
+
+// GetCollectionResponseValidationError is the validation error returned by
+// GetCollectionResponse.Validate if the designated constraints aren't met.
+type GetCollectionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCollectionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCollectionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCollectionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCollectionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCollectionResponseValidationError) ErrorName() string {
+	return "GetCollectionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCollectionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCollectionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCollectionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCollectionResponseValidationError{}
+
+// Validate checks the field values on Task with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *Task) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Task with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TaskMultiError, or nil if none found.
+func (m *Task) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Task) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMessageSelection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "MessageSelection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "MessageSelection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMessageSelection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskValidationError{
+				field:  "MessageSelection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCompletion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "Completion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "Completion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCompletion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskValidationError{
+				field:  "Completion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Expression
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TaskValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TaskValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TaskValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetStratification()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "Stratification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "Stratification",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStratification()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskValidationError{
+				field:  "Stratification",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "StartAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TaskValidationError{
+					field:  "StartAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TaskValidationError{
+				field:  "StartAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Owner
+
+	// no validation rules for Labels
+
+	if len(errors) > 0 {
+		return TaskMultiError(errors)
+	}
+
+	return nil
+}
+
+// TaskMultiError is an error wrapping multiple validation errors returned by
+// Task.ValidateAll() if the designated constraints aren't met.
+type TaskMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TaskMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TaskMultiError) AllErrors() []error { return m }
+
+// TaskValidationError is the validation error returned by Task.Validate if the
+// designated constraints aren't met.
+type TaskValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TaskValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TaskValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TaskValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TaskValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TaskValidationError) ErrorName() string { return "TaskValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TaskValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTask.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TaskValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TaskValidationError{}
+
+// Validate checks the field values on Collection with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Collection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Collection with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CollectionMultiError, or
+// nil if none found.
+func (m *Collection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Collection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CollectionId
+
+	// no validation rules for Status
+
+	if all {
+		switch v := interface{}(m.GetTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionValidationError{
+				field:  "Task",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RequestCount
+
+	// no validation rules for ResultId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "StartedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionValidationError{
+				field:  "StartedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCompletedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "CompletedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCompletedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionValidationError{
+				field:  "CompletedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ErrorMessage
+
+	// no validation rules for ErrorCode
+
+	if all {
+		switch v := interface{}(m.GetRetention()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetention()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionValidationError{
+				field:  "Retention",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DuplicateCount
+
+	// no validation rules for SeenCount
+
+	// no validation rules for ByteCount
+
+	if all {
+		switch v := interface{}(m.GetLastRequestAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "LastRequestAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "LastRequestAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRequestAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionValidationError{
+				field:  "LastRequestAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPausedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "PausedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "PausedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPausedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionValidationError{
+				field:  "PausedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFrozenDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "FrozenDuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CollectionValidationError{
+					field:  "FrozenDuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFrozenDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CollectionValidationError{
+				field:  "FrozenDuration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CollectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// CollectionMultiError is an error wrapping multiple validation errors
+// returned by Collection.ValidateAll() if the designated constraints aren't met.
+type CollectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CollectionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CollectionMultiError) AllErrors() []error { return m }
+
+// CollectionValidationError is the validation error returned by
+// Collection.Validate if the designated constraints aren't met.
+type CollectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollectionValidationError) ErrorName() string { return "CollectionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CollectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollectionValidationError{}
+
+// Validate checks the field values on CancelCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelCollectionRequestMultiError, or nil if none found.
+func (m *CancelCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCollectionId() <= 0 {
+		err := CancelCollectionRequestValidationError{
+			field:  "CollectionId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by CancelCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CancelCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelCollectionRequestMultiError) AllErrors() []error { return m }
+
+// CancelCollectionRequestValidationError is the validation error returned by
+// CancelCollectionRequest.Validate if the designated constraints aren't met.
+type CancelCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelCollectionRequestValidationError) ErrorName() string {
+	return "CancelCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelCollectionRequestValidationError{}
+
+// Validate checks the field values on CompleteCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompleteCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompleteCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompleteCollectionRequestMultiError, or nil if none found.
+func (m *CompleteCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompleteCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCollectionId() <= 0 {
+		err := CompleteCollectionRequestValidationError{
+			field:  "CollectionId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CompleteCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompleteCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by CompleteCollectionRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CompleteCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompleteCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompleteCollectionRequestMultiError) AllErrors() []error { return m }
+
+// CompleteCollectionRequestValidationError is the validation error returned by
+// CompleteCollectionRequest.Validate if the designated constraints aren't met.
+type CompleteCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompleteCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompleteCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompleteCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompleteCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompleteCollectionRequestValidationError) ErrorName() string {
+	return "CompleteCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompleteCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompleteCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompleteCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompleteCollectionRequestValidationError{}
+
+// Validate checks the field values on PauseCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PauseCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PauseCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PauseCollectionRequestMultiError, or nil if none found.
+func (m *PauseCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PauseCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCollectionId() <= 0 {
+		err := PauseCollectionRequestValidationError{
+			field:  "CollectionId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for FreezeTimeLimit
+
+	if len(errors) > 0 {
+		return PauseCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PauseCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by PauseCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PauseCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PauseCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PauseCollectionRequestMultiError) AllErrors() []error { return m }
+
+// PauseCollectionRequestValidationError is the validation error returned by
+// PauseCollectionRequest.Validate if the designated constraints aren't met.
+type PauseCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PauseCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PauseCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PauseCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PauseCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PauseCollectionRequestValidationError) ErrorName() string {
+	return "PauseCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PauseCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPauseCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PauseCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PauseCollectionRequestValidationError{}
+
+// Validate checks the field values on ResumeCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResumeCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResumeCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResumeCollectionRequestMultiError, or nil if none found.
+func (m *ResumeCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResumeCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCollectionId() <= 0 {
+		err := ResumeCollectionRequestValidationError{
+			field:  "CollectionId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResumeCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResumeCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by ResumeCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ResumeCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResumeCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResumeCollectionRequestMultiError) AllErrors() []error { return m }
+
+// ResumeCollectionRequestValidationError is the validation error returned by
+// ResumeCollectionRequest.Validate if the designated constraints aren't met.
+type ResumeCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResumeCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResumeCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResumeCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResumeCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResumeCollectionRequestValidationError) ErrorName() string {
+	return "ResumeCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResumeCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResumeCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResumeCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResumeCollectionRequestValidationError{}
+
+// Validate checks the field values on UpdateCollectionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateCollectionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateCollectionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateCollectionRequestMultiError, or nil if none found.
+func (m *UpdateCollectionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateCollectionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCollectionId() <= 0 {
+		err := UpdateCollectionRequestValidationError{
+			field:  "CollectionId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetAuthor()); l < 1 || l > 256 {
+		err := UpdateCollectionRequestValidationError{
+			field:  "Author",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUpdateMask() == nil {
+		err := UpdateCollectionRequestValidationError{
+			field:  "UpdateMask",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateMask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCollectionRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCollectionRequestValidationError{
+					field:  "UpdateMask",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateMask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCollectionRequestValidationError{
+				field:  "UpdateMask",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for RequestCountLimit
+
+	if d := m.GetTimeLimit(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = UpdateCollectionRequestValidationError{
+				field:  "TimeLimit",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			lte := time.Duration(86400*time.Second + 0*time.Nanosecond)
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt || dur > lte {
+				err := UpdateCollectionRequestValidationError{
+					field:  "TimeLimit",
+					reason: "value must be inside range (0s, 24h0m0s]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetIdleTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = UpdateCollectionRequestValidationError{
+				field:  "IdleTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			lte := time.Duration(86400*time.Second + 0*time.Nanosecond)
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte || dur > lte {
+				err := UpdateCollectionRequestValidationError{
+					field:  "IdleTimeout",
+					reason: "value must be inside range [0s, 24h0m0s]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for ByteSizeLimit
+
+	if all {
+		switch v := interface{}(m.GetSelectionCriteria()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateCollectionRequestValidationError{
+					field:  "SelectionCriteria",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateCollectionRequestValidationError{
+					field:  "SelectionCriteria",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSelectionCriteria()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateCollectionRequestValidationError{
+				field:  "SelectionCriteria",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Expression
+
+	if len(errors) > 0 {
+		return UpdateCollectionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateCollectionRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateCollectionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateCollectionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateCollectionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateCollectionRequestMultiError) AllErrors() []error { return m }
+
+// UpdateCollectionRequestValidationError is the validation error returned by
+// UpdateCollectionRequest.Validate if the designated constraints aren't met.
+type UpdateCollectionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateCollectionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateCollectionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateCollectionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateCollectionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateCollectionRequestValidationError) ErrorName() string {
+	return "UpdateCollectionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateCollectionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateCollectionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateCollectionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateCollectionRequestValidationError{}
+
+// Validate checks the field values on UpdateRetentionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateRetentionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateRetentionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateRetentionRequestMultiError, or nil if none found.
+func (m *UpdateRetentionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateRetentionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCollectionId() <= 0 {
+		err := UpdateRetentionRequestValidationError{
+			field:  "CollectionId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetRetention() == nil {
+		err := UpdateRetentionRequestValidationError{
+			field:  "Retention",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetention()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateRetentionRequestValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateRetentionRequestValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetention()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateRetentionRequestValidationError{
+				field:  "Retention",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateRetentionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateRetentionRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateRetentionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateRetentionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateRetentionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateRetentionRequestMultiError) AllErrors() []error { return m }
+
+// UpdateRetentionRequestValidationError is the validation error returned by
+// UpdateRetentionRequest.Validate if the designated constraints aren't met.
+type UpdateRetentionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateRetentionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateRetentionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateRetentionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateRetentionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateRetentionRequestValidationError) ErrorName() string {
+	return "UpdateRetentionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateRetentionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateRetentionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateRetentionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateRetentionRequestValidationError{}
+
+// Validate checks the field values on GetResultRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetResultRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetResultRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetResultRequestMultiError, or nil if none found.
+func (m *GetResultRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetResultRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetCollectionId() <= 0 {
+		err := GetResultRequestValidationError{
+			field:  "CollectionId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetResultRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetResultRequestMultiError is an error wrapping multiple validation errors
+// returned by GetResultRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetResultRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetResultRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetResultRequestMultiError) AllErrors() []error { return m }
+
+// GetResultRequestValidationError is the validation error returned by
+// GetResultRequest.Validate if the designated constraints aren't met.
+type GetResultRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetResultRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetResultRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetResultRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetResultRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetResultRequestValidationError) ErrorName() string { return "GetResultRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetResultRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetResultRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetResultRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetResultRequestValidationError{}
+
+// Validate checks the field values on GetResultResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetResultResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetResultResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetResultResponseMultiError, or nil if none found.
+func (m *GetResultResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetResultResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return GetResultResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetResultResponseMultiError is an error wrapping multiple validation errors
+// returned by GetResultResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetResultResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetResultResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetResultResponseMultiError) AllErrors() []error { return m }
+
+// GetResultResponseValidationError is the validation error returned by
+// GetResultResponse.Validate if the designated constraints aren't met.
+type GetResultResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetResultResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetResultResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetResultResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetResultResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetResultResponseValidationError) ErrorName() string {
+	return "GetResultResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetResultResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetResultResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetResultResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetResultResponseValidationError{}
+
+// Validate checks the field values on CreateScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateScheduleRequestMultiError, or nil if none found.
+func (m *CreateScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetCronExpression()); l < 1 || l > 256 {
+		err := CreateScheduleRequestValidationError{
+			field:  "CronExpression",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTask() == nil {
+		err := CreateScheduleRequestValidationError{
+			field:  "Task",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateScheduleRequestValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateScheduleRequestValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateScheduleRequestValidationError{
+				field:  "Task",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateScheduleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateScheduleRequestMultiError) AllErrors() []error { return m }
+
+// CreateScheduleRequestValidationError is the validation error returned by
+// CreateScheduleRequest.Validate if the designated constraints aren't met.
+type CreateScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateScheduleRequestValidationError) ErrorName() string {
+	return "CreateScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateScheduleRequestValidationError{}
+
+// Validate checks the field values on CreateScheduleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateScheduleResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateScheduleResponseMultiError, or nil if none found.
+func (m *CreateScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ScheduleId
+
+	if len(errors) > 0 {
+		return CreateScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateScheduleResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateScheduleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateScheduleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateScheduleResponseMultiError) AllErrors() []error { return m }
+
+// CreateScheduleResponseValidationError is the validation error returned by
+// CreateScheduleResponse.Validate if the designated constraints aren't met.
+type CreateScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateScheduleResponseValidationError) ErrorName() string {
+	return "CreateScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateScheduleResponseValidationError{}
+
+// Validate checks the field values on GetSchedulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetSchedulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetSchedulesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetSchedulesRequestMultiError, or nil if none found.
+func (m *GetSchedulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetSchedulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetSchedulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetSchedulesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetSchedulesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetSchedulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetSchedulesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetSchedulesRequestMultiError) AllErrors() []error { return m }
+
+// GetSchedulesRequestValidationError is the validation error returned by
+// GetSchedulesRequest.Validate if the designated constraints aren't met.
+type GetSchedulesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1637,22 +4727,24 @@ type CollectionValidationError struct {
 }
 
 // Field function returns field value.
-func (e CollectionValidationError) Field() string { return e.field }
+func (e GetSchedulesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CollectionValidationError) Reason() string { return e.reason }
+func (e GetSchedulesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CollectionValidationError) Cause() error { return e.cause }
+func (e GetSchedulesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CollectionValidationError) Key() bool { return e.key }
+func (e GetSchedulesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CollectionValidationError) ErrorName() string { return "CollectionValidationError" }
+func (e GetSchedulesRequestValidationError) ErrorName() string {
+	return "GetSchedulesRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e CollectionValidationError) Error() string {
+func (e GetSchedulesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1664,14 +4756,14 @@ func (e CollectionValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCollection.%s: %s%s",
+		"invalid %sGetSchedulesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CollectionValidationError{}
+var _ error = GetSchedulesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1679,56 +4771,79 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CollectionValidationError{}
+} = GetSchedulesRequestValidationError{}
 
-// Validate checks the field values on CancelCollectionRequest with the rules
+// Validate checks the field values on GetSchedulesResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelCollectionRequest) Validate() error {
+func (m *GetSchedulesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelCollectionRequest with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetSchedulesResponse with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CancelCollectionRequestMultiError, or nil if none found.
-func (m *CancelCollectionRequest) ValidateAll() error {
+// GetSchedulesResponseMultiError, or nil if none found.
+func (m *GetSchedulesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelCollectionRequest) validate(all bool) error {
+func (m *GetSchedulesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetCollectionId() <= 0 {
-		err := CancelCollectionRequestValidationError{
-			field:  "CollectionId",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
+	for idx, item := range m.GetSchedules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetSchedulesResponseValidationError{
+						field:  fmt.Sprintf("Schedules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetSchedulesResponseValidationError{
+						field:  fmt.Sprintf("Schedules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetSchedulesResponseValidationError{
+					field:  fmt.Sprintf("Schedules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return CancelCollectionRequestMultiError(errors)
+		return GetSchedulesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelCollectionRequestMultiError is an error wrapping multiple validation
-// errors returned by CancelCollectionRequest.ValidateAll() if the designated
+// GetSchedulesResponseMultiError is an error wrapping multiple validation
+// errors returned by GetSchedulesResponse.ValidateAll() if the designated
 // constraints aren't met.
-type CancelCollectionRequestMultiError []error
+type GetSchedulesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelCollectionRequestMultiError) Error() string {
-	var msgs []string
+func (m GetSchedulesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -1736,11 +4851,11 @@ func (m CancelCollectionRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelCollectionRequestMultiError) AllErrors() []error { return m }
+func (m GetSchedulesResponseMultiError) AllErrors() []error { return m }
 
-// CancelCollectionRequestValidationError is the validation error returned by
-// CancelCollectionRequest.Validate if the designated constraints aren't met.
-type CancelCollectionRequestValidationError struct {
+// GetSchedulesResponseValidationError is the validation error returned by
+// GetSchedulesResponse.Validate if the designated constraints aren't met.
+type GetSchedulesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1748,24 +4863,24 @@ type CancelCollectionRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelCollectionRequestValidationError) Field() string { return e.field }
+func (e GetSchedulesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelCollectionRequestValidationError) Reason() string { return e.reason }
+func (e GetSchedulesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelCollectionRequestValidationError) Cause() error { return e.cause }
+func (e GetSchedulesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelCollectionRequestValidationError) Key() bool { return e.key }
+func (e GetSchedulesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelCollectionRequestValidationError) ErrorName() string {
-	return "CancelCollectionRequestValidationError"
+func (e GetSchedulesResponseValidationError) ErrorName() string {
+	return "GetSchedulesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CancelCollectionRequestValidationError) Error() string {
+func (e GetSchedulesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1777,14 +4892,14 @@ func (e CancelCollectionRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelCollectionRequest.%s: %s%s",
+		"invalid %sGetSchedulesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelCollectionRequestValidationError{}
+var _ error = GetSchedulesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1792,33 +4907,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelCollectionRequestValidationError{}
+} = GetSchedulesResponseValidationError{}
 
-// Validate checks the field values on GetResultRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetResultRequest) Validate() error {
+// Validate checks the field values on DeleteScheduleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteScheduleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetResultRequest with the rules
+// ValidateAll checks the field values on DeleteScheduleRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetResultRequestMultiError, or nil if none found.
-func (m *GetResultRequest) ValidateAll() error {
+// DeleteScheduleRequestMultiError, or nil if none found.
+func (m *DeleteScheduleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetResultRequest) validate(all bool) error {
+func (m *DeleteScheduleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetCollectionId() <= 0 {
-		err := GetResultRequestValidationError{
-			field:  "CollectionId",
+	if m.GetScheduleId() <= 0 {
+		err := DeleteScheduleRequestValidationError{
+			field:  "ScheduleId",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -1828,20 +4943,20 @@ func (m *GetResultRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return GetResultRequestMultiError(errors)
+		return DeleteScheduleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetResultRequestMultiError is an error wrapping multiple validation errors
-// returned by GetResultRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetResultRequestMultiError []error
+// DeleteScheduleRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteScheduleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteScheduleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetResultRequestMultiError) Error() string {
-	var msgs []string
+func (m DeleteScheduleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -1849,11 +4964,11 @@ func (m GetResultRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetResultRequestMultiError) AllErrors() []error { return m }
+func (m DeleteScheduleRequestMultiError) AllErrors() []error { return m }
 
-// GetResultRequestValidationError is the validation error returned by
-// GetResultRequest.Validate if the designated constraints aren't met.
-type GetResultRequestValidationError struct {
+// DeleteScheduleRequestValidationError is the validation error returned by
+// DeleteScheduleRequest.Validate if the designated constraints aren't met.
+type DeleteScheduleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1861,22 +4976,24 @@ type GetResultRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetResultRequestValidationError) Field() string { return e.field }
+func (e DeleteScheduleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetResultRequestValidationError) Reason() string { return e.reason }
+func (e DeleteScheduleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetResultRequestValidationError) Cause() error { return e.cause }
+func (e DeleteScheduleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetResultRequestValidationError) Key() bool { return e.key }
+func (e DeleteScheduleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetResultRequestValidationError) ErrorName() string { return "GetResultRequestValidationError" }
+func (e DeleteScheduleRequestValidationError) ErrorName() string {
+	return "DeleteScheduleRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e GetResultRequestValidationError) Error() string {
+func (e DeleteScheduleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1888,14 +5005,14 @@ func (e GetResultRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetResultRequest.%s: %s%s",
+		"invalid %sDeleteScheduleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetResultRequestValidationError{}
+var _ error = DeleteScheduleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1903,47 +5020,195 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetResultRequestValidationError{}
+} = DeleteScheduleRequestValidationError{}
 
-// Validate checks the field values on GetResultResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetResultResponse) Validate() error {
+// Validate checks the field values on Schedule with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Schedule) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetResultResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetResultResponseMultiError, or nil if none found.
-func (m *GetResultResponse) ValidateAll() error {
+// ValidateAll checks the field values on Schedule with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ScheduleMultiError, or nil
+// if none found.
+func (m *Schedule) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetResultResponse) validate(all bool) error {
+func (m *Schedule) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Content
+	// no validation rules for ScheduleId
+
+	// no validation rules for CronExpression
+
+	if all {
+		switch v := interface{}(m.GetTask()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "Task",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTask()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleValidationError{
+				field:  "Task",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRetention()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "Retention",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRetention()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleValidationError{
+				field:  "Retention",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNextRunAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "NextRunAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "NextRunAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextRunAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleValidationError{
+				field:  "NextRunAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastRunAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "LastRunAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "LastRunAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRunAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleValidationError{
+				field:  "LastRunAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastCollectionId
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return GetResultResponseMultiError(errors)
+		return ScheduleMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetResultResponseMultiError is an error wrapping multiple validation errors
-// returned by GetResultResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetResultResponseMultiError []error
+// ScheduleMultiError is an error wrapping multiple validation errors returned
+// by Schedule.ValidateAll() if the designated constraints aren't met.
+type ScheduleMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetResultResponseMultiError) Error() string {
-	var msgs []string
+func (m ScheduleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
@@ -1951,11 +5216,11 @@ func (m GetResultResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetResultResponseMultiError) AllErrors() []error { return m }
+func (m ScheduleMultiError) AllErrors() []error { return m }
 
-// GetResultResponseValidationError is the validation error returned by
-// GetResultResponse.Validate if the designated constraints aren't met.
-type GetResultResponseValidationError struct {
+// ScheduleValidationError is the validation error returned by
+// Schedule.Validate if the designated constraints aren't met.
+type ScheduleValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1963,24 +5228,22 @@ type GetResultResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetResultResponseValidationError) Field() string { return e.field }
+func (e ScheduleValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetResultResponseValidationError) Reason() string { return e.reason }
+func (e ScheduleValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetResultResponseValidationError) Cause() error { return e.cause }
+func (e ScheduleValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetResultResponseValidationError) Key() bool { return e.key }
+func (e ScheduleValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetResultResponseValidationError) ErrorName() string {
-	return "GetResultResponseValidationError"
-}
+func (e ScheduleValidationError) ErrorName() string { return "ScheduleValidationError" }
 
 // Error satisfies the builtin error interface
-func (e GetResultResponseValidationError) Error() string {
+func (e ScheduleValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1992,14 +5255,14 @@ func (e GetResultResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetResultResponse.%s: %s%s",
+		"invalid %sSchedule.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = GetResultResponseValidationError{}
+var _ error = ScheduleValidationError{}
 
 var _ interface {
 	Field() string
@@ -2007,4 +5270,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetResultResponseValidationError{}
+} = ScheduleValidationError{}
@@ -20,11 +20,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	CollectionService_CreateTask_FullMethodName       = "/ammo.collector.CollectionService/CreateTask"
-	CollectionService_GetCollections_FullMethodName   = "/ammo.collector.CollectionService/GetCollections"
-	CollectionService_GetCollection_FullMethodName    = "/ammo.collector.CollectionService/GetCollection"
-	CollectionService_CancelCollection_FullMethodName = "/ammo.collector.CollectionService/CancelCollection"
-	CollectionService_GetResult_FullMethodName        = "/ammo.collector.CollectionService/GetResult"
+	CollectionService_CreateTask_FullMethodName         = "/ammo.collector.CollectionService/CreateTask"
+	CollectionService_GetCollections_FullMethodName     = "/ammo.collector.CollectionService/GetCollections"
+	CollectionService_GetCollection_FullMethodName      = "/ammo.collector.CollectionService/GetCollection"
+	CollectionService_CancelCollection_FullMethodName   = "/ammo.collector.CollectionService/CancelCollection"
+	CollectionService_CompleteCollection_FullMethodName = "/ammo.collector.CollectionService/CompleteCollection"
+	CollectionService_PauseCollection_FullMethodName    = "/ammo.collector.CollectionService/PauseCollection"
+	CollectionService_ResumeCollection_FullMethodName   = "/ammo.collector.CollectionService/ResumeCollection"
+	CollectionService_UpdateCollection_FullMethodName   = "/ammo.collector.CollectionService/UpdateCollection"
+	CollectionService_UpdateRetention_FullMethodName    = "/ammo.collector.CollectionService/UpdateRetention"
+	CollectionService_CreateSchedule_FullMethodName     = "/ammo.collector.CollectionService/CreateSchedule"
+	CollectionService_GetSchedules_FullMethodName       = "/ammo.collector.CollectionService/GetSchedules"
+	CollectionService_DeleteSchedule_FullMethodName     = "/ammo.collector.CollectionService/DeleteSchedule"
+	CollectionService_GetResult_FullMethodName          = "/ammo.collector.CollectionService/GetResult"
 )
 
 // CollectionServiceClient is the client API for CollectionService service.
@@ -41,6 +49,22 @@ type CollectionServiceClient interface {
 	GetCollection(ctx context.Context, in *GetCollectionRequest, opts ...grpc.CallOption) (*GetCollectionResponse, error)
 	// CancelCollection terminates an active collection
 	CancelCollection(ctx context.Context, in *CancelCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CompleteCollection finalizes an active collection with the requests collected so far
+	CompleteCollection(ctx context.Context, in *CompleteCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// PauseCollection stops collecting until the collection is resumed
+	PauseCollection(ctx context.Context, in *PauseCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// ResumeCollection continues collecting of a paused collection
+	ResumeCollection(ctx context.Context, in *ResumeCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UpdateCollection changes limits and selection criteria of a pending, in progress or paused collection
+	UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// UpdateRetention changes how long a collection is kept before cleanup
+	UpdateRetention(ctx context.Context, in *UpdateRetentionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// CreateSchedule creates collections from a task template by a cron expression
+	CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error)
+	// GetSchedules returns all schedules
+	GetSchedules(ctx context.Context, in *GetSchedulesRequest, opts ...grpc.CallOption) (*GetSchedulesResponse, error)
+	// DeleteSchedule stops creating collections by a schedule
+	DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// GetResult returns the result of a collection as a stream of bytes.
 	GetResult(ctx context.Context, in *GetResultRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetResultResponse], error)
 }
@@ -93,6 +117,86 @@ func (c *collectionServiceClient) CancelCollection(ctx context.Context, in *Canc
 	return out, nil
 }
 
+func (c *collectionServiceClient) CompleteCollection(ctx context.Context, in *CompleteCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_CompleteCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) PauseCollection(ctx context.Context, in *PauseCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_PauseCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) ResumeCollection(ctx context.Context, in *ResumeCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_ResumeCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) UpdateCollection(ctx context.Context, in *UpdateCollectionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_UpdateCollection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) UpdateRetention(ctx context.Context, in *UpdateRetentionRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_UpdateRetention_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) CreateSchedule(ctx context.Context, in *CreateScheduleRequest, opts ...grpc.CallOption) (*CreateScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateScheduleResponse)
+	err := c.cc.Invoke(ctx, CollectionService_CreateSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) GetSchedules(ctx context.Context, in *GetSchedulesRequest, opts ...grpc.CallOption) (*GetSchedulesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSchedulesResponse)
+	err := c.cc.Invoke(ctx, CollectionService_GetSchedules_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *collectionServiceClient) DeleteSchedule(ctx context.Context, in *DeleteScheduleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CollectionService_DeleteSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *collectionServiceClient) GetResult(ctx context.Context, in *GetResultRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[GetResultResponse], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &CollectionService_ServiceDesc.Streams[0], CollectionService_GetResult_FullMethodName, cOpts...)
@@ -126,6 +230,22 @@ type CollectionServiceServer interface {
 	GetCollection(context.Context, *GetCollectionRequest) (*GetCollectionResponse, error)
 	// CancelCollection terminates an active collection
 	CancelCollection(context.Context, *CancelCollectionRequest) (*emptypb.Empty, error)
+	// CompleteCollection finalizes an active collection with the requests collected so far
+	CompleteCollection(context.Context, *CompleteCollectionRequest) (*emptypb.Empty, error)
+	// PauseCollection stops collecting until the collection is resumed
+	PauseCollection(context.Context, *PauseCollectionRequest) (*emptypb.Empty, error)
+	// ResumeCollection continues collecting of a paused collection
+	ResumeCollection(context.Context, *ResumeCollectionRequest) (*emptypb.Empty, error)
+	// UpdateCollection changes limits and selection criteria of a pending, in progress or paused collection
+	UpdateCollection(context.Context, *UpdateCollectionRequest) (*emptypb.Empty, error)
+	// UpdateRetention changes how long a collection is kept before cleanup
+	UpdateRetention(context.Context, *UpdateRetentionRequest) (*emptypb.Empty, error)
+	// CreateSchedule creates collections from a task template by a cron expression
+	CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error)
+	// GetSchedules returns all schedules
+	GetSchedules(context.Context, *GetSchedulesRequest) (*GetSchedulesResponse, error)
+	// DeleteSchedule stops creating collections by a schedule
+	DeleteSchedule(context.Context, *DeleteScheduleRequest) (*emptypb.Empty, error)
 	// GetResult returns the result of a collection as a stream of bytes.
 	GetResult(*GetResultRequest, grpc.ServerStreamingServer[GetResultResponse]) error
 }
@@ -149,6 +269,30 @@ func (UnimplementedCollectionServiceServer) GetCollection(context.Context, *GetC
 func (UnimplementedCollectionServiceServer) CancelCollection(context.Context, *CancelCollectionRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelCollection not implemented")
 }
+func (UnimplementedCollectionServiceServer) CompleteCollection(context.Context, *CompleteCollectionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) PauseCollection(context.Context, *PauseCollectionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) ResumeCollection(context.Context, *ResumeCollectionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) UpdateCollection(context.Context, *UpdateCollectionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollection not implemented")
+}
+func (UnimplementedCollectionServiceServer) UpdateRetention(context.Context, *UpdateRetentionRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRetention not implemented")
+}
+func (UnimplementedCollectionServiceServer) CreateSchedule(context.Context, *CreateScheduleRequest) (*CreateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSchedule not implemented")
+}
+func (UnimplementedCollectionServiceServer) GetSchedules(context.Context, *GetSchedulesRequest) (*GetSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchedules not implemented")
+}
+func (UnimplementedCollectionServiceServer) DeleteSchedule(context.Context, *DeleteScheduleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSchedule not implemented")
+}
 func (UnimplementedCollectionServiceServer) GetResult(*GetResultRequest, grpc.ServerStreamingServer[GetResultResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method GetResult not implemented")
 }
@@ -244,6 +388,150 @@ func _CollectionService_CancelCollection_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CollectionService_CompleteCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).CompleteCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_CompleteCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).CompleteCollection(ctx, req.(*CompleteCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_PauseCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).PauseCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_PauseCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).PauseCollection(ctx, req.(*PauseCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_ResumeCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).ResumeCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_ResumeCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).ResumeCollection(ctx, req.(*ResumeCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_UpdateCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).UpdateCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_UpdateCollection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).UpdateCollection(ctx, req.(*UpdateCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_UpdateRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).UpdateRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_UpdateRetention_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).UpdateRetention(ctx, req.(*UpdateRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_CreateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).CreateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_CreateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).CreateSchedule(ctx, req.(*CreateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_GetSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).GetSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_GetSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).GetSchedules(ctx, req.(*GetSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CollectionService_DeleteSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CollectionServiceServer).DeleteSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CollectionService_DeleteSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CollectionServiceServer).DeleteSchedule(ctx, req.(*DeleteScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CollectionService_GetResult_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(GetResultRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -278,6 +566,38 @@ var CollectionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelCollection",
 			Handler:    _CollectionService_CancelCollection_Handler,
 		},
+		{
+			MethodName: "CompleteCollection",
+			Handler:    _CollectionService_CompleteCollection_Handler,
+		},
+		{
+			MethodName: "PauseCollection",
+			Handler:    _CollectionService_PauseCollection_Handler,
+		},
+		{
+			MethodName: "ResumeCollection",
+			Handler:    _CollectionService_ResumeCollection_Handler,
+		},
+		{
+			MethodName: "UpdateCollection",
+			Handler:    _CollectionService_UpdateCollection_Handler,
+		},
+		{
+			MethodName: "UpdateRetention",
+			Handler:    _CollectionService_UpdateRetention_Handler,
+		},
+		{
+			MethodName: "CreateSchedule",
+			Handler:    _CollectionService_CreateSchedule_Handler,
+		},
+		{
+			MethodName: "GetSchedules",
+			Handler:    _CollectionService_GetSchedules_Handler,
+		},
+		{
+			MethodName: "DeleteSchedule",
+			Handler:    _CollectionService_DeleteSchedule_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -22,6 +22,7 @@ import (
 	"github.com/n-r-w/collector/internal/usecases/cleaner"
 	"github.com/n-r-w/collector/internal/usecases/finalizer"
 	"github.com/n-r-w/collector/internal/usecases/reqprocessor"
+	"github.com/n-r-w/collector/internal/usecases/scheduler"
 	"github.com/n-r-w/grpcsrv"
 	"github.com/n-r-w/pgh/v2/px/db"
 	"github.com/n-r-w/pgh/v2/txmgr"
@@ -41,6 +42,7 @@ type Container struct {
 	APIProcessorService        *apiprocessor.Service
 	RequestProcessorService    *reqprocessor.Service
 	CleanupService             *cleaner.Service
+	SchedulerService           *scheduler.Service
 	GRPCServer                 *grpcsrv.Service
 	GRPCHandlers               *grpchandlers.Service
 	KafkaConsumer              *consumer.Service
@@ -63,6 +65,7 @@ func InitializeContainer(
 		grpcServerSet,
 		usecasesSet,
 		kafkaConsumerSet,
+		wire.Bind(new(cleaner.IMetrics), new(telemetry.IMetrics)),
 	)
 	return nil, nil
 }
@@ -79,9 +82,13 @@ var sqlRepositorySet = wire.NewSet(
 	lockerrepo.New,
 	wire.Bind(new(finalizer.ILocker), new(*lockerrepo.Service)),
 	wire.Bind(new(cleaner.ILocker), new(*lockerrepo.Service)),
+	wire.Bind(new(scheduler.ILocker), new(*lockerrepo.Service)),
 
 	cleanerrepo.New,
 	wire.Bind(new(cleaner.IDatabaseCleaner), new(*cleanerrepo.Service)),
+	wire.Bind(new(cleaner.IPendingDeletionStorer), new(*cleanerrepo.Service)),
+	wire.Bind(new(cleaner.IReleasedBodyDeleter), new(*cleanerrepo.Service)),
+	wire.Bind(new(cleaner.IPartitionManager), new(*cleanerrepo.Service)),
 
 	colmanagerrepo.New,
 	wire.Bind(new(apiprocessor.ICollectionCreator), new(*colmanagerrepo.Service)),
@@ -91,6 +98,9 @@ var sqlRepositorySet = wire.NewSet(
 	wire.Bind(new(finalizer.ICollectionReader), new(*colmanagerrepo.Service)),
 	wire.Bind(new(cache.ICollectionReader), new(*colmanagerrepo.Service)),
 	wire.Bind(new(cleaner.ICollectionReader), new(*colmanagerrepo.Service)),
+	wire.Bind(new(apiprocessor.IScheduleStorer), new(*colmanagerrepo.Service)),
+	wire.Bind(new(scheduler.IScheduleStorer), new(*colmanagerrepo.Service)),
+	wire.Bind(new(scheduler.ICollectionCreator), new(*colmanagerrepo.Service)),
 )
 
 // DatabaseSet is a Wire provider set that includes all database dependencies.
@@ -110,6 +120,8 @@ var s3Set = wire.NewSet(
 	wire.Bind(new(finalizer.IResultChanSaver), new(*s3.Service)),
 	wire.Bind(new(apiprocessor.IResultGetter), new(*s3.Service)),
 	wire.Bind(new(cleaner.IObjectStorageCleaner), new(*s3.Service)),
+	wire.Bind(new(reqprocessor.IBodyStorer), new(*s3.Service)),
+	wire.Bind(new(finalizer.IBodyGetter), new(*s3.Service)),
 )
 
 // grpcServerSet is a Wire provider set that includes all grpc dependencies.
@@ -136,6 +148,7 @@ var usecasesSet = wire.NewSet(
 
 	finalizer.New,
 	cleaner.New,
+	scheduler.New,
 
 	reqprocessor.New,
 	wire.Bind(new(consumer.IHandlers), new(*reqprocessor.Service)),
@@ -143,4 +156,5 @@ var usecasesSet = wire.NewSet(
 	apiprocessor.New,
 	wire.Bind(new(grpchandlers.ICollectionManager), new(*apiprocessor.Service)),
 	wire.Bind(new(grpchandlers.IResultGetter), new(*apiprocessor.Service)),
+	wire.Bind(new(grpchandlers.IScheduleManager), new(*apiprocessor.Service)),
 )
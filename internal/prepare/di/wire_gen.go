@@ -24,6 +24,7 @@ import (
 	cleaner2 "github.com/n-r-w/collector/internal/usecases/cleaner"
 	"github.com/n-r-w/collector/internal/usecases/finalizer"
 	reqprocessor2 "github.com/n-r-w/collector/internal/usecases/reqprocessor"
+	"github.com/n-r-w/collector/internal/usecases/scheduler"
 	"github.com/n-r-w/grpcsrv"
 	"github.com/n-r-w/pgh/v2/px/db"
 	"github.com/n-r-w/pgh/v2/txmgr"
@@ -63,17 +64,21 @@ func InitializeContainer(ctx context.Context, cfg *config.Config, metrics teleme
 	if err != nil {
 		return nil, err
 	}
-	finalizerService, err := finalizer.New(cfg, transactionManager, colmanagerService, colmanagerService, resgetterService, s3Service, resgetterService, lockerService)
+	finalizerService, err := finalizer.New(cfg, transactionManager, colmanagerService, colmanagerService, resgetterService, s3Service, resgetterService, s3Service, lockerService)
 	if err != nil {
 		return nil, err
 	}
-	apiprocessorService := apiprocessor.New(colmanagerService, colmanagerService, colmanagerService, s3Service, transactionManager)
-	service2 := reqprocessor2.New(reqprocessorService, cacheService)
-	cleanerService, err := cleaner2.New(cfg, lockerService, colmanagerService, service, s3Service)
+	apiprocessorService := apiprocessor.New(colmanagerService, colmanagerService, colmanagerService, colmanagerService, s3Service, transactionManager)
+	service2 := reqprocessor2.New(cfg, reqprocessorService, cacheService, s3Service)
+	cleanerService, err := cleaner2.New(cfg, lockerService, colmanagerService, service, service, s3Service, service, service, metrics)
 	if err != nil {
 		return nil, err
 	}
-	handlersService := handlers.New(cfg, apiprocessorService, apiprocessorService)
+	schedulerService, err := scheduler.New(cfg, transactionManager, lockerService, colmanagerService, colmanagerService)
+	if err != nil {
+		return nil, err
+	}
+	handlersService := handlers.New(cfg, apiprocessorService, apiprocessorService, apiprocessorService)
 	v := provideGRPCInitializers(handlersService)
 	grpcsrvService := grpcsrv.New(ctx, v, grpcOpts...)
 	consumerService, err := consumer.New(ctx, cfg, service2, metrics)
@@ -93,6 +98,7 @@ func InitializeContainer(ctx context.Context, cfg *config.Config, metrics teleme
 		APIProcessorService:        apiprocessorService,
 		RequestProcessorService:    service2,
 		CleanupService:             cleanerService,
+		SchedulerService:           schedulerService,
 		GRPCServer:                 grpcsrvService,
 		GRPCHandlers:               handlersService,
 		KafkaConsumer:              consumerService,
@@ -117,6 +123,7 @@ type Container struct {
 	APIProcessorService        *apiprocessor.Service
 	RequestProcessorService    *reqprocessor2.Service
 	CleanupService             *cleaner2.Service
+	SchedulerService           *scheduler.Service
 	GRPCServer                 *grpcsrv.Service
 	GRPCHandlers               *handlers.Service
 	KafkaConsumer              *consumer.Service
@@ -124,13 +131,13 @@ type Container struct {
 }
 
 // sqlRepositorySet provides SQL repository and its interface bindings.
-var sqlRepositorySet = wire.NewSet(resgetter.New, wire.Bind(new(finalizer.IResultChanGetter), new(*resgetter.Service)), wire.Bind(new(finalizer.ICollectionResultUpdater), new(*resgetter.Service)), reqprocessor.New, wire.Bind(new(reqprocessor2.IRequestStorer), new(*reqprocessor.Service)), locker.New, wire.Bind(new(finalizer.ILocker), new(*locker.Service)), wire.Bind(new(cleaner2.ILocker), new(*locker.Service)), cleaner.New, wire.Bind(new(cleaner2.IDatabaseCleaner), new(*cleaner.Service)), colmanager.New, wire.Bind(new(apiprocessor.ICollectionCreator), new(*colmanager.Service)), wire.Bind(new(finalizer.IStatusChanger), new(*colmanager.Service)), wire.Bind(new(apiprocessor.ICollectionReader), new(*colmanager.Service)), wire.Bind(new(apiprocessor.ICollectionUpdater), new(*colmanager.Service)), wire.Bind(new(finalizer.ICollectionReader), new(*colmanager.Service)), wire.Bind(new(cache.ICollectionReader), new(*colmanager.Service)), wire.Bind(new(cleaner2.ICollectionReader), new(*colmanager.Service)))
+var sqlRepositorySet = wire.NewSet(resgetter.New, wire.Bind(new(finalizer.IResultChanGetter), new(*resgetter.Service)), wire.Bind(new(finalizer.ICollectionResultUpdater), new(*resgetter.Service)), reqprocessor.New, wire.Bind(new(reqprocessor2.IRequestStorer), new(*reqprocessor.Service)), locker.New, wire.Bind(new(finalizer.ILocker), new(*locker.Service)), wire.Bind(new(cleaner2.ILocker), new(*locker.Service)), wire.Bind(new(scheduler.ILocker), new(*locker.Service)), cleaner.New, wire.Bind(new(cleaner2.IDatabaseCleaner), new(*cleaner.Service)), wire.Bind(new(cleaner2.IPendingDeletionStorer), new(*cleaner.Service)), wire.Bind(new(cleaner2.IReleasedBodyDeleter), new(*cleaner.Service)), wire.Bind(new(cleaner2.IPartitionManager), new(*cleaner.Service)), colmanager.New, wire.Bind(new(apiprocessor.ICollectionCreator), new(*colmanager.Service)), wire.Bind(new(finalizer.IStatusChanger), new(*colmanager.Service)), wire.Bind(new(apiprocessor.ICollectionReader), new(*colmanager.Service)), wire.Bind(new(apiprocessor.ICollectionUpdater), new(*colmanager.Service)), wire.Bind(new(finalizer.ICollectionReader), new(*colmanager.Service)), wire.Bind(new(cache.ICollectionReader), new(*colmanager.Service)), wire.Bind(new(cleaner2.ICollectionReader), new(*colmanager.Service)), wire.Bind(new(apiprocessor.IScheduleStorer), new(*colmanager.Service)), wire.Bind(new(scheduler.IScheduleStorer), new(*colmanager.Service)), wire.Bind(new(scheduler.ICollectionCreator), new(*colmanager.Service)))
 
 // DatabaseSet is a Wire provider set that includes all database dependencies.
 var databaseSet = wire.NewSet(db.New, wire.Bind(new(db.IConnectionGetter), new(*db.PxDB)), wire.Bind(new(txmgr.ITransactionBeginner), new(*db.PxDB)), wire.Bind(new(txmgr.ITransactionInformer), new(*db.PxDB)), txmgr.New, wire.Bind(new(txmgr.ITransactionManager), new(*txmgr.TransactionManager)))
 
 // s3Set provides S3 storage and its interface bindings.
-var s3Set = wire.NewSet(s3.New, wire.Bind(new(finalizer.IResultChanSaver), new(*s3.Service)), wire.Bind(new(apiprocessor.IResultGetter), new(*s3.Service)), wire.Bind(new(cleaner2.IObjectStorageCleaner), new(*s3.Service)))
+var s3Set = wire.NewSet(s3.New, wire.Bind(new(finalizer.IResultChanSaver), new(*s3.Service)), wire.Bind(new(apiprocessor.IResultGetter), new(*s3.Service)), wire.Bind(new(cleaner2.IObjectStorageCleaner), new(*s3.Service)), wire.Bind(new(reqprocessor2.IBodyStorer), new(*s3.Service)), wire.Bind(new(finalizer.IBodyGetter), new(*s3.Service)))
 
 // grpcServerSet is a Wire provider set that includes all grpc dependencies.
 var grpcServerSet = wire.NewSet(handlers.New, provideGRPCInitializers, grpcsrv.New)
@@ -144,4 +151,4 @@ func provideGRPCInitializers(handlers2 *handlers.Service) []grpcsrv.IGRPCInitial
 }
 
 // usecasesSet is a Wire provider set that includes all usecases from this package.
-var usecasesSet = wire.NewSet(cache.New, wire.Bind(new(reqprocessor2.ICollectionCacher), new(*cache.Service)), finalizer.New, cleaner2.New, reqprocessor2.New, wire.Bind(new(consumer.IHandlers), new(*reqprocessor2.Service)), apiprocessor.New, wire.Bind(new(handlers.ICollectionManager), new(*apiprocessor.Service)), wire.Bind(new(handlers.IResultGetter), new(*apiprocessor.Service)))
+var usecasesSet = wire.NewSet(cache.New, wire.Bind(new(reqprocessor2.ICollectionCacher), new(*cache.Service)), finalizer.New, cleaner2.New, scheduler.New, reqprocessor2.New, wire.Bind(new(consumer.IHandlers), new(*reqprocessor2.Service)), apiprocessor.New, wire.Bind(new(handlers.ICollectionManager), new(*apiprocessor.Service)), wire.Bind(new(handlers.IResultGetter), new(*apiprocessor.Service)), wire.Bind(new(handlers.IScheduleManager), new(*apiprocessor.Service)))
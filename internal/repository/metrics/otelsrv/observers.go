@@ -10,3 +10,12 @@ func (s *Service) ObserveKafkaErrors(ctx context.Context, err error) {
 
 	s.kafkaErrors.Add(ctx, 1)
 }
+
+// ObserveObjectStorageDeleteErrors observe objects that failed to be deleted from object storage.
+func (s *Service) ObserveObjectStorageDeleteErrors(ctx context.Context, count int) {
+	if count <= 0 {
+		return
+	}
+
+	s.objectStorageDeleteErrors.Add(ctx, int64(count))
+}
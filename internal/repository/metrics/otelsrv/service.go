@@ -33,7 +33,8 @@ type Service struct {
 	clientKeyFile string
 	rootCAFile    string
 
-	kafkaErrors metric.Int64Counter
+	kafkaErrors               metric.Int64Counter
+	objectStorageDeleteErrors metric.Int64Counter
 }
 
 // New creates a new Otel service.
@@ -169,5 +170,13 @@ func (s *Service) prepareMetrics() error {
 		return fmt.Errorf("failed to create kafka_errors metric: %w", err)
 	}
 
+	s.objectStorageDeleteErrors, err = meter.Int64Counter(
+		"object_storage_delete_errors_total",
+		metric.WithDescription("number of objects that failed to be deleted from object storage"),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create object_storage_delete_errors metric: %w", err)
+	}
+
 	return nil
 }
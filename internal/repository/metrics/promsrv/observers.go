@@ -10,3 +10,12 @@ func (s *Service) ObserveKafkaErrors(_ context.Context, err error) {
 
 	s.kafkaErrors.Inc()
 }
+
+// ObserveObjectStorageDeleteErrors observe objects that failed to be deleted from object storage.
+func (s *Service) ObserveObjectStorageDeleteErrors(_ context.Context, count int) {
+	if count <= 0 {
+		return
+	}
+
+	s.objectStorageDeleteErrors.Add(float64(count))
+}
@@ -9,7 +9,8 @@ import (
 
 // Service is a service that provides Prometheus metrics.
 type Service struct {
-	kafkaErrors prometheus.Counter
+	kafkaErrors               prometheus.Counter
+	objectStorageDeleteErrors prometheus.Counter
 }
 
 // New creates a new Prometheus service.
@@ -30,4 +31,10 @@ func (s *Service) prepareMetrics() {
 			Name: "kafka_errors_total",
 			Help: "Number of kafka errors",
 		})
+
+	s.objectStorageDeleteErrors = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "object_storage_delete_errors_total",
+			Help: "Number of objects that failed to be deleted from object storage",
+		})
 }
@@ -0,0 +1,48 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	s3_api "github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/ctxlog"
+)
+
+// SaveBody stores a request body. Implements reqprocessor.IBodyStorer.SaveBody.
+// Keys are derived from the body content, so saving the same body again overwrites the object with the same data.
+func (s *Service) SaveBody(ctx context.Context, ref entity.BodyRef, body []byte) error {
+	_, err := s.client.PutObject(ctx, &s3_api.PutObjectInput{
+		Bucket:        aws.String(s.cfg.S3.Bucket),
+		Key:           aws.String(string(ref)),
+		Body:          bytes.NewReader(body),
+		ContentLength: aws.Int64(int64(len(body))),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to put body %s: %w", ref, err)
+	}
+
+	return nil
+}
+
+// GetBody returns a request body. Implements finalizer.IBodyGetter.GetBody.
+func (s *Service) GetBody(ctx context.Context, ref entity.BodyRef) ([]byte, error) {
+	resp, err := s.client.GetObject(ctx, &s3_api.GetObjectInput{
+		Bucket: aws.String(s.cfg.S3.Bucket),
+		Key:    aws.String(string(ref)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get body %s: %w", ref, err)
+	}
+	defer ctxlog.CloseError(ctx, resp.Body)
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body %s: %w", ref, err)
+	}
+
+	return body, nil
+}
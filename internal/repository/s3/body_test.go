@@ -0,0 +1,27 @@
+package s3
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_SaveBody(t *testing.T) {
+	s, _, ctx := setupTest(t)
+
+	body := []byte(`{"test": "` + strings.Repeat("a", 1024) + `"}`)
+	ref := entity.NewBodyRef(entity.HashBody(body))
+
+	require.NoError(t, s.SaveBody(ctx, ref, body))
+	// the same body is saved to the same object
+	require.NoError(t, s.SaveBody(ctx, ref, body))
+
+	got, err := s.GetBody(ctx, ref)
+	require.NoError(t, err)
+	require.Equal(t, body, got)
+
+	_, err = s.GetBody(ctx, "bodies/missing.json")
+	require.Error(t, err)
+}
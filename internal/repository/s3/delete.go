@@ -3,25 +3,101 @@ package s3
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	s3_api "github.com/aws/aws-sdk-go-v2/service/s3"
+	s3_types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/n-r-w/collector/internal/entity"
 	"github.com/n-r-w/ctxlog"
+	"github.com/samber/lo"
 )
 
-// CleanObjectStorage deletes the result of a collection.
-func (s *Service) CleanObjectStorage(ctx context.Context, resultIDs []entity.ResultID) error {
-	// TODO: DeleteObjects causes a `MissingContentMD5` error and how to fix it is not clear yet
+// maxDeleteBatchSize is the maximum number of keys allowed in a single DeleteObjects request.
+const maxDeleteBatchSize = 1000
+
+// CleanObjectStorage deletes the results of collections.
+// Returns the objects that could not be deleted, so they can be retried later.
+func (s *Service) CleanObjectStorage(
+	ctx context.Context, resultIDs []entity.ResultID,
+) ([]entity.DeletionFailure, error) {
+	var failures []entity.DeletionFailure
+	for _, batch := range lo.Chunk(resultIDs, s.cfg.S3.DeleteBatchSize) {
+		batchFailures, err := s.deleteBatch(ctx, batch)
+		if err != nil {
+			return nil, err
+		}
+		failures = append(failures, batchFailures...)
+	}
+
+	return failures, nil
+}
+
+// deleteBatch deletes a batch of objects with a single DeleteObjects request.
+// Falls back to single deletions if the storage doesn't support batch deletion.
+func (s *Service) deleteBatch(ctx context.Context, resultIDs []entity.ResultID) ([]entity.DeletionFailure, error) {
+	objects := lo.Map(resultIDs, func(id entity.ResultID, _ int) s3_types.ObjectIdentifier {
+		return s3_types.ObjectIdentifier{Key: aws.String(string(id))}
+	})
+
+	out, err := s.client.DeleteObjects(ctx, &s3_api.DeleteObjectsInput{
+		Bucket: aws.String(s.cfg.S3.Bucket),
+		Delete: &s3_types.Delete{
+			Objects: objects,
+			Quiet:   aws.Bool(true),
+		},
+	}, s3_api.WithAPIOptions(
+		// DeleteObjects requires Content-MD5 header. The SDK sends only flexible checksums,
+		// which are not supported by all S3 compatible storages (MissingContentMD5 error).
+		smithyhttp.AddContentChecksumMiddleware,
+	))
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+
+		ctxlog.Warn(ctx, "failed to delete objects from S3 in batch, falling back to single deletions",
+			slog.Int("count", len(resultIDs)), slog.Any("error", err))
+
+		return s.deleteSingle(ctx, resultIDs)
+	}
+
+	failures := make([]entity.DeletionFailure, 0, len(out.Errors))
+	for _, e := range out.Errors {
+		if aws.ToString(e.Code) == "NoSuchKey" {
+			continue
+		}
+
+		failures = append(failures, entity.DeletionFailure{
+			ResultID: entity.ResultID(aws.ToString(e.Key)),
+			Reason:   fmt.Sprintf("%s: %s", aws.ToString(e.Code), aws.ToString(e.Message)),
+		})
+	}
+
+	return failures, nil
+}
+
+// deleteSingle deletes objects one by one.
+func (s *Service) deleteSingle(ctx context.Context, resultIDs []entity.ResultID) ([]entity.DeletionFailure, error) {
+	var failures []entity.DeletionFailure
 	for _, id := range resultIDs {
 		_, err := s.client.DeleteObject(ctx, &s3_api.DeleteObjectInput{
 			Bucket: aws.String(s.cfg.S3.Bucket),
 			Key:    aws.String(string(id)),
 		})
-		if err != nil && !errors.Is(err, context.Canceled) {
-			ctxlog.Error(ctx, "failed to delete object from S3", slog.Any("id", id), slog.Any("error", err))
+		if err != nil {
+			if errors.Is(err, context.Canceled) {
+				return nil, err
+			}
+
+			failures = append(failures, entity.DeletionFailure{
+				ResultID: id,
+				Reason:   err.Error(),
+			})
 		}
 	}
-	return nil
+
+	return failures, nil
 }
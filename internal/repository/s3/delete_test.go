@@ -12,6 +12,7 @@ import (
 
 func TestService_CleanObjectStorage(t *testing.T) {
 	s, cfg, ctx := setupTest(t)
+	cfg.S3.DeleteBatchSize = 2
 
 	tests := []struct {
 		name      string
@@ -23,6 +24,11 @@ func TestService_CleanObjectStorage(t *testing.T) {
 			resultIDs: []entity.ResultID{"test1", "test2"},
 			err:       false,
 		},
+		{
+			name:      "more than one batch",
+			resultIDs: []entity.ResultID{"test3", "test4", "test5"},
+			err:       false,
+		},
 		{
 			name:      "empty result IDs",
 			resultIDs: []entity.ResultID{},
@@ -42,11 +48,21 @@ func TestService_CleanObjectStorage(t *testing.T) {
 				require.NoError(t, err)
 			}
 
-			err := s.CleanObjectStorage(ctx, tt.resultIDs)
+			failures, err := s.CleanObjectStorage(ctx, tt.resultIDs)
 			if tt.err {
 				require.Error(t, err)
 			} else {
 				require.NoError(t, err)
+				require.Empty(t, failures)
+			}
+
+			// Check that objects are deleted
+			for _, id := range tt.resultIDs {
+				_, err := s.client.HeadObject(ctx, &s3.HeadObjectInput{
+					Bucket: aws.String(cfg.S3.Bucket),
+					Key:    aws.String(string(id)),
+				})
+				require.Error(t, err)
 			}
 		})
 	}
@@ -4,6 +4,7 @@ import (
 	"archive/zip"
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -174,8 +175,7 @@ func (s *Service) processIncomingData(
 		firstPart = false
 
 		// Write data to zip file
-		_, err = zipFile.Write(r.Data)
-		if err != nil {
+		if err = writeChunk(zipFile, r); err != nil {
 			return fmt.Errorf("failed to write to zip file: %w", err)
 		}
 
@@ -243,3 +243,26 @@ func (s *Service) uploadBuffer(
 	*partNumber++
 	return nil
 }
+
+// writeChunk writes the request body. Requests of multi-rule collections are wrapped
+// into an object with the rule name: {"tag":"<rule>","body":<body>}.
+func writeChunk(w io.Writer, r entity.RequestChunk) error {
+	tag, ok := r.Tag.Get()
+	if !ok {
+		_, err := w.Write(r.Data)
+		return err
+	}
+
+	tagJSON, err := json.Marshal(tag)
+	if err != nil {
+		return fmt.Errorf("failed to marshal tag: %w", err)
+	}
+
+	for _, part := range [][]byte{[]byte(`{"tag":`), tagJSON, []byte(`,"body":`), r.Data, []byte(`}`)} {
+		if _, err := w.Write(part); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
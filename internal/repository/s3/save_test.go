@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/n-r-w/collector/internal/entity"
+	"github.com/samber/mo"
 	"github.com/stretchr/testify/require"
 )
 
@@ -77,3 +78,13 @@ func TestService_SaveResultChan(t *testing.T) {
 
 	require.JSONEq(t, expectedJSON, string(content))
 }
+
+func TestWriteChunk(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeChunk(&buf, entity.RequestChunk{Data: []byte(`{"id":1}`)}))
+	require.JSONEq(t, `{"id":1}`, buf.String())
+
+	buf.Reset()
+	require.NoError(t, writeChunk(&buf, entity.RequestChunk{Data: []byte(`{"id":2}`), Tag: mo.Some(`search "v2"`)}))
+	require.JSONEq(t, `{"tag":"search \"v2\"","body":{"id":2}}`, buf.String())
+}
@@ -39,6 +39,11 @@ func New(cfg *config.Config) (*Service, error) {
 		return nil, fmt.Errorf("write chunk size is too small: %d, min part size: %d", cfg.S3.WriteChunkSize, minPartSize)
 	}
 
+	if cfg.S3.DeleteBatchSize <= 0 || cfg.S3.DeleteBatchSize > maxDeleteBatchSize {
+		return nil, fmt.Errorf("invalid delete batch size: %d, must be in range [1, %d]",
+			cfg.S3.DeleteBatchSize, maxDeleteBatchSize)
+	}
+
 	return &Service{
 		cfg: cfg,
 	}, nil
@@ -43,6 +43,7 @@ func setupTest(t *testing.T) (*Service, *config.Config, context.Context) {
 	cfg := &config.Config{}
 	cfg.S3.ReadChunkSize = 52428800
 	cfg.S3.WriteChunkSize = 52428800
+	cfg.S3.DeleteBatchSize = 1000
 	cfg.S3.Endpoint = url
 	cfg.S3.Bucket = testBucket
 	cfg.S3.AccessKey = testAccessKey
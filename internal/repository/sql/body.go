@@ -0,0 +1,39 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/pgh/v2"
+	"github.com/n-r-w/pgh/v2/px"
+	"github.com/n-r-w/pgh/v2/px/db/conn"
+	"github.com/samber/lo"
+)
+
+// BodyRefreshInterval is how often last_seen_at of a stored request body is refreshed.
+// The cleaner removes unused bodies only if they were not seen for at least two intervals,
+// so bodies of requests being stored are never removed.
+const BodyRefreshInterval = time.Hour
+
+// ReleaseBodyObjects schedules deletion of request bodies stored in the object storage.
+// They are deleted by the cleaner, if they are not referenced again by then.
+func ReleaseBodyObjects(ctx context.Context, c conn.IConnection, refs []entity.BodyRef) error {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	sql := pgh.Builder().Insert("released_body_objects").Columns("object_key")
+	for _, ref := range lo.Uniq(refs) {
+		sql = sql.Values(ref)
+	}
+	// releasing the body again restarts the period the cleaner waits before deleting it
+	sql = sql.Suffix("ON CONFLICT (object_key) DO UPDATE SET released_at = NOW()")
+
+	if _, err := px.Exec(ctx, c, sql); err != nil {
+		return fmt.Errorf("failed to release request body objects: %w", err)
+	}
+
+	return nil
+}
@@ -3,56 +3,281 @@ package cleaner
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/n-r-w/collector/internal/entity"
+	sqlrepo "github.com/n-r-w/collector/internal/repository/sql"
 	"github.com/n-r-w/pgh/v2"
 	"github.com/n-r-w/pgh/v2/px"
 	sq "github.com/n-r-w/squirrel"
+	"github.com/samber/lo"
 )
 
 // CleanDatabase removes all collection data.
+// Collected requests are removed first by batches, each batch in its own transaction,
+// so the collections are removed last and an interrupted cleanup is continued during the next run.
+// The batches are committed even if the caller is in a transaction.
 func (s *Service) CleanDatabase(ctxMain context.Context, collectionIDs []entity.CollectionID) error {
+	ctxMain = s.detach(ctxMain)
+
+	// requests in the range partitions are removed by dropping the partitions,
+	// the default partitions are cleaned up row by row
+	if err := s.deleteRequests(ctxMain, "request_collections_default", collectionIDs); err != nil {
+		return err
+	}
+
 	return s.txManager.Begin(ctxMain, func(ctx context.Context) error {
 		return s.cleanDatabaseHelper(ctx, collectionIDs)
 	})
 }
 
 func (s *Service) cleanDatabaseHelper(ctx context.Context, collectionIDs []entity.CollectionID) error {
-	// get not blocked collections
+	notBlocked, err := s.lockNotBlocked(ctx, collectionIDs)
+	if err != nil {
+		return err
+	}
+
+	if len(notBlocked) == 0 {
+		return nil
+	}
+
+	// delete collections
+	deleteSQL := pgh.Builder().Delete("collections").Where(sq.Eq{"id": notBlocked})
+	if _, err := px.Exec(ctx, s.conn(ctx), deleteSQL); err != nil {
+		return fmt.Errorf("failed to clean collections: %w", err)
+	}
+
+	if err := s.deleteCollectionBodies(ctx, notBlocked); err != nil {
+		return err
+	}
+
+	if err := s.deleteCollectionRules(ctx, notBlocked); err != nil {
+		return err
+	}
+
+	if err := s.deleteCollectionStrata(ctx, notBlocked); err != nil {
+		return err
+	}
+
+	return s.deleteCollectionChanges(ctx, notBlocked)
+}
+
+// lockNotBlocked locks the given collections and returns the ones that are not locked by others.
+func (s *Service) lockNotBlocked(ctx context.Context, collectionIDs []entity.CollectionID,
+) ([]entity.CollectionID, error) {
 	var notBlocked []entity.CollectionID
-	notBlockedSQL := pgh.Builder().Select("id").From("collections").
+	sql := pgh.Builder().Select("id").From("collections").
 		Where(sq.Eq{"id": collectionIDs}).
 		Suffix("FOR UPDATE SKIP LOCKED")
-	if err := px.Select(ctx, s.conn(ctx), notBlockedSQL, &notBlocked); err != nil {
-		return fmt.Errorf("failed to get not blocked collections: %w", err)
+	if err := px.Select(ctx, s.conn(ctx), sql, &notBlocked); err != nil {
+		return nil, fmt.Errorf("failed to get not blocked collections: %w", err)
 	}
 
-	// delete collections
-	deleteSQL := pgh.Builder().Delete("collections").Where(sq.Eq{"id": notBlocked})
-	_, err := px.Exec(ctx, s.conn(ctx), deleteSQL)
+	return notBlocked, nil
+}
+
+// PurgeCompletedRequests removes collected requests of collections completed before the given time.
+// The collections themselves are kept, because their result is stored in the object storage.
+// The batches are committed even if the caller is in a transaction.
+func (s *Service) PurgeCompletedRequests(ctx context.Context, completedBefore time.Time) error {
+	ctx = s.detach(ctx)
+
+	// get completed collections that still have requests
+	var collectionIDs []entity.CollectionID
+	sql := pgh.Builder().Select("id").From("collections").
+		Where(sq.Eq{"status": entity.StatusCompleted}).
+		Where(sq.LtOrEq{"completed_at": completedBefore}).
+		Where(sq.Exists(
+			sq.Select("1").From("request_collections").
+				Where(sq.Expr("request_collections.collection_id = collections.id")),
+		))
+	if err := px.Select(ctx, s.conn(ctx), sql, &collectionIDs); err != nil {
+		return fmt.Errorf("failed to get completed collections: %w", err)
+	}
+
+	if len(collectionIDs) == 0 {
+		return nil
+	}
+
+	err := s.txManager.Begin(ctx, func(ctxTr context.Context) error {
+		// completed collections don't collect bodies anymore
+		if err := s.deleteCollectionBodies(ctxTr, collectionIDs); err != nil {
+			return err
+		}
+
+		return s.deleteCollectionStrata(ctxTr, collectionIDs)
+	})
 	if err != nil {
-		return fmt.Errorf("failed to clean collections: %w", err)
+		return err
+	}
+
+	// links are removed from all partitions, so the partitions can be dropped
+	return s.deleteRequests(ctx, "request_collections", collectionIDs)
+}
+
+// deleteCollectionBodies removes bodies collected by collections with distinct bodies.
+func (s *Service) deleteCollectionBodies(ctx context.Context, collectionIDs []entity.CollectionID) error {
+	sql := pgh.Builder().Delete("collection_bodies").Where(sq.Eq{"collection_id": collectionIDs})
+	if _, err := px.Exec(ctx, s.conn(ctx), sql); err != nil {
+		return fmt.Errorf("failed to clean collection_bodies: %w", err)
 	}
 
-	// delete links
-	deleteSQL = pgh.Builder().Delete("request_collections").Where(sq.Eq{"collection_id": notBlocked})
-	_, err = px.Exec(ctx, s.conn(ctx), deleteSQL)
+	return nil
+}
+
+// deleteCollectionRules removes quotas of multi-rule collections.
+func (s *Service) deleteCollectionRules(ctx context.Context, collectionIDs []entity.CollectionID) error {
+	sql := pgh.Builder().Delete("collection_rules").Where(sq.Eq{"collection_id": collectionIDs})
+	if _, err := px.Exec(ctx, s.conn(ctx), sql); err != nil {
+		return fmt.Errorf("failed to clean collection_rules: %w", err)
+	}
+
+	return nil
+}
+
+// deleteCollectionStrata removes counters of stratified collections.
+func (s *Service) deleteCollectionStrata(ctx context.Context, collectionIDs []entity.CollectionID) error {
+	sql := pgh.Builder().Delete("collection_strata").Where(sq.Eq{"collection_id": collectionIDs})
+	if _, err := px.Exec(ctx, s.conn(ctx), sql); err != nil {
+		return fmt.Errorf("failed to clean collection_strata: %w", err)
+	}
+
+	return nil
+}
+
+// deleteCollectionChanges removes the audit of the collection changes.
+func (s *Service) deleteCollectionChanges(ctx context.Context, collectionIDs []entity.CollectionID) error {
+	sql := pgh.Builder().Delete("collection_changes").Where(sq.Eq{"collection_id": collectionIDs})
+	if _, err := px.Exec(ctx, s.conn(ctx), sql); err != nil {
+		return fmt.Errorf("failed to clean collection_changes: %w", err)
+	}
+
+	return nil
+}
+
+// deleteRequests removes links between requests and collections from the given table by batches.
+// Each batch is removed in its own transaction, collections locked by others are skipped.
+// Requests of the default partition are removed if they are not linked to any other collection,
+// requests of the range partitions are removed by dropping the partitions.
+func (s *Service) deleteRequests(ctxMain context.Context, linksTable string,
+	collectionIDs []entity.CollectionID,
+) error {
+	for {
+		var deleted int
+		err := s.txManager.Begin(ctxMain, func(ctx context.Context) error {
+			var err error
+			deleted, err = s.deleteRequestsBatch(ctx, linksTable, collectionIDs)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		if deleted < s.batchSize {
+			return nil
+		}
+	}
+}
+
+// deleteRequestsBatch removes a single batch of links and returns the number of removed links.
+func (s *Service) deleteRequestsBatch(ctx context.Context, linksTable string, collectionIDs []entity.CollectionID,
+) (int, error) {
+	notBlocked, err := s.lockNotBlocked(ctx, collectionIDs)
 	if err != nil {
-		return fmt.Errorf("failed to clean request_collections: %w", err)
+		return 0, err
+	}
+
+	if len(notBlocked) == 0 {
+		return 0, nil
+	}
+
+	// delete a batch of links
+	var requestIDs []int64
+	deleteLinksSQL := pgh.Builder().Delete(linksTable).
+		Where(sq.Expr("(request_id, collection_id, created_at) IN (?)",
+			sq.Select("request_id", "collection_id", "created_at").From(linksTable).
+				Where(sq.Eq{"collection_id": notBlocked}).
+				Limit(uint64(s.batchSize)), //nolint:gosec // checked in New
+		)).
+		Suffix("RETURNING request_id")
+	if err := px.Select(ctx, s.conn(ctx), deleteLinksSQL, &requestIDs); err != nil {
+		return 0, fmt.Errorf("failed to clean request_collections: %w", err)
+	}
+
+	if len(requestIDs) == 0 {
+		return 0, nil
 	}
 
 	// delete requests that have no record in request_collections
-	deleteSQL = pgh.Builder().Delete("requests").
+	deleteRequestsSQL := pgh.Builder().Delete("requests_default").
+		Where(sq.Eq{"id": lo.Uniq(requestIDs)}).
 		Where(
 			sq.NotExists(
 				sq.Select("1").From("request_collections").
-					Where(sq.Expr("request_collections.request_id = requests.id")),
+					Where(sq.Expr("request_collections.request_id = requests_default.id")).
+					Where(sq.Expr("request_collections.created_at = requests_default.created_at")),
 			),
 		)
-	_, err = px.Exec(ctx, s.conn(ctx), deleteSQL)
-	if err != nil {
-		return fmt.Errorf("failed to clean requests: %w", err)
+	if _, err := px.Exec(ctx, s.conn(ctx), deleteRequestsSQL); err != nil {
+		return 0, fmt.Errorf("failed to clean requests: %w", err)
 	}
 
-	return nil
+	return len(requestIDs), nil
+}
+
+// DeleteUnusedBodies removes request bodies that are not referenced by requests anymore.
+// Bodies seen recently are kept, because they can be referenced by requests being stored.
+// Each batch is removed in its own transaction, even if the caller is in a transaction.
+func (s *Service) DeleteUnusedBodies(ctxMain context.Context) error {
+	ctxMain = s.detach(ctxMain)
+
+	for {
+		var deleted int
+		err := s.txManager.Begin(ctxMain, func(ctx context.Context) error {
+			var err error
+			deleted, err = s.deleteUnusedBodiesBatch(ctx)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		if deleted < s.batchSize {
+			return nil
+		}
+	}
+}
+
+// deleteUnusedBodiesBatch removes a single batch of bodies and returns the number of removed bodies.
+func (s *Service) deleteUnusedBodiesBatch(ctx context.Context) (int, error) {
+	// last_seen_at is checked again for the deleted rows, in case they were refreshed concurrently
+	notSeen := sq.Expr("last_seen_at < NOW() - ?::interval", 2*sqlrepo.BodyRefreshInterval)
+
+	sql := pgh.Builder().Delete("request_bodies").
+		Where(sq.Expr("hash IN (?)",
+			sq.Select("hash").From("request_bodies").
+				Where(notSeen).
+				Where(sq.NotExists(
+					sq.Select("1").From("requests").
+						Where(sq.Expr("requests.body_hash = request_bodies.hash")),
+				)).
+				Limit(uint64(s.batchSize)), //nolint:gosec // checked in New
+		)).
+		Where(notSeen).
+		Suffix("RETURNING COALESCE(object_key, '')")
+
+	var objectKeys []string
+	if err := px.Select(ctx, s.conn(ctx), sql, &objectKeys); err != nil {
+		return 0, fmt.Errorf("failed to clean request_bodies: %w", err)
+	}
+
+	// bodies stored in the object storage are deleted from it later
+	refs := lo.FilterMap(objectKeys, func(key string, _ int) (entity.BodyRef, bool) {
+		return entity.BodyRef(key), key != ""
+	})
+	if err := sqlrepo.ReleaseBodyObjects(ctx, s.conn(ctx), refs); err != nil {
+		return 0, err
+	}
+
+	return len(objectKeys), nil
 }
@@ -2,6 +2,7 @@ package cleaner
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -24,6 +25,8 @@ func TestClean(t *testing.T) {
 	ctx, s := sql.SetupTest(t,
 		func(cfg *config.Config, db *db.PxDB, txmgr *txmgr.TransactionManager) (*Service, error) {
 			cfg.App.EnvType = ctxlog.EnvDevelopment
+			cfg.Collection.CleanupBatchSize = 2
+			cfg.Collection.PartitionInterval = 24 * time.Hour
 			return New(cfg, db, txmgr)
 		},
 	)
@@ -71,14 +74,17 @@ func TestClean(t *testing.T) {
 	}
 
 	// Insert requests
+	createdAt := time.Now()
 	for i := range requests {
 		headersJSON, err := json.Marshal(requests[i].headers)
 		require.NoError(t, err)
 
+		bodyHash := sql.InsertTestBody(t, ctx, s.conn, requests[i].body)
+
 		sql := pgh.Builder().
 			Insert("requests").
-			Columns("handler", "headers", "body").
-			Values(requests[i].handler, headersJSON, requests[i].body).
+			Columns("handler", "headers", "body_hash", "created_at").
+			Values(requests[i].handler, headersJSON, bodyHash, createdAt).
 			Suffix("RETURNING id")
 
 		var requestID int64
@@ -89,8 +95,8 @@ func TestClean(t *testing.T) {
 		for _, collectionID := range requests[i].linkTo {
 			_, err = px.Exec(ctx, s.conn(ctx),
 				pgh.Builder().Insert("request_collections").
-					Columns("request_id", "collection_id").
-					Values(requestID, collectionID),
+					Columns("request_id", "collection_id", "created_at").
+					Values(requestID, collectionID, createdAt),
 			)
 			require.NoError(t, err)
 		}
@@ -143,3 +149,225 @@ func TestClean(t *testing.T) {
 	require.NoError(t, px.SelectOne(ctx, s.conn(ctx), sql, &requestCount))
 	require.Equal(t, 0, requestCount) // All requests should still be there
 }
+
+func TestCleanDatabaseCommitsBatches(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, txmgr *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			cfg.Collection.CleanupBatchSize = 2
+			cfg.Collection.PartitionInterval = 24 * time.Hour
+			return New(cfg, db, txmgr)
+		},
+	)
+
+	task := entity.Task{
+		MessageSelection: entity.MessageSelectionCriteria{
+			Handler: "test-handler",
+		},
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 100,
+		},
+	}
+
+	collectionID := sql.CreateTestCollection(t, ctx, s.conn, task)
+
+	// more links than the batch size
+	createdAt := time.Now()
+	bodyHash := sql.InsertTestBody(t, ctx, s.conn, []byte(`{"test": "data"}`))
+	for range 5 {
+		var requestID int64
+		require.NoError(t, px.SelectOne(ctx, s.conn(ctx),
+			pgh.Builder().Insert("requests").
+				Columns("handler", "headers", "body_hash", "created_at").
+				Values("handler", []byte(`{}`), bodyHash, createdAt).
+				Suffix("RETURNING id"),
+			&requestID))
+
+		_, err := px.Exec(ctx, s.conn(ctx),
+			pgh.Builder().Insert("request_collections").
+				Columns("request_id", "collection_id", "created_at").
+				Values(requestID, collectionID, createdAt),
+		)
+		require.NoError(t, err)
+	}
+
+	// the worker cleans up inside the transaction holding the cleanup lock
+	ctxLock, tx, err := s.txManager.BeginTx(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, s.CleanDatabase(ctxLock, []entity.CollectionID{collectionID}))
+
+	countCommitted := func(table string) int {
+		var count int
+		require.NoError(t, px.SelectOne(ctx, s.conn(ctx),
+			pgh.Builder().Select("COUNT(*)").From(table), &count))
+		return count
+	}
+
+	// changes are visible to other connections before the lock transaction ends
+	require.Zero(t, countCommitted("request_collections"))
+	require.Zero(t, countCommitted("requests"))
+	require.Zero(t, countCommitted("collections"))
+
+	require.NoError(t, tx.Rollback(ctxLock))
+
+	require.Zero(t, countCommitted("request_collections"))
+	require.Zero(t, countCommitted("requests"))
+	require.Zero(t, countCommitted("collections"))
+}
+
+func TestPurgeCompletedRequests(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, txmgr *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			cfg.Collection.CleanupBatchSize = 2
+			cfg.Collection.PartitionInterval = 24 * time.Hour
+			return New(cfg, db, txmgr)
+		},
+	)
+
+	task := entity.Task{
+		MessageSelection: entity.MessageSelectionCriteria{
+			Handler: "test-handler",
+		},
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 100,
+		},
+	}
+
+	completedID := sql.CreateTestCollection(t, ctx, s.conn, task)
+	activeID := sql.CreateTestCollection(t, ctx, s.conn, task)
+
+	completedAt := time.Now().Add(-time.Hour)
+	_, err := px.Exec(ctx, s.conn(ctx),
+		pgh.Builder().Update("collections").
+			Set("status", entity.StatusCompleted).
+			Set("completed_at", completedAt).
+			Where(sq.Eq{"id": completedID}),
+	)
+	require.NoError(t, err)
+
+	// 5 requests of the completed collection, the last one is shared with the active collection
+	createdAt := time.Now()
+	bodyHash := sql.InsertTestBody(t, ctx, s.conn, []byte(`{"test": "data"}`))
+	var requestIDs []int64
+	for i := range 5 {
+		var requestID int64
+		require.NoError(t, px.SelectOne(ctx, s.conn(ctx),
+			pgh.Builder().Insert("requests").
+				Columns("handler", "headers", "body_hash", "created_at").
+				Values("handler", []byte(`{}`), bodyHash, createdAt).
+				Suffix("RETURNING id"),
+			&requestID))
+		requestIDs = append(requestIDs, requestID)
+
+		linkTo := []entity.CollectionID{completedID}
+		if i == 4 {
+			linkTo = append(linkTo, activeID)
+		}
+		for _, collectionID := range linkTo {
+			_, err = px.Exec(ctx, s.conn(ctx),
+				pgh.Builder().Insert("request_collections").
+					Columns("request_id", "collection_id", "created_at").
+					Values(requestID, collectionID, createdAt),
+			)
+			require.NoError(t, err)
+		}
+	}
+
+	countLinks := func(collectionID entity.CollectionID) int {
+		var count int
+		require.NoError(t, px.SelectOne(ctx, s.conn(ctx),
+			pgh.Builder().Select("COUNT(*)").From("request_collections").
+				Where(sq.Eq{"collection_id": collectionID}),
+			&count))
+		return count
+	}
+
+	countRequests := func() int {
+		var count int
+		require.NoError(t, px.SelectOne(ctx, s.conn(ctx),
+			pgh.Builder().Select("COUNT(*)").From("requests").Where(sq.Eq{"id": requestIDs}),
+			&count))
+		return count
+	}
+
+	// Collection is completed after the given time, nothing to purge
+	require.NoError(t, s.PurgeCompletedRequests(ctx, completedAt.Add(-time.Minute)))
+	require.Equal(t, 5, countLinks(completedID))
+	require.Equal(t, 5, countRequests())
+
+	require.NoError(t, s.PurgeCompletedRequests(ctx, time.Now()))
+	require.Zero(t, countLinks(completedID))
+	require.Equal(t, 1, countLinks(activeID))
+	require.Equal(t, 1, countRequests()) // shared request is preserved
+
+	// Collection itself is preserved
+	var collections []dbmodel.Collection
+	require.NoError(t, px.Select(ctx, s.conn(ctx),
+		pgh.Builder().Select("*").From("collections").Where(sq.Eq{"id": completedID}),
+		&collections))
+	require.Len(t, collections, 1)
+}
+
+func TestDeleteUnusedBodies(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, txmgr *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			cfg.Collection.CleanupBatchSize = 2
+			cfg.Collection.PartitionInterval = 24 * time.Hour
+			return New(cfg, db, txmgr)
+		},
+	)
+
+	usedHash := sql.InsertTestBody(t, ctx, s.conn, []byte(`{"test": "used"}`))
+	// more unused bodies than the batch size
+	for i := range 3 {
+		sql.InsertTestBody(t, ctx, s.conn, []byte(fmt.Sprintf(`{"test": %d}`, i)))
+	}
+	recentHash := sql.InsertTestBody(t, ctx, s.conn, []byte(`{"test": "recent"}`))
+
+	// body stored in the object storage
+	_, err := px.Exec(ctx, s.conn(ctx),
+		pgh.Builder().Insert("request_bodies").
+			Columns("hash", "object_key").
+			Values(entity.HashBody([]byte(`{"test": "object"}`)), "bodies/test.json"),
+	)
+	require.NoError(t, err)
+
+	_, err = px.Exec(ctx, s.conn(ctx),
+		pgh.Builder().Insert("requests").
+			Columns("handler", "headers", "body_hash", "created_at").
+			Values("handler", []byte(`{}`), usedHash, time.Now()),
+	)
+	require.NoError(t, err)
+
+	// all bodies except the recent one were seen long ago
+	_, err = px.Exec(ctx, s.conn(ctx),
+		pgh.Builder().Update("request_bodies").
+			Set("last_seen_at", time.Now().Add(-3*sql.BodyRefreshInterval)).
+			Where(sq.NotEq{"hash": recentHash}),
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, s.DeleteUnusedBodies(ctx))
+
+	var hashes [][]byte
+	require.NoError(t, px.Select(ctx, s.conn(ctx),
+		pgh.Builder().Select("hash").From("request_bodies"), &hashes))
+	require.ElementsMatch(t, [][]byte{usedHash, recentHash}, hashes)
+
+	// the object is deleted by the cleaner later
+	var released []string
+	require.NoError(t, px.Select(ctx, s.conn(ctx),
+		pgh.Builder().Select("object_key").From("released_body_objects"), &released))
+	require.Equal(t, []string{"bodies/test.json"}, released)
+}
@@ -0,0 +1,247 @@
+package cleaner
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/n-r-w/ctxlog"
+	"github.com/n-r-w/pgh/v2"
+	"github.com/n-r-w/pgh/v2/px"
+	sq "github.com/n-r-w/squirrel"
+)
+
+const (
+	// partitionTimeFormat is used for partition bounds in partition names.
+	partitionTimeFormat = "200601021504"
+	// partitionLockTimeout limits waiting for locks on partitions, so the cleanup doesn't block the ingestion.
+	partitionLockTimeout = "10s"
+)
+
+// partitionedTables are partitioned by the request creation time with the same bounds.
+var partitionedTables = []string{"request_collections", "requests"}
+
+// partition is a time range of a partition. Partition names are derived from it.
+type partition struct {
+	from time.Time
+	to   time.Time
+}
+
+// name returns the name of the partition of the given table.
+func (p partition) name(table string) string {
+	return fmt.Sprintf("%s_p%s_%s",
+		table, p.from.UTC().Format(partitionTimeFormat), p.to.UTC().Format(partitionTimeFormat))
+}
+
+// overlaps returns true if partitions have common time range.
+func (p partition) overlaps(other partition) bool {
+	return p.from.Before(other.to) && other.from.Before(p.to)
+}
+
+// parsePartition parses the time range from the partition name.
+// Returns false for partitions not created by the cleaner, e.g. the default one.
+func parsePartition(table, name string) (partition, bool) {
+	bounds, ok := strings.CutPrefix(name, table+"_p")
+	if !ok {
+		return partition{}, false
+	}
+
+	fromStr, toStr, ok := strings.Cut(bounds, "_")
+	if !ok {
+		return partition{}, false
+	}
+
+	from, err := time.Parse(partitionTimeFormat, fromStr)
+	if err != nil {
+		return partition{}, false
+	}
+
+	to, err := time.Parse(partitionTimeFormat, toStr)
+	if err != nil || !from.Before(to) {
+		return partition{}, false
+	}
+
+	return partition{from: from, to: to}, true
+}
+
+// CreatePartitions creates partitions of collected requests covering the given time range.
+func (s *Service) CreatePartitions(ctxMain context.Context, from, until time.Time) error {
+	return s.txManager.Begin(s.detach(ctxMain), func(ctx context.Context) error {
+		return s.createPartitionsHelper(ctx, from, until)
+	})
+}
+
+func (s *Service) createPartitionsHelper(ctx context.Context, from, until time.Time) error {
+	existing, err := s.getPartitions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.setLockTimeout(ctx); err != nil {
+		return err
+	}
+
+	for start := from.UTC().Truncate(s.partitionInterval); start.Before(until); start = start.Add(s.partitionInterval) {
+		p := partition{from: start, to: start.Add(s.partitionInterval)}
+
+		// partition interval could be changed, existing partitions are kept as is
+		if slices.ContainsFunc(existing, p.overlaps) {
+			continue
+		}
+
+		if err := s.createPartition(ctx, p); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) createPartition(ctx context.Context, p partition) error {
+	// partition can't be created if the default partition already contains its rows
+	var inDefault bool
+	sql := pgh.Builder().Select().Column(sq.Expr("EXISTS (?)",
+		sq.Select("1").From("requests_default").
+			Where(sq.GtOrEq{"created_at": p.from}).
+			Where(sq.Lt{"created_at": p.to}),
+	))
+	if err := px.SelectOne(ctx, s.conn(ctx), sql, &inDefault); err != nil {
+		return fmt.Errorf("failed to check default partition: %w", err)
+	}
+
+	if inDefault {
+		ctxlog.Warn(ctx, "default partition contains requests of the partition, skipping",
+			slog.String("partition", p.name("requests")))
+		return nil
+	}
+
+	for _, table := range partitionedTables {
+		_, err := px.ExecPlain(ctx, s.conn(ctx), fmt.Sprintf(
+			"CREATE TABLE %s PARTITION OF %s FOR VALUES FROM ('%s') TO ('%s')",
+			pgx.Identifier{p.name(table)}.Sanitize(), table,
+			p.from.UTC().Format(time.RFC3339), p.to.UTC().Format(time.RFC3339)), nil)
+		if err != nil {
+			return fmt.Errorf("failed to create partition %s: %w", p.name(table), err)
+		}
+	}
+
+	ctxlog.Debug(ctx, "partition created", slog.String("partition", p.name("requests")))
+
+	return nil
+}
+
+// DropPartitions detaches and drops partitions of collected requests that ended before the given time
+// and are not referenced by existing collections.
+func (s *Service) DropPartitions(ctxMain context.Context, before time.Time) error {
+	return s.txManager.Begin(s.detach(ctxMain), func(ctx context.Context) error {
+		return s.dropPartitionsHelper(ctx, before)
+	})
+}
+
+func (s *Service) dropPartitionsHelper(ctx context.Context, before time.Time) error {
+	existing, err := s.getPartitions(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.setLockTimeout(ctx); err != nil {
+		return err
+	}
+
+	// partitions are locked and checked first, so the parent tables are locked only for detaching
+	var toDrop []partition
+	for _, p := range existing {
+		if p.to.After(before) {
+			continue
+		}
+
+		referenced, err := s.isPartitionReferenced(ctx, p)
+		if err != nil {
+			return err
+		}
+
+		if !referenced {
+			toDrop = append(toDrop, p)
+		}
+	}
+
+	for _, p := range toDrop {
+		for _, table := range partitionedTables {
+			name := pgx.Identifier{p.name(table)}.Sanitize()
+			if _, err := px.ExecPlain(ctx, s.conn(ctx),
+				fmt.Sprintf("ALTER TABLE %s DETACH PARTITION %s", table, name), nil); err != nil {
+				return fmt.Errorf("failed to detach partition %s: %w", p.name(table), err)
+			}
+
+			if _, err := px.ExecPlain(ctx, s.conn(ctx), "DROP TABLE "+name, nil); err != nil {
+				return fmt.Errorf("failed to drop partition %s: %w", p.name(table), err)
+			}
+		}
+
+		ctxlog.Debug(ctx, "partition dropped", slog.String("partition", p.name("requests")))
+	}
+
+	return nil
+}
+
+// isPartitionReferenced locks the partition and checks if it contains requests of existing collections.
+func (s *Service) isPartitionReferenced(ctx context.Context, p partition) (bool, error) {
+	names := make([]string, 0, len(partitionedTables))
+	for _, table := range partitionedTables {
+		names = append(names, pgx.Identifier{p.name(table)}.Sanitize())
+	}
+
+	// no new requests can be added to the partition until the end of the transaction
+	if _, err := px.ExecPlain(ctx, s.conn(ctx),
+		fmt.Sprintf("LOCK TABLE %s IN ACCESS EXCLUSIVE MODE", strings.Join(names, ", ")), nil); err != nil {
+		return false, fmt.Errorf("failed to lock partition %s: %w", p.name("requests"), err)
+	}
+
+	var referenced bool
+	if err := px.SelectOnePlain(ctx, s.conn(ctx), fmt.Sprintf(
+		`SELECT EXISTS (
+			SELECT 1 FROM %s rc
+			WHERE EXISTS (SELECT 1 FROM collections c WHERE c.id = rc.collection_id)
+		)`, pgx.Identifier{p.name("request_collections")}.Sanitize()), &referenced, nil); err != nil {
+		return false, fmt.Errorf("failed to check partition %s: %w", p.name("requests"), err)
+	}
+
+	return referenced, nil
+}
+
+// setLockTimeout limits waiting for locks until the end of the transaction.
+func (s *Service) setLockTimeout(ctx context.Context) error {
+	if _, err := px.ExecPlain(ctx, s.conn(ctx),
+		fmt.Sprintf("SET LOCAL lock_timeout = '%s'", partitionLockTimeout), nil); err != nil {
+		return fmt.Errorf("failed to set lock timeout: %w", err)
+	}
+
+	return nil
+}
+
+// getPartitions returns partitions created by the cleaner ordered by time.
+func (s *Service) getPartitions(ctx context.Context) ([]partition, error) {
+	var names []string
+	if err := px.SelectPlain(ctx, s.conn(ctx),
+		`SELECT c.relname
+		FROM pg_inherits i
+		JOIN pg_class c ON c.oid = i.inhrelid
+		WHERE i.inhparent = 'requests'::regclass`, &names, nil); err != nil {
+		return nil, fmt.Errorf("failed to get partitions: %w", err)
+	}
+
+	partitions := make([]partition, 0, len(names))
+	for _, name := range names {
+		if p, ok := parsePartition("requests", name); ok {
+			partitions = append(partitions, p)
+		}
+	}
+
+	slices.SortFunc(partitions, func(a, b partition) int { return a.from.Compare(b.from) })
+
+	return partitions, nil
+}
@@ -0,0 +1,117 @@
+package cleaner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/n-r-w/collector/internal/config"
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/collector/internal/repository/sql"
+	"github.com/n-r-w/ctxlog"
+	"github.com/n-r-w/pgh/v2"
+	"github.com/n-r-w/pgh/v2/px"
+	"github.com/n-r-w/pgh/v2/px/db"
+	"github.com/n-r-w/pgh/v2/txmgr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePartition(t *testing.T) {
+	t.Parallel()
+
+	p := partition{
+		from: time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+		to:   time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC),
+	}
+	require.Equal(t, "requests_p202610190000_202610200000", p.name("requests"))
+
+	parsed, ok := parsePartition("requests", p.name("requests"))
+	require.True(t, ok)
+	require.Equal(t, p, parsed)
+
+	for _, name := range []string{
+		"requests_default",
+		"request_collections_p202610190000_202610200000",
+		"requests_p202610200000_202610190000",
+		"requests_p202610190000",
+	} {
+		_, ok = parsePartition("requests", name)
+		require.False(t, ok, name)
+	}
+}
+
+func TestPartitions(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, txmgr *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			cfg.Collection.CleanupBatchSize = 2
+			cfg.Collection.PartitionInterval = 24 * time.Hour
+			return New(cfg, db, txmgr)
+		},
+	)
+
+	now := time.Now()
+
+	// 3 past partitions, the current one and the next one
+	require.NoError(t, s.CreatePartitions(ctx, now.Add(-72*time.Hour), now.Add(24*time.Hour)))
+	partitions, err := s.getPartitions(ctx)
+	require.NoError(t, err)
+	require.Len(t, partitions, 5)
+
+	// repeated call doesn't fail
+	require.NoError(t, s.CreatePartitions(ctx, now, now.Add(24*time.Hour)))
+
+	task := entity.Task{
+		MessageSelection: entity.MessageSelectionCriteria{
+			Handler: "test-handler",
+		},
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 100,
+		},
+	}
+	collectionID := sql.CreateTestCollection(t, ctx, s.conn, task)
+
+	bodyHash := sql.InsertTestBody(t, ctx, s.conn, []byte(`{"test": "data"}`))
+
+	insertRequest := func(createdAt time.Time, collectionID entity.CollectionID) {
+		var requestID int64
+		require.NoError(t, px.SelectOne(ctx, s.conn(ctx),
+			pgh.Builder().Insert("requests").
+				Columns("handler", "headers", "body_hash", "created_at").
+				Values("handler", []byte(`{}`), bodyHash, createdAt).
+				Suffix("RETURNING id"),
+			&requestID))
+
+		_, err := px.Exec(ctx, s.conn(ctx),
+			pgh.Builder().Insert("request_collections").
+				Columns("request_id", "collection_id", "created_at").
+				Values(requestID, collectionID, createdAt),
+		)
+		require.NoError(t, err)
+	}
+
+	// request of the existing collection
+	insertRequest(partitions[1].from.Add(time.Hour), collectionID)
+	// request of the deleted collection
+	insertRequest(partitions[0].from.Add(time.Hour), entity.CollectionID(-1))
+
+	// only the partition with requests of the existing collection is kept
+	require.NoError(t, s.DropPartitions(ctx, partitions[2].to))
+	remaining, err := s.getPartitions(ctx)
+	require.NoError(t, err)
+	require.Equal(t, partitions[1:2], remaining[:1])
+	require.Len(t, remaining, 3)
+
+	// partition is dropped after the collection is cleaned up
+	require.NoError(t, s.CleanDatabase(ctx, []entity.CollectionID{collectionID}))
+	require.NoError(t, s.DropPartitions(ctx, partitions[2].to))
+	remaining, err = s.getPartitions(ctx)
+	require.NoError(t, err)
+	require.Equal(t, partitions[3:], remaining)
+
+	var requestCount int
+	require.NoError(t, px.SelectOne(ctx, s.conn(ctx), pgh.Builder().Select("COUNT(*)").From("requests"), &requestCount))
+	require.Zero(t, requestCount)
+}
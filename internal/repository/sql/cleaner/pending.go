@@ -0,0 +1,75 @@
+package cleaner
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/pgh/v2"
+	"github.com/n-r-w/pgh/v2/px"
+	sq "github.com/n-r-w/squirrel"
+	"github.com/samber/lo"
+)
+
+// GetPendingDeletions returns objects that failed to be deleted from object storage earlier.
+// The least recently attempted objects are returned first.
+func (s *Service) GetPendingDeletions(ctx context.Context, limit int) ([]entity.ResultID, error) {
+	sql := pgh.Builder().Select("result_id").From("pending_object_deletions").
+		OrderBy("updated_at").
+		Limit(uint64(max(limit, 0)))
+
+	var resultIDs []entity.ResultID
+	if err := px.Select(ctx, s.conn(ctx), sql, &resultIDs); err != nil {
+		return nil, fmt.Errorf("failed to get pending deletions: %w", err)
+	}
+
+	return resultIDs, nil
+}
+
+// SavePendingDeletions saves objects that failed to be deleted from object storage.
+// If the object is already saved, the number of attempts is incremented.
+// The failures are committed even if the caller is in a transaction, because the objects are already processed.
+func (s *Service) SavePendingDeletions(ctx context.Context, failures []entity.DeletionFailure) error {
+	ctx = s.detach(ctx)
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	// ON CONFLICT DO UPDATE can't affect the same row twice
+	failures = lo.UniqBy(failures, func(f entity.DeletionFailure) entity.ResultID {
+		return f.ResultID
+	})
+
+	sql := pgh.Builder().Insert("pending_object_deletions").Columns("result_id", "last_error")
+	for _, f := range failures {
+		sql = sql.Values(f.ResultID, f.Reason)
+	}
+	sql = sql.Suffix(`ON CONFLICT (result_id) DO UPDATE SET
+		attempts = pending_object_deletions.attempts + 1,
+		last_error = EXCLUDED.last_error,
+		updated_at = NOW()`)
+
+	if _, err := px.Exec(ctx, s.conn(ctx), sql); err != nil {
+		return fmt.Errorf("failed to save pending deletions: %w", err)
+	}
+
+	return nil
+}
+
+// RemovePendingDeletions removes objects that have been deleted from object storage.
+// The removal is committed even if the caller is in a transaction.
+func (s *Service) RemovePendingDeletions(ctx context.Context, resultIDs []entity.ResultID) error {
+	ctx = s.detach(ctx)
+
+	if len(resultIDs) == 0 {
+		return nil
+	}
+
+	sql := pgh.Builder().Delete("pending_object_deletions").Where(sq.Eq{"result_id": resultIDs})
+	if _, err := px.Exec(ctx, s.conn(ctx), sql); err != nil {
+		return fmt.Errorf("failed to remove pending deletions: %w", err)
+	}
+
+	return nil
+}
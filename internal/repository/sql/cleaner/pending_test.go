@@ -0,0 +1,68 @@
+package cleaner
+
+import (
+	"testing"
+	"time"
+
+	"github.com/n-r-w/collector/internal/config"
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/collector/internal/repository/sql"
+	"github.com/n-r-w/collector/internal/repository/sql/dbmodel"
+	"github.com/n-r-w/ctxlog"
+	"github.com/n-r-w/pgh/v2"
+	"github.com/n-r-w/pgh/v2/px"
+	"github.com/n-r-w/pgh/v2/px/db"
+	"github.com/n-r-w/pgh/v2/txmgr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingDeletions(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, txmgr *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			cfg.Collection.CleanupBatchSize = 2
+			cfg.Collection.PartitionInterval = 24 * time.Hour
+			return New(cfg, db, txmgr)
+		},
+	)
+
+	// Save failed deletions
+	require.NoError(t, s.SavePendingDeletions(ctx, []entity.DeletionFailure{
+		{ResultID: "result-1", Reason: "error 1"},
+		{ResultID: "result-2", Reason: "error 2"},
+		{ResultID: "result-2", Reason: "error 2"}, // duplicate
+	}))
+
+	// Save again to increment attempts
+	require.NoError(t, s.SavePendingDeletions(ctx, []entity.DeletionFailure{
+		{ResultID: "result-1", Reason: "error 3"},
+	}))
+
+	var rows []dbmodel.PendingObjectDeletion
+	require.NoError(t, px.Select(ctx, s.conn(ctx),
+		pgh.Builder().Select("*").From("pending_object_deletions").OrderBy("result_id"), &rows))
+	require.Len(t, rows, 2)
+	require.Equal(t, "result-1", rows[0].ResultID)
+	require.Equal(t, 2, rows[0].Attempts)
+	require.Equal(t, "error 3", rows[0].LastError)
+	require.Equal(t, "result-2", rows[1].ResultID)
+	require.Equal(t, 1, rows[1].Attempts)
+
+	// Least recently attempted first
+	pending, err := s.GetPendingDeletions(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, []entity.ResultID{"result-2", "result-1"}, pending)
+
+	pending, err = s.GetPendingDeletions(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, []entity.ResultID{"result-2"}, pending)
+
+	// Remove deleted objects
+	require.NoError(t, s.RemovePendingDeletions(ctx, []entity.ResultID{"result-2"}))
+
+	pending, err = s.GetPendingDeletions(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, []entity.ResultID{"result-1"}, pending)
+}
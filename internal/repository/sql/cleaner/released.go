@@ -0,0 +1,137 @@
+package cleaner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/n-r-w/collector/internal/entity"
+	sqlrepo "github.com/n-r-w/collector/internal/repository/sql"
+	"github.com/n-r-w/pgh/v2"
+	"github.com/n-r-w/pgh/v2/px"
+	sq "github.com/n-r-w/squirrel"
+	"github.com/samber/lo"
+)
+
+// releasedDeletionTimeout is the time after which objects marked as being deleted are considered abandoned
+// by an interrupted cleaner and are deleted again.
+const releasedDeletionTimeout = time.Hour
+
+// DeleteReleasedBodies deletes a batch of objects of request bodies that are not used anymore
+// and returns the number of processed objects.
+// The objects are marked as being deleted and committed before deleteFn is called, so no rows are locked
+// while the object storage is accessed. A request processor doesn't claim marked objects and keeps such bodies
+// in the database instead. Objects that failed to be deleted are returned to the queue.
+// Objects released recently or referenced by stored bodies again are not deleted.
+func (s *Service) DeleteReleasedBodies(ctxMain context.Context, limit int,
+	deleteFn func(ctx context.Context, resultIDs []entity.ResultID) ([]entity.DeletionFailure, error),
+) (int, error) {
+	ctxMain = s.detach(ctxMain)
+
+	var (
+		processed int
+		unused    []string
+	)
+	err := s.txManager.Begin(ctxMain, func(ctx context.Context) error {
+		var err error
+		processed, unused, err = s.markReleasedBodies(ctx, limit)
+		return err
+	})
+	if err != nil || len(unused) == 0 {
+		return processed, err
+	}
+
+	failures, err := deleteFn(ctxMain,
+		lo.Map(unused, func(key string, _ int) entity.ResultID { return entity.ResultID(key) }))
+	if err != nil {
+		// the objects are deleted again during the next run
+		return 0, errors.Join(err, s.requeueReleasedBodies(ctxMain, unused))
+	}
+
+	failed := lo.Map(failures, func(f entity.DeletionFailure, _ int) string { return string(f.ResultID) })
+	deleted, _ := lo.Difference(unused, failed)
+
+	if err := s.requeueReleasedBodies(ctxMain, failed); err != nil {
+		return 0, err
+	}
+
+	if len(deleted) > 0 {
+		deleteSQL := pgh.Builder().Delete("released_body_objects").Where(sq.Eq{"object_key": deleted})
+		if _, err := px.Exec(ctxMain, s.conn(ctxMain), deleteSQL); err != nil {
+			return 0, fmt.Errorf("failed to remove released bodies: %w", err)
+		}
+	}
+
+	return processed, nil
+}
+
+// markReleasedBodies marks a batch of released objects as being deleted. Objects referenced by stored bodies
+// again are removed from the queue. Returns the number of processed objects and the keys of the marked ones.
+func (s *Service) markReleasedBodies(ctx context.Context, limit int) (int, []string, error) {
+	// bodies of uncommitted requests can reference released objects, they are given the same time
+	// as bodies not seen recently
+	var keys []string
+	sql := pgh.Builder().Select("object_key").From("released_body_objects").
+		Where(sq.Expr("released_at < NOW() - ?::interval", 2*sqlrepo.BodyRefreshInterval)).
+		Where(sq.Or{
+			sq.Eq{"deleting_at": nil},
+			sq.Expr("deleting_at < NOW() - ?::interval", releasedDeletionTimeout),
+		}).
+		OrderBy("released_at").
+		Limit(uint64(max(limit, 0))).
+		Suffix("FOR UPDATE SKIP LOCKED")
+	if err := px.Select(ctx, s.conn(ctx), sql, &keys); err != nil {
+		return 0, nil, fmt.Errorf("failed to get released bodies: %w", err)
+	}
+
+	if len(keys) == 0 {
+		return 0, nil, nil
+	}
+
+	var referenced []string
+	referencedSQL := pgh.Builder().Select("DISTINCT object_key").From("request_bodies").
+		Where(sq.Eq{"object_key": keys})
+	if err := px.Select(ctx, s.conn(ctx), referencedSQL, &referenced); err != nil {
+		return 0, nil, fmt.Errorf("failed to get referenced bodies: %w", err)
+	}
+
+	if len(referenced) > 0 {
+		deleteSQL := pgh.Builder().Delete("released_body_objects").Where(sq.Eq{"object_key": referenced})
+		if _, err := px.Exec(ctx, s.conn(ctx), deleteSQL); err != nil {
+			return 0, nil, fmt.Errorf("failed to remove referenced bodies: %w", err)
+		}
+	}
+
+	unused, _ := lo.Difference(keys, referenced)
+	if len(unused) == 0 {
+		return len(keys), nil, nil
+	}
+
+	markSQL := pgh.Builder().Update("released_body_objects").
+		Set("deleting_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"object_key": unused})
+	if _, err := px.Exec(ctx, s.conn(ctx), markSQL); err != nil {
+		return 0, nil, fmt.Errorf("failed to mark released bodies: %w", err)
+	}
+
+	return len(keys), unused, nil
+}
+
+// requeueReleasedBodies returns objects that failed to be deleted to the queue.
+// They are released again, so the retry is delayed as for new objects.
+func (s *Service) requeueReleasedBodies(ctx context.Context, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+
+	sql := pgh.Builder().Update("released_body_objects").
+		Set("deleting_at", nil).
+		Set("released_at", sq.Expr("NOW()")).
+		Where(sq.Eq{"object_key": keys})
+	if _, err := px.Exec(ctx, s.conn(ctx), sql); err != nil {
+		return fmt.Errorf("failed to requeue released bodies: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package cleaner
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/n-r-w/collector/internal/config"
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/collector/internal/repository/sql"
+	"github.com/n-r-w/collector/internal/repository/sql/dbmodel"
+	"github.com/n-r-w/ctxlog"
+	"github.com/n-r-w/pgh/v2"
+	"github.com/n-r-w/pgh/v2/px"
+	"github.com/n-r-w/pgh/v2/px/db"
+	"github.com/n-r-w/pgh/v2/txmgr"
+	sq "github.com/n-r-w/squirrel"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeleteReleasedBodies(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, txmgr *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			cfg.Collection.CleanupBatchSize = 2
+			cfg.Collection.PartitionInterval = 24 * time.Hour
+			return New(cfg, db, txmgr)
+		},
+	)
+
+	longAgo := time.Now().Add(-3 * sql.BodyRefreshInterval)
+	_, err := px.Exec(ctx, s.conn(ctx),
+		pgh.Builder().Insert("released_body_objects").Columns("object_key", "released_at").
+			Values("bodies/1.json", longAgo).
+			Values("bodies/2.json", longAgo).
+			Values("bodies/used.json", longAgo).
+			Values("bodies/recent.json", time.Now()))
+	require.NoError(t, err)
+
+	// the object was uploaded again for a stored body
+	_, err = px.Exec(ctx, s.conn(ctx),
+		pgh.Builder().Insert("request_bodies").Columns("hash", "object_key").
+			Values(entity.HashBody([]byte(`{"used": true}`)), "bodies/used.json"))
+	require.NoError(t, err)
+
+	// the object was abandoned by an interrupted cleaner
+	_, err = px.Exec(ctx, s.conn(ctx),
+		pgh.Builder().Insert("released_body_objects").Columns("object_key", "released_at", "deleting_at").
+			Values("bodies/abandoned.json", longAgo, time.Now().Add(-2*releasedDeletionTimeout)))
+	require.NoError(t, err)
+
+	var deleted []entity.ResultID
+	deleteFn := func(ctx context.Context, resultIDs []entity.ResultID) ([]entity.DeletionFailure, error) {
+		// the objects are marked and not locked while being deleted
+		var marked []string
+		require.NoError(t, px.Select(ctx, s.conn(ctx),
+			pgh.Builder().Select("object_key").From("released_body_objects").
+				Where(sq.NotEq{"deleting_at": nil}).
+				Suffix("FOR UPDATE NOWAIT"), &marked))
+		require.ElementsMatch(t, resultIDs, lo.Map(marked, func(key string, _ int) entity.ResultID {
+			return entity.ResultID(key)
+		}))
+
+		deleted = append(deleted, resultIDs...)
+		return []entity.DeletionFailure{{ResultID: "bodies/2.json", Reason: "AccessDenied"}}, nil
+	}
+
+	processed, err := s.DeleteReleasedBodies(ctx, 10, deleteFn)
+	require.NoError(t, err)
+	require.Equal(t, 4, processed)
+	require.ElementsMatch(t,
+		[]entity.ResultID{"bodies/1.json", "bodies/2.json", "bodies/abandoned.json"}, deleted)
+
+	// recently released objects are kept, failed ones are returned to the queue
+	getReleased := func() []dbmodel.ReleasedBodyObject {
+		var released []dbmodel.ReleasedBodyObject
+		require.NoError(t, px.Select(ctx, s.conn(ctx),
+			pgh.Builder().Select("*").From("released_body_objects").OrderBy("object_key"), &released))
+		return released
+	}
+
+	released := getReleased()
+	require.Len(t, released, 2)
+	require.Equal(t, "bodies/2.json", released[0].ObjectKey)
+	require.False(t, released[0].DeletingAt.Valid)
+	require.WithinDuration(t, time.Now(), released[0].ReleasedAt, time.Minute)
+	require.Equal(t, "bodies/recent.json", released[1].ObjectKey)
+
+	processed, err = s.DeleteReleasedBodies(ctx, 10, deleteFn)
+	require.NoError(t, err)
+	require.Zero(t, processed)
+
+	// objects are returned to the queue if the deletion failed
+	_, err = px.Exec(ctx, s.conn(ctx),
+		pgh.Builder().Update("released_body_objects").Set("released_at", longAgo))
+	require.NoError(t, err)
+
+	errDelete := errors.New("object storage is not available")
+	_, err = s.DeleteReleasedBodies(ctx, 10,
+		func(context.Context, []entity.ResultID) ([]entity.DeletionFailure, error) {
+			return nil, errDelete
+		})
+	require.ErrorIs(t, err, errDelete)
+
+	released = getReleased()
+	require.Len(t, released, 2)
+	for _, r := range released {
+		require.False(t, r.DeletingAt.Valid)
+	}
+}
@@ -2,6 +2,8 @@ package cleaner
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/n-r-w/collector/internal/config"
 	"github.com/n-r-w/collector/internal/repository/sql"
@@ -15,9 +17,17 @@ import (
 type Service struct {
 	txManager txmgr.ITransactionManager
 	conn      func(ctx context.Context) conn.IConnection
+	batchSize int
+	// partitionInterval is the time range of a single requests partition.
+	partitionInterval time.Duration
 }
 
-var _ cleaner.IDatabaseCleaner = (*Service)(nil)
+var (
+	_ cleaner.IDatabaseCleaner       = (*Service)(nil)
+	_ cleaner.IPendingDeletionStorer = (*Service)(nil)
+	_ cleaner.IPartitionManager      = (*Service)(nil)
+	_ cleaner.IReleasedBodyDeleter   = (*Service)(nil)
+)
 
 // New creates a new instance of Service.
 func New(
@@ -25,8 +35,25 @@ func New(
 	connectionGetter db.IConnectionGetter,
 	txManager txmgr.ITransactionManager,
 ) (*Service, error) {
+	if cfg.Collection.CleanupBatchSize <= 0 {
+		return nil, fmt.Errorf("invalid cleanup batch size: %d", cfg.Collection.CleanupBatchSize)
+	}
+
+	// partition names contain bounds with minute precision
+	if cfg.Collection.PartitionInterval < time.Minute || cfg.Collection.PartitionInterval%time.Minute != 0 {
+		return nil, fmt.Errorf("invalid partition interval: %s", cfg.Collection.PartitionInterval)
+	}
+
 	return &Service{
-		txManager: txManager,
-		conn:      sql.GetConn(cfg, connectionGetter),
+		txManager:         txManager,
+		conn:              sql.GetConn(cfg, connectionGetter),
+		batchSize:         cfg.Collection.CleanupBatchSize,
+		partitionInterval: cfg.Collection.PartitionInterval,
 	}, nil
 }
+
+// detach returns a context without the caller's transaction, e.g. the one holding the cleanup lock,
+// so the cleanup is committed by batches independently of it.
+func (s *Service) detach(ctx context.Context) context.Context {
+	return s.txManager.WithoutTransaction(ctx)
+}
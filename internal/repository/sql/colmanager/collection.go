@@ -2,6 +2,7 @@ package colmanager
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	"github.com/n-r-w/collector/internal/entity"
@@ -12,6 +13,17 @@ import (
 	sq "github.com/n-r-w/squirrel"
 )
 
+// collectionColumns is the list of columns to read a collection.
+var collectionColumns = []string{
+	"id", "status", "request_count_limit", "request_duration_limit", "criteria",
+	"request_count", "created_at", "started_at",
+	"updated_at", "completed_at", "result_id", "error_message", "error_code",
+	"retention_period", "pinned", "distinct_bodies", "duplicate_count", "reservoir", "seen_count",
+	"max_per_value", "min_distinct_values", "start_at", "idle_timeout", "byte_size_limit", "byte_count",
+	"last_request_at", "min_request_count", "time_limit_from_first_request", "max_wait",
+	"paused_at", "resumed_at", "freeze_time_limit", "frozen_duration", "name", "description", "owner", "labels",
+}
+
 // CreateCollection creates a new collection with the given parameters and returns its ID.
 func (s *Service) CreateCollection(ctx context.Context, task entity.Task) (entity.CollectionID, error) {
 	// Convert criteria to bytes for JSONB storage
@@ -20,11 +32,27 @@ func (s *Service) CreateCollection(ctx context.Context, task entity.Task) (entit
 		return 0, fmt.Errorf("CreateCollection: failed to convert criteria to bytes: %w", err)
 	}
 
+	labels, err := sqlrepo.ConvertLabelsToDB(task.Metadata.Labels)
+	if err != nil {
+		return 0, fmt.Errorf("CreateCollection: %w", err)
+	}
+
+	// stratification limits are used by the store path, so they are kept in columns
+	stratification := task.Stratification.OrEmpty()
+
 	// Insert the new collection and get the auto-generated ID
 	sql := pgh.Builder().
 		Insert("collections").
-		Columns("status", "request_count_limit", "request_duration_limit", "criteria").
-		Values(entity.StatusPending, task.Completion.RequestCountLimit, task.Completion.TimeLimit, criteriaBytes).
+		Columns("status", "request_count_limit", "request_duration_limit", "criteria", "retention_period", "pinned",
+			"distinct_bodies", "reservoir", "max_per_value", "min_distinct_values", "start_at", "idle_timeout",
+			"byte_size_limit", "min_request_count", "time_limit_from_first_request", "max_wait",
+			"name", "description", "owner", "labels").
+		Values(entity.StatusPending, task.Completion.RequestCountLimit, task.Completion.TimeLimit, criteriaBytes,
+			task.Retention.Period.ToPointer(), task.Retention.Pinned, task.MessageSelection.DistinctBodies,
+			task.Completion.Reservoir, stratification.MaxPerValue, stratification.MinDistinctValues,
+			task.StartAt.ToPointer(), task.Completion.IdleTimeout, task.Completion.ByteSizeLimit,
+			task.Completion.MinRequestCount, task.Completion.TimeLimitFromFirstRequest, task.Completion.MaxWait,
+			task.Metadata.Name, task.Metadata.Description, task.Metadata.Owner, labels).
 		Suffix("RETURNING id")
 
 	var collectionID entity.CollectionID
@@ -32,16 +60,27 @@ func (s *Service) CreateCollection(ctx context.Context, task entity.Task) (entit
 		return 0, fmt.Errorf("CreateCollection: failed to insert collection: %w", err)
 	}
 
+	if len(task.Rules) > 0 {
+		// quotas are counted in a separate table to lock them independently of the collection
+		rulesSQL := pgh.Builder().
+			Insert("collection_rules").
+			Columns("collection_id", "rule_id", "name", "request_count_limit")
+		for i, rule := range task.Rules {
+			rulesSQL = rulesSQL.Values(collectionID, i, rule.Name, rule.RequestCountLimit)
+		}
+
+		if _, err := px.Exec(ctx, s.conn(ctx), rulesSQL); err != nil {
+			return 0, fmt.Errorf("CreateCollection: failed to insert rules: %w", err)
+		}
+	}
+
 	return collectionID, nil
 }
 
 // GetCollections returns collections by filter.
 func (s *Service) GetCollections(ctx context.Context, filter entity.CollectionFilter) ([]entity.Collection, error) {
 	// Build base query
-	sql := pgh.Builder().Select(
-		"id", "status", "request_count_limit", "request_duration_limit", "criteria",
-		"request_count", "created_at", "started_at",
-		"updated_at", "completed_at", "result_id", "error_message", "error_code").
+	sql := pgh.Builder().Select(collectionColumns...).
 		From("collections")
 
 	// Apply status filter if provided
@@ -57,6 +96,52 @@ func (s *Service) GetCollections(ctx context.Context, filter entity.CollectionFi
 		sql = sql.Where(sq.LtOrEq{"created_at": filter.ToTime.OrEmpty()})
 	}
 
+	if owner, ok := filter.Owner.Get(); ok {
+		sql = sql.Where(sq.Eq{"owner": owner})
+	}
+
+	labelsFilter, err := labelSelectorToSQL(filter.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("GetCollections: %w", err)
+	}
+	if labelsFilter != nil {
+		sql = sql.Where(labelsFilter)
+	}
+
+	// handlers of the selection criteria, its handler patterns and rules are collected in the generated column
+	// in lower case, because handlers are matched case-insensitively.
+	// ?? is the escaped jsonb operator, that checks if the array contains the string
+	if handler, ok := filter.Handler.Get(); ok {
+		sql = sql.Where(sq.Expr("handlers ?? lower(?)", handler))
+	}
+
+	if filter.CompletedFrom.IsPresent() {
+		sql = sql.Where(sq.GtOrEq{"completed_at": filter.CompletedFrom.OrEmpty()})
+	}
+	if filter.CompletedTo.IsPresent() {
+		sql = sql.Where(sq.LtOrEq{"completed_at": filter.CompletedTo.OrEmpty()})
+	}
+
+	// keyset pagination, the id breaks ties of the creation time
+	switch filter.Order {
+	case entity.CollectionOrderNewestFirst:
+		sql = sql.OrderBy("created_at DESC", "id DESC")
+		if after, ok := filter.After.Get(); ok {
+			sql = sql.Where(sq.Expr("(created_at, id) < (?, ?)", after.CreatedAt, after.ID))
+		}
+	case entity.CollectionOrderOldestFirst:
+		sql = sql.OrderBy("created_at", "id")
+		if after, ok := filter.After.Get(); ok {
+			sql = sql.Where(sq.Expr("(created_at, id) > (?, ?)", after.CreatedAt, after.ID))
+		}
+	default:
+		return nil, fmt.Errorf("GetCollections: unknown order %d", filter.Order)
+	}
+
+	if filter.Limit > 0 {
+		sql = sql.Limit(uint64(filter.Limit))
+	}
+
 	// Execute query and scan results
 	var data []dbmodel.Collection
 	if err := px.Select(ctx, s.conn(ctx), sql, &data); err != nil {
@@ -81,10 +166,7 @@ func (s *Service) GetCollection(ctx context.Context, id entity.CollectionID) (en
 	conn := s.conn(ctx)
 
 	// Build query
-	sql := pgh.Builder().Select(
-		"id", "status", "request_count_limit", "request_duration_limit", "criteria",
-		"request_count", "created_at", "started_at",
-		"updated_at", "completed_at", "result_id", "error_message", "error_code").
+	sql := pgh.Builder().Select(collectionColumns...).
 		From("collections").
 		Where(sq.Eq{"id": id})
 
@@ -110,3 +192,37 @@ func (s *Service) GetCollection(ctx context.Context, id entity.CollectionID) (en
 
 	return collection, nil
 }
+
+// labelSelectorToSQL converts label requirements to a condition on the labels column.
+// Returns nil if there are no requirements.
+func labelSelectorToSQL(requirements []entity.LabelRequirement) (sq.Sqlizer, error) {
+	if len(requirements) == 0 {
+		return nil, nil //nolint:nilnil // no condition
+	}
+
+	conditions := make(sq.And, 0, len(requirements))
+	for _, r := range requirements {
+		switch r.Operator {
+		case entity.LabelEquals, entity.LabelNotEquals:
+			label, err := json.Marshal(map[string]string{r.Key: r.Value})
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal label %q: %w", r.Key, err)
+			}
+
+			if r.Operator == entity.LabelEquals {
+				conditions = append(conditions, sq.Expr("labels @> ?::jsonb", label))
+			} else {
+				conditions = append(conditions, sq.Expr("NOT labels @> ?::jsonb", label))
+			}
+		case entity.LabelExists:
+			// ?? is the escaped jsonb key existence operator
+			conditions = append(conditions, sq.Expr("labels ?? ?", r.Key))
+		case entity.LabelNotExists:
+			conditions = append(conditions, sq.Expr("NOT labels ?? ?", r.Key))
+		default:
+			return nil, fmt.Errorf("unknown label operator %d", r.Operator)
+		}
+	}
+
+	return conditions, nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/n-r-w/pgh/v2/px/db"
 	"github.com/n-r-w/pgh/v2/txmgr"
 	sq "github.com/n-r-w/squirrel"
+	"github.com/samber/lo"
 	"github.com/samber/mo"
 	"github.com/stretchr/testify/require"
 )
@@ -265,6 +266,232 @@ func TestGetCollections(t *testing.T) {
 	require.Empty(t, collections)
 }
 
+func TestGetCollectionsByMetadata(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, _ *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db)
+		},
+	)
+
+	task := entity.Task{
+		MessageSelection: entity.MessageSelectionCriteria{
+			Handler: "test-handler",
+		},
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 100,
+		},
+	}
+
+	metadata := []entity.Metadata{
+		{
+			Name:        "checkout",
+			Description: "checkout traffic",
+			Owner:       "payments",
+			Labels:      map[string]string{"env": "prod", "tier": "premium"},
+		},
+		{Name: "search", Owner: "search", Labels: map[string]string{"env": "staging"}},
+		{Name: "unlabeled"},
+	}
+
+	ids := make([]entity.CollectionID, 0, len(metadata))
+	for _, m := range metadata {
+		task.Metadata = m
+		id, err := s.CreateCollection(ctx, task)
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	collection, err := s.GetCollection(ctx, ids[0])
+	require.NoError(t, err)
+	require.Equal(t, metadata[0], collection.Task.Metadata)
+
+	collection, err = s.GetCollection(ctx, ids[2])
+	require.NoError(t, err)
+	require.Equal(t, metadata[2], collection.Task.Metadata)
+
+	tests := []struct {
+		selector string
+		owner    mo.Option[string]
+		want     []entity.CollectionID
+	}{
+		{selector: "env=prod", want: []entity.CollectionID{ids[0]}},
+		{selector: "env!=prod", want: []entity.CollectionID{ids[1], ids[2]}},
+		{selector: "env", want: []entity.CollectionID{ids[0], ids[1]}},
+		{selector: "!env", want: []entity.CollectionID{ids[2]}},
+		{selector: "env=prod,tier=basic"},
+		{selector: "env", owner: mo.Some("search"), want: []entity.CollectionID{ids[1]}},
+	}
+	for _, tt := range tests {
+		labels, err := entity.ParseLabelSelector(tt.selector)
+		require.NoError(t, err)
+
+		collections, err := s.GetCollections(ctx, entity.CollectionFilter{Owner: tt.owner, Labels: labels})
+		require.NoError(t, err)
+
+		got := make([]entity.CollectionID, 0, len(collections))
+		for _, c := range collections {
+			got = append(got, c.ID)
+		}
+		require.ElementsMatch(t, tt.want, got, tt.selector)
+	}
+}
+
+func TestGetCollectionsPages(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, _ *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db)
+		},
+	)
+
+	task := entity.Task{
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 100,
+		},
+	}
+
+	// two collections have the same creation time, the id breaks the tie
+	baseTime := time.Now().UTC().Truncate(time.Microsecond)
+	createdAt := []time.Time{
+		baseTime.Add(-time.Hour * 4), baseTime.Add(-time.Hour * 3), baseTime.Add(-time.Hour * 3),
+		baseTime.Add(-time.Hour * 2), baseTime.Add(-time.Hour),
+	}
+	ids := make([]entity.CollectionID, 0, len(createdAt))
+	for i, created := range createdAt {
+		task.MessageSelection.Handler = "handler-even"
+		if i%2 == 1 {
+			task.MessageSelection.Handler = "handler-odd"
+		}
+
+		id, err := s.CreateCollection(ctx, task)
+		require.NoError(t, err)
+		ids = append(ids, id)
+
+		_, err = px.Exec(ctx, s.conn(ctx),
+			pgh.Builder().Update("collections").
+				Set("created_at", created).
+				Where(sq.Eq{"id": id}))
+		require.NoError(t, err)
+	}
+
+	readPages := func(filter entity.CollectionFilter) []entity.CollectionID {
+		var got []entity.CollectionID
+		for {
+			collections, err := s.GetCollections(ctx, filter)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(collections), filter.Limit)
+
+			for _, c := range collections {
+				got = append(got, c.ID)
+			}
+			if len(collections) < filter.Limit {
+				return got
+			}
+			filter.After = mo.Some(collections[len(collections)-1].Cursor())
+		}
+	}
+
+	require.Equal(t, []entity.CollectionID{ids[4], ids[3], ids[2], ids[1], ids[0]},
+		readPages(entity.CollectionFilter{Limit: 2}))
+	require.Equal(t, []entity.CollectionID{ids[0], ids[1], ids[2], ids[3], ids[4]},
+		readPages(entity.CollectionFilter{Limit: 2, Order: entity.CollectionOrderOldestFirst}))
+	require.Equal(t, []entity.CollectionID{ids[3], ids[1]},
+		readPages(entity.CollectionFilter{Limit: 2, Handler: mo.Some("handler-odd")}))
+
+	// completion time range
+	require.NoError(t, s.UpdateStatus(ctx, ids[0], entity.StatusCompleted))
+	completed, err := s.GetCollections(ctx, entity.CollectionFilter{
+		CompletedFrom: mo.Some(baseTime.Add(-time.Minute)),
+		CompletedTo:   mo.Some(time.Now().Add(time.Minute)),
+	})
+	require.NoError(t, err)
+	require.Len(t, completed, 1)
+	require.Equal(t, ids[0], completed[0].ID)
+}
+
+func TestGetCollectionsByHandler(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, _ *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db)
+		},
+	)
+
+	completion := entity.CompletionCriteria{
+		TimeLimit:         time.Hour,
+		RequestCountLimit: 100,
+	}
+
+	handlerCriteria, err := entity.NewHandlerCriteria(entity.HandlerMatchPrefix, []string{"/api/orders", "/api/users"})
+	require.NoError(t, err)
+
+	tasks := []entity.Task{
+		{
+			MessageSelection: entity.MessageSelectionCriteria{Handler: "/api/orders"},
+			Completion:       completion,
+		},
+		{
+			MessageSelection: entity.MessageSelectionCriteria{
+				Handler:         "/api/orders",
+				HandlerCriteria: mo.Some(handlerCriteria),
+			},
+			Completion: completion,
+		},
+		{
+			Rules: []entity.SelectionRule{
+				{
+					Name:              "search",
+					Selection:         entity.MessageSelectionCriteria{Handler: "/api/search"},
+					RequestCountLimit: 50,
+				},
+				{
+					Name: "users",
+					Selection: entity.MessageSelectionCriteria{
+						Handler:         "/api/orders",
+						HandlerCriteria: mo.Some(handlerCriteria),
+					},
+					RequestCountLimit: 50,
+				},
+			},
+			Completion: completion,
+		},
+	}
+
+	ids := make([]entity.CollectionID, 0, len(tasks))
+	for _, task := range tasks {
+		id, err := s.CreateCollection(ctx, task)
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+
+	readIDs := func(handler string) []entity.CollectionID {
+		collections, err := s.GetCollections(ctx, entity.CollectionFilter{
+			Handler: mo.Some(handler),
+			Order:   entity.CollectionOrderOldestFirst,
+		})
+		require.NoError(t, err)
+
+		return lo.Map(collections, func(c entity.Collection, _ int) entity.CollectionID { return c.ID })
+	}
+
+	require.Equal(t, ids, readIDs("/api/orders"))
+	require.Equal(t, []entity.CollectionID{ids[1], ids[2]}, readIDs("/api/users"))
+	require.Equal(t, []entity.CollectionID{ids[2]}, readIDs("/api/search"))
+	// handlers are matched case-insensitively, as by the request processor
+	require.Equal(t, []entity.CollectionID{ids[2]}, readIDs("/API/Search"))
+	// patterns are not matched against the handler
+	require.Empty(t, readIDs("/api/orders/1"))
+}
+
 func TestCreateCollection(t *testing.T) {
 	t.Parallel()
 
@@ -275,6 +502,9 @@ func TestCreateCollection(t *testing.T) {
 		},
 	)
 
+	bodyCriteria, err := entity.NewBodyCriteria("$.tier", entity.BodyOperatorEqual, `"premium"`)
+	require.NoError(t, err)
+
 	task := entity.Task{
 		MessageSelection: entity.MessageSelectionCriteria{
 			Handler: "handler-name",
@@ -284,6 +514,17 @@ func TestCreateCollection(t *testing.T) {
 					Pattern:    regexp.MustCompile(`^application/json.*$`),
 				},
 			},
+			HeaderGroup: mo.Some(entity.HeaderGroup{
+				Operator: entity.HeaderGroupNone,
+				Criteria: []entity.HeaderCriteria{
+					{
+						HeaderName: "x-canary",
+						Pattern:    regexp.MustCompile(`^true$`),
+					},
+				},
+				Groups: []entity.HeaderGroup{},
+			}),
+			BodyCriteria: []entity.BodyCriteria{bodyCriteria},
 		},
 		Completion: entity.CompletionCriteria{
 			TimeLimit:         time.Hour,
@@ -319,8 +560,79 @@ func TestCreateCollection(t *testing.T) {
 	"pattern": "^application/json.*$",
 	"headerName": "header-name"
 	}
-]  
+],
+"headerGroup": {
+	"operator": 3,
+	"headerCriteria": [
+		{
+		"pattern": "^true$",
+		"headerName": "x-canary"
+		}
+	]
+},
+"bodyCriteria": [
+	{
+	"path": "$.tier",
+	"operator": 1,
+	"value": "\"premium\""
+	}
+]
 }`
 
 	require.JSONEq(t, expectedCriteria, string(dbCollection.Criteria))
+
+	collection, err := sql.ConvertCollectionToEntity(*dbCollection)
+	require.NoError(t, err)
+	require.Equal(t, task.MessageSelection.BodyCriteria, collection.Task.MessageSelection.BodyCriteria)
+	require.Equal(t, task.MessageSelection.HeaderGroup, collection.Task.MessageSelection.HeaderGroup)
+}
+
+func TestCreateCollectionRules(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, _ *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db)
+		},
+	)
+
+	task := entity.Task{
+		Rules: []entity.SelectionRule{
+			{
+				Name:              "search",
+				Selection:         entity.MessageSelectionCriteria{Handler: "search", HeaderCriteria: []entity.HeaderCriteria{}},
+				RequestCountLimit: 60,
+				Percent:           mo.Some(60),
+			},
+			{
+				Name:              "checkout",
+				Selection:         entity.MessageSelectionCriteria{Handler: "checkout", HeaderCriteria: []entity.HeaderCriteria{}},
+				RequestCountLimit: 10,
+			},
+		},
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 70,
+		},
+	}
+
+	id, err := s.CreateCollection(ctx, task)
+	require.NoError(t, err)
+
+	for i, rule := range task.Rules {
+		dbRule, err := dbmodel.CollectionRuleByCollectionIDRuleID(ctx, s.conn(ctx), int64(id), i)
+		require.NoError(t, err)
+		require.Equal(t, rule.Name, dbRule.Name)
+		require.Equal(t, rule.RequestCountLimit, dbRule.RequestCountLimit)
+		require.Zero(t, dbRule.RequestCount)
+	}
+
+	collection, err := s.GetCollection(ctx, id)
+	require.NoError(t, err)
+	require.Len(t, collection.Task.Rules, 2)
+	require.Equal(t, task.Rules[0].Name, collection.Task.Rules[0].Name)
+	require.Equal(t, task.Rules[0].Percent, collection.Task.Rules[0].Percent)
+	require.Equal(t, task.Rules[1].Selection.Handler, collection.Task.Rules[1].Selection.Handler)
+	require.Equal(t, task.Rules[1].RequestCountLimit, collection.Task.Rules[1].RequestCountLimit)
 }
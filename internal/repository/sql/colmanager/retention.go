@@ -0,0 +1,96 @@
+package colmanager
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/n-r-w/collector/internal/entity"
+	sqlrepo "github.com/n-r-w/collector/internal/repository/sql"
+	"github.com/n-r-w/collector/internal/repository/sql/dbmodel"
+	"github.com/n-r-w/pgh/v2"
+	"github.com/n-r-w/pgh/v2/px"
+	sq "github.com/n-r-w/squirrel"
+)
+
+// UpdateRetention updates collection retention policy.
+func (s *Service) UpdateRetention(
+	ctx context.Context, collectionID entity.CollectionID, retention entity.RetentionPolicy,
+) error {
+	sql := pgh.Builder().Update("collections").
+		Set("retention_period", retention.Period.ToPointer()).
+		Set("pinned", retention.Pinned).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": collectionID})
+
+	result, err := px.Exec(ctx, s.conn(ctx), sql)
+	if err != nil {
+		return fmt.Errorf("failed to update retention for collection id %d: %w", collectionID, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf(
+			"failed to update retention for collection id %d: %w", collectionID, entity.ErrCollectionNotFound)
+	}
+
+	return nil
+}
+
+// GetExpiredCollections returns collections whose retention period has expired.
+// Collections without own retention period use global settings. Pinned collections never expire.
+// Retention periods not counted from completion are counted from the start of collecting
+// and extended by pauses that froze the time limit, so collections are not removed before they are completed.
+func (s *Service) GetExpiredCollections(
+	ctx context.Context, now time.Time, settings entity.RetentionSettings,
+) ([]entity.Collection, error) {
+	// the current pause is counted up to now
+	retentionStart := "COALESCE(start_at, created_at) + frozen_duration + " +
+		"CASE WHEN freeze_time_limit AND paused_at IS NOT NULL THEN ?::timestamptz - paused_at ELSE '0' END"
+
+	// global retention period depends on the status
+	statuses := slices.Sorted(maps.Keys(settings.ByStatus))
+	byGlobalSettings := sq.Or{}
+	for _, status := range statuses {
+		byGlobalSettings = append(byGlobalSettings, sq.And{
+			sq.Eq{"status": status},
+			sq.Expr("completed_at + ?::interval <= ?", settings.ByStatus[status], now),
+		})
+	}
+	byGlobalSettings = append(byGlobalSettings, sq.And{
+		sq.NotEq{"status": statuses},
+		sq.Expr(retentionStart+" + ?::interval <= ?", now, settings.Default, now),
+	})
+
+	sql := pgh.Builder().Select(collectionColumns...).
+		From("collections").
+		Where(sq.Eq{"pinned": false}).
+		Where(sq.Or{
+			// own retention period
+			sq.And{
+				sq.NotEq{"retention_period": nil},
+				sq.Expr(retentionStart+" + retention_period <= ?", now, now),
+			},
+			sq.And{
+				sq.Eq{"retention_period": nil},
+				byGlobalSettings,
+			},
+		})
+
+	var data []dbmodel.Collection
+	if err := px.Select(ctx, s.conn(ctx), sql, &data); err != nil {
+		return nil, fmt.Errorf("GetExpiredCollections: %w", err)
+	}
+
+	collections := make([]entity.Collection, len(data))
+	for i, collection := range data {
+		collection, err := sqlrepo.ConvertCollectionToEntity(collection)
+		if err != nil {
+			return nil, fmt.Errorf("GetExpiredCollections: failed to convert collection to entity: %w", err)
+		}
+		collections[i] = collection
+	}
+
+	return collections, nil
+}
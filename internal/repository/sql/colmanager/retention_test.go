@@ -0,0 +1,122 @@
+package colmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/n-r-w/collector/internal/config"
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/collector/internal/repository/sql"
+	"github.com/n-r-w/ctxlog"
+	"github.com/n-r-w/pgh/v2/px/db"
+	"github.com/n-r-w/pgh/v2/txmgr"
+	"github.com/samber/lo"
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetention(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, _ *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db)
+		},
+	)
+
+	newTask := func(retention entity.RetentionPolicy) entity.Task {
+		return entity.Task{
+			MessageSelection: entity.MessageSelectionCriteria{
+				Handler: "test-handler",
+			},
+			Completion: entity.CompletionCriteria{
+				TimeLimit:         time.Minute,
+				RequestCountLimit: 100,
+			},
+			Retention: retention,
+		}
+	}
+
+	defaultID, err := s.CreateCollection(ctx, newTask(entity.RetentionPolicy{}))
+	require.NoError(t, err)
+
+	shortID, err := s.CreateCollection(ctx, newTask(entity.RetentionPolicy{Period: mo.Some(time.Hour)}))
+	require.NoError(t, err)
+
+	pinnedID, err := s.CreateCollection(ctx, newTask(entity.RetentionPolicy{Pinned: true}))
+	require.NoError(t, err)
+
+	// Verify the retention policy is stored
+	collection, err := s.GetCollection(ctx, shortID)
+	require.NoError(t, err)
+	require.Equal(t, mo.Some(time.Hour), collection.Task.Retention.Period)
+	require.False(t, collection.Task.Retention.Pinned)
+
+	collection, err = s.GetCollection(ctx, pinnedID)
+	require.NoError(t, err)
+	require.True(t, collection.Task.Retention.Period.IsAbsent())
+	require.True(t, collection.Task.Retention.Pinned)
+
+	expiredIDs := func(now time.Time, defaultRetention time.Duration) []entity.CollectionID {
+		collections, err := s.GetExpiredCollections(ctx, now, entity.RetentionSettings{Default: defaultRetention})
+		require.NoError(t, err)
+
+		return lo.Map(collections, func(c entity.Collection, _ int) entity.CollectionID { return c.ID })
+	}
+
+	// Only the collection with own short retention period is expired
+	require.ElementsMatch(t, []entity.CollectionID{shortID}, expiredIDs(time.Now().Add(2*time.Hour), 24*time.Hour))
+
+	// Pinned collection is never expired
+	require.ElementsMatch(t,
+		[]entity.CollectionID{defaultID, shortID}, expiredIDs(time.Now().Add(48*time.Hour), 24*time.Hour))
+
+	// Unpin collection and extend retention of the short one
+	require.NoError(t, s.UpdateRetention(ctx, pinnedID, entity.RetentionPolicy{}))
+	require.NoError(t, s.UpdateRetention(ctx, shortID, entity.RetentionPolicy{Period: mo.Some(72 * time.Hour)}))
+
+	require.ElementsMatch(t,
+		[]entity.CollectionID{defaultID, pinnedID}, expiredIDs(time.Now().Add(48*time.Hour), 24*time.Hour))
+
+	// Per-status retention period is counted from completion time
+	completedID, err := s.CreateCollection(ctx, newTask(entity.RetentionPolicy{}))
+	require.NoError(t, err)
+	require.NoError(t, s.UpdateStatus(ctx, completedID, entity.StatusCompleted))
+
+	settings := entity.RetentionSettings{
+		Default: 24 * time.Hour,
+		ByStatus: map[entity.CollectionStatus]time.Duration{
+			entity.StatusCompleted: time.Hour,
+			entity.StatusCancelled: time.Hour,
+		},
+	}
+	collections, err := s.GetExpiredCollections(ctx, time.Now().Add(2*time.Hour), settings)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []entity.CollectionID{completedID},
+		lo.Map(collections, func(c entity.Collection, _ int) entity.CollectionID { return c.ID }))
+
+	// Retention of delayed collections is counted from the start, frozen pauses extend it
+	delayedTask := newTask(entity.RetentionPolicy{Period: mo.Some(2 * time.Hour)})
+	delayedTask.StartAt = mo.Some(time.Now().Add(24 * time.Hour))
+	delayedID, err := s.CreateCollection(ctx, delayedTask)
+	require.NoError(t, err)
+
+	frozenID, err := s.CreateCollection(ctx, newTask(entity.RetentionPolicy{Period: mo.Some(2 * time.Hour)}))
+	require.NoError(t, err)
+	require.NoError(t, s.PauseCollection(ctx, frozenID, true))
+
+	pausedID, err := s.CreateCollection(ctx, newTask(entity.RetentionPolicy{Period: mo.Some(2 * time.Hour)}))
+	require.NoError(t, err)
+	require.NoError(t, s.PauseCollection(ctx, pausedID, false))
+
+	ownExpired := func(now time.Time) []entity.CollectionID {
+		return lo.Intersect([]entity.CollectionID{delayedID, frozenID, pausedID}, expiredIDs(now, 24*time.Hour))
+	}
+	require.ElementsMatch(t, []entity.CollectionID{pausedID}, ownExpired(time.Now().Add(3*time.Hour)))
+	require.ElementsMatch(t, []entity.CollectionID{delayedID, pausedID}, ownExpired(time.Now().Add(27*time.Hour)))
+
+	// Not existing collection
+	err = s.UpdateRetention(ctx, entity.CollectionID(-1), entity.RetentionPolicy{})
+	require.ErrorIs(t, err, entity.ErrCollectionNotFound)
+}
@@ -0,0 +1,153 @@
+package colmanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/n-r-w/collector/internal/entity"
+	sqlrepo "github.com/n-r-w/collector/internal/repository/sql"
+	"github.com/n-r-w/collector/internal/repository/sql/dbmodel"
+	"github.com/n-r-w/pgh/v2"
+	"github.com/n-r-w/pgh/v2/px"
+	sq "github.com/n-r-w/squirrel"
+)
+
+// scheduleColumns is the list of columns to read a schedule.
+var scheduleColumns = []string{
+	"id", "cron_expression", "request_count_limit", "request_duration_limit", "criteria",
+	"retention_period", "pinned", "distinct_bodies", "reservoir", "max_per_value", "min_distinct_values",
+	"next_run_at", "last_run_at", "last_collection_id", "created_at", "idle_timeout", "byte_size_limit",
+	"min_request_count", "time_limit_from_first_request", "max_wait", "name", "description", "owner", "labels",
+}
+
+// CreateSchedule creates a new schedule and returns its ID.
+func (s *Service) CreateSchedule(ctx context.Context, schedule entity.Schedule) (entity.ScheduleID, error) {
+	task := schedule.Task
+
+	criteriaBytes, err := sqlrepo.ConvertTaskToCriteriaDB(task)
+	if err != nil {
+		return 0, fmt.Errorf("CreateSchedule: failed to convert criteria to bytes: %w", err)
+	}
+
+	labels, err := sqlrepo.ConvertLabelsToDB(task.Metadata.Labels)
+	if err != nil {
+		return 0, fmt.Errorf("CreateSchedule: %w", err)
+	}
+
+	stratification := task.Stratification.OrEmpty()
+
+	sql := pgh.Builder().
+		Insert("schedules").
+		Columns("cron_expression", "request_count_limit", "request_duration_limit", "criteria", "retention_period",
+			"pinned", "distinct_bodies", "reservoir", "max_per_value", "min_distinct_values", "next_run_at",
+			"idle_timeout", "byte_size_limit", "min_request_count", "time_limit_from_first_request", "max_wait",
+			"name", "description", "owner", "labels").
+		Values(schedule.Cron.String(), task.Completion.RequestCountLimit, task.Completion.TimeLimit, criteriaBytes,
+			task.Retention.Period.ToPointer(), task.Retention.Pinned, task.MessageSelection.DistinctBodies,
+			task.Completion.Reservoir, stratification.MaxPerValue, stratification.MinDistinctValues,
+			schedule.NextRunAt, task.Completion.IdleTimeout, task.Completion.ByteSizeLimit,
+			task.Completion.MinRequestCount, task.Completion.TimeLimitFromFirstRequest, task.Completion.MaxWait,
+			task.Metadata.Name, task.Metadata.Description, task.Metadata.Owner, labels).
+		Suffix("RETURNING id")
+
+	var scheduleID entity.ScheduleID
+	if err := px.SelectOne(ctx, s.conn(ctx), sql, &scheduleID); err != nil {
+		return 0, fmt.Errorf("CreateSchedule: failed to insert schedule: %w", err)
+	}
+
+	return scheduleID, nil
+}
+
+// GetSchedules returns all schedules.
+func (s *Service) GetSchedules(ctx context.Context) ([]entity.Schedule, error) {
+	sql := pgh.Builder().Select(scheduleColumns...).
+		From("schedules").
+		OrderBy("id")
+
+	return s.selectSchedules(ctx, sql)
+}
+
+// GetDueSchedules returns schedules that must create a collection at the given time.
+// Schedules are not locked, the run of a schedule deleted concurrently fails to be saved.
+func (s *Service) GetDueSchedules(ctx context.Context, now time.Time, limit int) ([]entity.Schedule, error) {
+	sql := pgh.Builder().Select(scheduleColumns...).
+		From("schedules").
+		Where(sq.LtOrEq{"next_run_at": now}).
+		OrderBy("next_run_at").
+		Limit(uint64(limit)) //nolint:gosec // checked in config
+
+	return s.selectSchedules(ctx, sql)
+}
+
+func (s *Service) selectSchedules(ctx context.Context, sql sq.SelectBuilder) ([]entity.Schedule, error) {
+	var data []dbmodel.Schedule
+	if err := px.Select(ctx, s.conn(ctx), sql, &data); err != nil {
+		return nil, fmt.Errorf("GetSchedules: %w", err)
+	}
+
+	schedules := make([]entity.Schedule, len(data))
+	for i, schedule := range data {
+		schedule, err := sqlrepo.ConvertScheduleToEntity(schedule)
+		if err != nil {
+			return nil, fmt.Errorf("GetSchedules: failed to convert schedule to entity: %w", err)
+		}
+		schedules[i] = schedule
+	}
+
+	return schedules, nil
+}
+
+// SetScheduleRun saves the collection created by the schedule and the time of the next one.
+func (s *Service) SetScheduleRun(ctx context.Context, run entity.ScheduleRun) error {
+	sql := pgh.Builder().Update("schedules").
+		Set("last_run_at", run.RunAt).
+		Set("last_collection_id", run.CollectionID).
+		Set("next_run_at", run.NextRunAt).
+		Where(sq.Eq{"id": run.ScheduleID})
+
+	result, err := px.Exec(ctx, s.conn(ctx), sql)
+	if err != nil {
+		return fmt.Errorf("failed to update run of schedule id %d: %w", run.ScheduleID, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("failed to update run of schedule id %d: %w", run.ScheduleID, entity.ErrScheduleNotFound)
+	}
+
+	return nil
+}
+
+// SkipScheduleRun moves the schedule to the next run without creating a collection.
+func (s *Service) SkipScheduleRun(ctx context.Context, id entity.ScheduleID, nextRunAt time.Time) error {
+	sql := pgh.Builder().Update("schedules").
+		Set("next_run_at", nextRunAt).
+		Where(sq.Eq{"id": id})
+
+	result, err := px.Exec(ctx, s.conn(ctx), sql)
+	if err != nil {
+		return fmt.Errorf("failed to skip run of schedule id %d: %w", id, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("failed to skip run of schedule id %d: %w", id, entity.ErrScheduleNotFound)
+	}
+
+	return nil
+}
+
+// DeleteSchedule deletes a schedule. Collections created by it are kept.
+func (s *Service) DeleteSchedule(ctx context.Context, id entity.ScheduleID) error {
+	sql := pgh.Builder().Delete("schedules").Where(sq.Eq{"id": id})
+
+	result, err := px.Exec(ctx, s.conn(ctx), sql)
+	if err != nil {
+		return fmt.Errorf("failed to delete schedule id %d: %w", id, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("failed to delete schedule id %d: %w", id, entity.ErrScheduleNotFound)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package colmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/n-r-w/collector/internal/config"
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/collector/internal/repository/sql"
+	"github.com/n-r-w/ctxlog"
+	"github.com/n-r-w/pgh/v2/px/db"
+	"github.com/n-r-w/pgh/v2/txmgr"
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchedules(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, _ *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db)
+		},
+	)
+
+	cron, err := entity.ParseCronExpression("*/5 * * * *")
+	require.NoError(t, err)
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	task := entity.Task{
+		MessageSelection: entity.MessageSelectionCriteria{
+			Handler: "test-handler",
+		},
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Minute,
+			RequestCountLimit: 100,
+		},
+		Retention: entity.RetentionPolicy{Period: mo.Some(time.Hour)},
+		Metadata: entity.Metadata{
+			Name:   "nightly",
+			Owner:  "team",
+			Labels: map[string]string{"env": "prod"},
+		},
+	}
+
+	dueID, err := s.CreateSchedule(ctx, entity.Schedule{Cron: cron, Task: task, NextRunAt: now.Add(-time.Minute)})
+	require.NoError(t, err)
+
+	laterID, err := s.CreateSchedule(ctx, entity.Schedule{Cron: cron, Task: task, NextRunAt: now.Add(time.Hour)})
+	require.NoError(t, err)
+
+	schedules, err := s.GetSchedules(ctx)
+	require.NoError(t, err)
+	require.Len(t, schedules, 2)
+	require.Equal(t, dueID, schedules[0].ID)
+	require.Equal(t, "*/5 * * * *", schedules[0].Cron.String())
+	require.Equal(t, task.MessageSelection.Handler, schedules[0].Task.MessageSelection.Handler)
+	require.Equal(t, task.Completion, schedules[0].Task.Completion)
+	require.Equal(t, task.Retention, schedules[0].Task.Retention)
+	require.Equal(t, task.Metadata, schedules[0].Task.Metadata)
+	require.True(t, schedules[0].LastRunAt.IsAbsent())
+
+	// Only the due schedule is returned
+	due, err := s.GetDueSchedules(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Equal(t, dueID, due[0].ID)
+
+	collectionID, err := s.CreateCollection(ctx, due[0].Task)
+	require.NoError(t, err)
+
+	require.NoError(t, s.SetScheduleRun(ctx, entity.ScheduleRun{
+		ScheduleID:   dueID,
+		CollectionID: collectionID,
+		RunAt:        now,
+		NextRunAt:    now.Add(5 * time.Minute),
+	}))
+
+	due, err = s.GetDueSchedules(ctx, now, 10)
+	require.NoError(t, err)
+	require.Empty(t, due)
+
+	schedules, err = s.GetSchedules(ctx)
+	require.NoError(t, err)
+	require.Equal(t, mo.Some(collectionID), schedules[0].LastCollectionID)
+	require.True(t, schedules[0].LastRunAt.MustGet().Equal(now))
+
+	// A skipped run doesn't change the last run
+	require.NoError(t, s.SkipScheduleRun(ctx, laterID, now.Add(-time.Minute)))
+
+	due, err = s.GetDueSchedules(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	require.Equal(t, laterID, due[0].ID)
+	require.True(t, due[0].LastRunAt.IsAbsent())
+
+	require.NoError(t, s.SkipScheduleRun(ctx, laterID, now.Add(time.Hour)))
+
+	// Collections created by the schedule are kept
+	require.NoError(t, s.DeleteSchedule(ctx, dueID))
+	_, err = s.GetCollection(ctx, collectionID)
+	require.NoError(t, err)
+
+	schedules, err = s.GetSchedules(ctx)
+	require.NoError(t, err)
+	require.Len(t, schedules, 1)
+	require.Equal(t, laterID, schedules[0].ID)
+
+	// Not existing schedule
+	require.ErrorIs(t, s.DeleteSchedule(ctx, dueID), entity.ErrScheduleNotFound)
+	require.ErrorIs(t, s.SetScheduleRun(ctx, entity.ScheduleRun{ScheduleID: dueID}), entity.ErrScheduleNotFound)
+	require.ErrorIs(t, s.SkipScheduleRun(ctx, dueID, now), entity.ErrScheduleNotFound)
+}
@@ -7,7 +7,9 @@ import (
 	"github.com/n-r-w/collector/internal/repository/sql"
 	"github.com/n-r-w/collector/internal/usecases/apiprocessor"
 	"github.com/n-r-w/collector/internal/usecases/cache"
+	"github.com/n-r-w/collector/internal/usecases/cleaner"
 	"github.com/n-r-w/collector/internal/usecases/finalizer"
+	"github.com/n-r-w/collector/internal/usecases/scheduler"
 	"github.com/n-r-w/pgh/v2/px/db"
 	"github.com/n-r-w/pgh/v2/px/db/conn"
 )
@@ -24,6 +26,10 @@ var (
 	_ apiprocessor.ICollectionCreator = (*Service)(nil)
 	_ apiprocessor.ICollectionReader  = (*Service)(nil)
 	_ apiprocessor.ICollectionUpdater = (*Service)(nil)
+	_ cleaner.ICollectionReader       = (*Service)(nil)
+	_ apiprocessor.IScheduleStorer    = (*Service)(nil)
+	_ scheduler.IScheduleStorer       = (*Service)(nil)
+	_ scheduler.ICollectionCreator    = (*Service)(nil)
 )
 
 // New creates a new instance of Service.
@@ -35,6 +35,11 @@ func (s *Service) UpdateStatus(
 		sql = sql.Set("completed_at", now)
 	}
 
+	// errors of previous finalization attempts are not relevant for the completed collection
+	if status == entity.StatusCompleted {
+		sql = sql.Set("error_code", nil).Set("error_message", nil)
+	}
+
 	// Execute update
 	result, err := px.Exec(ctx, conn, sql)
 	if err != nil {
@@ -50,3 +55,120 @@ func (s *Service) UpdateStatus(
 
 	return nil
 }
+
+// FailCollection marks an active collection as failed with the error code and message.
+func (s *Service) FailCollection(
+	ctx context.Context, collectionID entity.CollectionID, code entity.ErrorCode, message string,
+) error {
+	now := time.Now()
+
+	sql := pgh.Builder().Update("collections").
+		Set("status", entity.StatusFailed).
+		Set("error_code", code).
+		Set("error_message", message).
+		Set("updated_at", now).
+		Set("completed_at", now).
+		Where(sq.Eq{"id": collectionID}).
+		Where(sq.Eq{"status": entity.ActiveCollectionStatuses()})
+
+	result, err := px.Exec(ctx, s.conn(ctx), sql)
+	if err != nil {
+		return fmt.Errorf("failed to mark collection id %d as failed: %w", collectionID, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("failed to mark collection id %d as failed: %w", collectionID, entity.ErrCollectionNotFound)
+	}
+
+	return nil
+}
+
+// RecordFinalizationFailure records the error of a failed finalization attempt of an active collection.
+// The status is not changed until the number of failed attempts reaches maxAttempts, then the collection is failed.
+// Returns true, if the collection is failed.
+func (s *Service) RecordFinalizationFailure(
+	ctx context.Context, collectionID entity.CollectionID, message string, maxAttempts int,
+) (bool, error) {
+	now := time.Now()
+	exhausted := "finalization_attempts + 1 >= ?"
+
+	sql := pgh.Builder().Update("collections").
+		Set("finalization_attempts", sq.Expr("finalization_attempts + 1")).
+		Set("status", sq.Expr("CASE WHEN "+exhausted+" THEN ? ELSE status END", maxAttempts, entity.StatusFailed)).
+		Set("completed_at", sq.Expr(
+			"CASE WHEN "+exhausted+" THEN ?::timestamptz ELSE completed_at END", maxAttempts, now)).
+		Set("error_code", entity.ErrorCodeFinalizationFailed).
+		Set("error_message", message).
+		Set("updated_at", now).
+		Where(sq.Eq{"id": collectionID}).
+		Where(sq.Eq{"status": entity.ActiveCollectionStatuses()}).
+		Suffix("RETURNING status")
+
+	var statuses []entity.CollectionStatus
+	if err := px.Select(ctx, s.conn(ctx), sql, &statuses); err != nil {
+		return false, fmt.Errorf("failed to record finalization failure of collection id %d: %w", collectionID, err)
+	}
+
+	if len(statuses) == 0 {
+		return false, fmt.Errorf(
+			"failed to record finalization failure of collection id %d: %w", collectionID, entity.ErrCollectionNotFound)
+	}
+
+	return statuses[0] == entity.StatusFailed, nil
+}
+
+// PauseCollection pauses a collecting collection.
+func (s *Service) PauseCollection(
+	ctx context.Context, collectionID entity.CollectionID, freezeTimeLimit bool,
+) error {
+	now := time.Now()
+
+	sql := pgh.Builder().Update("collections").
+		Set("status", entity.StatusPaused).
+		Set("paused_at", now).
+		Set("freeze_time_limit", freezeTimeLimit).
+		Set("updated_at", now).
+		Where(sq.Eq{"id": collectionID}).
+		Where(sq.Eq{"status": entity.CollectingCollectionStatuses()})
+
+	result, err := px.Exec(ctx, s.conn(ctx), sql)
+	if err != nil {
+		return fmt.Errorf("failed to pause collection id %d: %w", collectionID, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("failed to pause collection id %d: %w", collectionID, entity.ErrCollectionNotFound)
+	}
+
+	return nil
+}
+
+// ResumeCollection resumes a paused collection. The collection returns to pending, if it has no requests yet.
+// The duration of the pause is added to the frozen duration, if the pause freezes the time limit.
+func (s *Service) ResumeCollection(ctx context.Context, collectionID entity.CollectionID) error {
+	now := time.Now()
+
+	sql := pgh.Builder().Update("collections").
+		Set("status", sq.Expr("CASE WHEN started_at IS NULL THEN ? ELSE ? END",
+			entity.StatusPending, entity.StatusInProgress)).
+		Set("frozen_duration", sq.Expr(
+			"CASE WHEN freeze_time_limit THEN frozen_duration + (?::timestamptz - paused_at) ELSE frozen_duration END",
+			now)).
+		Set("paused_at", nil).
+		Set("resumed_at", now).
+		Set("freeze_time_limit", false).
+		Set("updated_at", now).
+		Where(sq.Eq{"id": collectionID}).
+		Where(sq.Eq{"status": entity.StatusPaused})
+
+	result, err := px.Exec(ctx, s.conn(ctx), sql)
+	if err != nil {
+		return fmt.Errorf("failed to resume collection id %d: %w", collectionID, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("failed to resume collection id %d: %w", collectionID, entity.ErrCollectionNotFound)
+	}
+
+	return nil
+}
@@ -87,3 +87,167 @@ func TestUpdateStatus(t *testing.T) {
 	err = s.UpdateStatus(ctx, collectionID, entity.CollectionStatus(999))
 	require.ErrorIs(t, err, entity.ErrInvalidStatus)
 }
+
+func TestFailCollection(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, _ *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db)
+		},
+	)
+
+	task := entity.Task{
+		MessageSelection: entity.MessageSelectionCriteria{
+			Handler: "test-handler",
+		},
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 100,
+			MinRequestCount:   10,
+		},
+	}
+
+	collectionID, err := s.CreateCollection(ctx, task)
+	require.NoError(t, err)
+
+	err = s.FailCollection(ctx, collectionID, entity.ErrorCodeNotEnoughRequests, "collected 0 requests of minimum 10")
+	require.NoError(t, err)
+
+	collection, err := s.GetCollection(ctx, collectionID)
+	require.NoError(t, err)
+	require.Equal(t, entity.StatusFailed, collection.Status)
+	require.Equal(t, entity.ErrorCodeNotEnoughRequests, collection.ErrorCode.MustGet())
+	require.Equal(t, "collected 0 requests of minimum 10", collection.ErrorMessage.MustGet())
+	require.True(t, collection.CompletedAt.IsPresent())
+	require.Equal(t, 10, collection.Task.Completion.MinRequestCount)
+
+	// Terminal collections are not changed
+	err = s.FailCollection(ctx, collectionID, entity.ErrorCodeFinalizationFailed, "error")
+	require.ErrorIs(t, err, entity.ErrCollectionNotFound)
+}
+
+func TestRecordFinalizationFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, _ *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db)
+		},
+	)
+
+	task := entity.Task{
+		MessageSelection: entity.MessageSelectionCriteria{
+			Handler: "test-handler",
+		},
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 100,
+		},
+	}
+
+	collectionID, err := s.CreateCollection(ctx, task)
+	require.NoError(t, err)
+
+	// the first attempt only records the error
+	failed, err := s.RecordFinalizationFailure(ctx, collectionID, "connection reset", 2)
+	require.NoError(t, err)
+	require.False(t, failed)
+
+	collection, err := s.GetCollection(ctx, collectionID)
+	require.NoError(t, err)
+	require.Equal(t, entity.StatusPending, collection.Status)
+	require.Equal(t, entity.ErrorCodeFinalizationFailed, collection.ErrorCode.MustGet())
+	require.Equal(t, "connection reset", collection.ErrorMessage.MustGet())
+	require.False(t, collection.CompletedAt.IsPresent())
+
+	// the last attempt fails the collection
+	failed, err = s.RecordFinalizationFailure(ctx, collectionID, "timeout", 2)
+	require.NoError(t, err)
+	require.True(t, failed)
+
+	collection, err = s.GetCollection(ctx, collectionID)
+	require.NoError(t, err)
+	require.Equal(t, entity.StatusFailed, collection.Status)
+	require.Equal(t, "timeout", collection.ErrorMessage.MustGet())
+	require.True(t, collection.CompletedAt.IsPresent())
+
+	// terminal collections are not changed
+	_, err = s.RecordFinalizationFailure(ctx, collectionID, "error", 2)
+	require.ErrorIs(t, err, entity.ErrCollectionNotFound)
+
+	// the error of a previous attempt is cleared on completion
+	collectionID, err = s.CreateCollection(ctx, task)
+	require.NoError(t, err)
+
+	_, err = s.RecordFinalizationFailure(ctx, collectionID, "connection reset", 2)
+	require.NoError(t, err)
+	require.NoError(t, s.UpdateStatus(ctx, collectionID, entity.StatusCompleted))
+
+	collection, err = s.GetCollection(ctx, collectionID)
+	require.NoError(t, err)
+	require.Equal(t, entity.StatusCompleted, collection.Status)
+	require.False(t, collection.ErrorCode.IsPresent())
+	require.False(t, collection.ErrorMessage.IsPresent())
+}
+
+func TestPauseCollection(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, _ *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db)
+		},
+	)
+
+	task := entity.Task{
+		MessageSelection: entity.MessageSelectionCriteria{
+			Handler: "test-handler",
+		},
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 100,
+		},
+	}
+
+	collectionID, err := s.CreateCollection(ctx, task)
+	require.NoError(t, err)
+
+	// Resume of not paused collection
+	require.ErrorIs(t, s.ResumeCollection(ctx, collectionID), entity.ErrCollectionNotFound)
+
+	require.NoError(t, s.PauseCollection(ctx, collectionID, true))
+
+	collection, err := s.GetCollection(ctx, collectionID)
+	require.NoError(t, err)
+	require.Equal(t, entity.StatusPaused, collection.Status)
+	require.True(t, collection.Pause.PausedAt.IsPresent())
+	require.True(t, collection.Pause.FreezeTimeLimit)
+
+	// Paused collection can't be paused again
+	require.ErrorIs(t, s.PauseCollection(ctx, collectionID, false), entity.ErrCollectionNotFound)
+
+	time.Sleep(time.Millisecond * 10)
+	require.NoError(t, s.ResumeCollection(ctx, collectionID))
+
+	// Collection without requests returns to pending
+	collection, err = s.GetCollection(ctx, collectionID)
+	require.NoError(t, err)
+	require.Equal(t, entity.StatusPending, collection.Status)
+	require.True(t, collection.Pause.PausedAt.IsAbsent())
+	require.True(t, collection.Pause.ResumedAt.IsPresent())
+	require.False(t, collection.Pause.FreezeTimeLimit)
+	require.GreaterOrEqual(t, collection.Pause.FrozenDuration, time.Millisecond*10)
+
+	// Pause without freezing doesn't change the frozen duration
+	frozen := collection.Pause.FrozenDuration
+	require.NoError(t, s.PauseCollection(ctx, collectionID, false))
+	require.NoError(t, s.ResumeCollection(ctx, collectionID))
+
+	collection, err = s.GetCollection(ctx, collectionID)
+	require.NoError(t, err)
+	require.Equal(t, frozen, collection.Pause.FrozenDuration)
+}
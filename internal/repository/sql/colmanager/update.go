@@ -0,0 +1,67 @@
+package colmanager
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/n-r-w/collector/internal/entity"
+	sqlrepo "github.com/n-r-w/collector/internal/repository/sql"
+	"github.com/n-r-w/pgh/v2"
+	"github.com/n-r-w/pgh/v2/px"
+	sq "github.com/n-r-w/squirrel"
+)
+
+// UpdateCollection stores the changeable parameters of a collecting or paused collection.
+func (s *Service) UpdateCollection(ctx context.Context, collectionID entity.CollectionID, task entity.Task) error {
+	criteriaBytes, err := sqlrepo.ConvertTaskToCriteriaDB(task)
+	if err != nil {
+		return fmt.Errorf("UpdateCollection: failed to convert criteria to bytes: %w", err)
+	}
+
+	sql := pgh.Builder().Update("collections").
+		Set("request_count_limit", task.Completion.RequestCountLimit).
+		Set("request_duration_limit", task.Completion.TimeLimit).
+		Set("idle_timeout", task.Completion.IdleTimeout).
+		Set("byte_size_limit", task.Completion.ByteSizeLimit).
+		Set("criteria", criteriaBytes).
+		Set("distinct_bodies", task.MessageSelection.DistinctBodies).
+		Set("updated_at", time.Now()).
+		Where(sq.Eq{"id": collectionID}).
+		Where(sq.Eq{"status": append(entity.CollectingCollectionStatuses(), entity.StatusPaused)})
+
+	result, err := px.Exec(ctx, s.conn(ctx), sql)
+	if err != nil {
+		return fmt.Errorf("failed to update collection id %d: %w", collectionID, err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("failed to update collection id %d: %w", collectionID, entity.ErrCollectionNotFound)
+	}
+
+	return nil
+}
+
+// AddCollectionChange records who changed the collection and the values of the changed fields.
+func (s *Service) AddCollectionChange(ctx context.Context, change entity.CollectionChange) error {
+	previous, err := sqlrepo.ConvertChangeToDB(change.Previous, change.Fields)
+	if err != nil {
+		return fmt.Errorf("AddCollectionChange: %w", err)
+	}
+
+	current, err := sqlrepo.ConvertChangeToDB(change.Current, change.Fields)
+	if err != nil {
+		return fmt.Errorf("AddCollectionChange: %w", err)
+	}
+
+	sql := pgh.Builder().Insert("collection_changes").
+		Columns("collection_id", "author", "fields", "previous", "current").
+		Values(change.CollectionID, change.Author, change.Fields, previous, current)
+
+	if _, err := px.Exec(ctx, s.conn(ctx), sql); err != nil {
+		return fmt.Errorf("AddCollectionChange: failed to insert change of collection id %d: %w",
+			change.CollectionID, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package colmanager
+
+import (
+	"testing"
+	"time"
+
+	"github.com/n-r-w/collector/internal/config"
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/collector/internal/repository/sql"
+	"github.com/n-r-w/ctxlog"
+	"github.com/n-r-w/pgh/v2"
+	"github.com/n-r-w/pgh/v2/px"
+	"github.com/n-r-w/pgh/v2/px/db"
+	"github.com/n-r-w/pgh/v2/txmgr"
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateCollection(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, _ *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db)
+		},
+	)
+
+	task := entity.Task{
+		MessageSelection: entity.MessageSelectionCriteria{
+			Handler: "test-handler",
+		},
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 100,
+		},
+	}
+
+	collectionID, err := s.CreateCollection(ctx, task)
+	require.NoError(t, err)
+
+	update := entity.CollectionUpdate{
+		Author:            "tester",
+		RequestCountLimit: mo.Some(200),
+		IdleTimeout:       mo.Some(time.Minute),
+		MessageSelection:  mo.Some(entity.MessageSelectionCriteria{Handler: "other-handler", DistinctBodies: true}),
+	}
+	changed, err := update.Apply(task)
+	require.NoError(t, err)
+
+	require.NoError(t, s.UpdateCollection(ctx, collectionID, changed))
+	require.NoError(t, s.AddCollectionChange(ctx, entity.CollectionChange{
+		CollectionID: collectionID,
+		Author:       update.Author,
+		Fields:       update.Fields(),
+		Previous:     task,
+		Current:      changed,
+	}))
+
+	collection, err := s.GetCollection(ctx, collectionID)
+	require.NoError(t, err)
+	require.Equal(t, 200, collection.Task.Completion.RequestCountLimit)
+	require.Equal(t, time.Hour, collection.Task.Completion.TimeLimit)
+	require.Equal(t, time.Minute, collection.Task.Completion.IdleTimeout)
+	require.Equal(t, "other-handler", collection.Task.MessageSelection.Handler)
+	require.True(t, collection.Task.MessageSelection.DistinctBodies)
+	require.True(t, collection.UpdatedAt.IsPresent())
+
+	var changes []struct {
+		Author   string   `db:"author"`
+		Fields   []string `db:"fields"`
+		Previous string   `db:"previous"`
+		Current  string   `db:"current"`
+	}
+	require.NoError(t, px.SelectPlain(ctx, s.conn(ctx),
+		"SELECT author, fields, previous::text, current::text FROM collection_changes WHERE collection_id = $1",
+		&changes, pgh.Args{collectionID}))
+	require.Len(t, changes, 1)
+	require.Equal(t, "tester", changes[0].Author)
+	require.Equal(t, update.Fields(), changes[0].Fields)
+	require.JSONEq(t, `{"requestCountLimit": 100, "idleTimeout": "0s",
+		"selectionCriteria": {"handler": "test-handler", "headerCriteria": []}}`, changes[0].Previous)
+	require.JSONEq(t, `{"requestCountLimit": 200, "idleTimeout": "1m0s",
+		"selectionCriteria": {"handler": "other-handler", "headerCriteria": []}}`, changes[0].Current)
+
+	// Paused collection can be changed
+	require.NoError(t, s.UpdateStatus(ctx, collectionID, entity.StatusPaused))
+	require.NoError(t, s.UpdateCollection(ctx, collectionID, changed))
+
+	// Not collecting collection can't be changed
+	require.NoError(t, s.UpdateStatus(ctx, collectionID, entity.StatusFinalizing))
+	require.ErrorIs(t, s.UpdateCollection(ctx, collectionID, changed), entity.ErrCollectionNotFound)
+}
@@ -6,17 +6,64 @@ import (
 	"regexp"
 	"time"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/n-r-w/collector/internal/entity"
 	"github.com/n-r-w/collector/internal/repository/sql/dbmodel"
+	"github.com/samber/lo"
 	"github.com/samber/mo"
 )
 
+const (
+	day   = 24 * time.Hour
+	month = 30 * day // PostgreSQL uses 30 days per month to convert intervals
+)
+
 type criteriaDTO struct {
-	Handler        string `json:"handler"`
-	HeaderCriteria []struct {
-		HeaderName string `json:"headerName"`
-		Pattern    string `json:"pattern"`
-	} `json:"headerCriteria"`
+	Handler              string              `json:"handler"`
+	HandlerMatch         *handlerCriteriaDTO `json:"handlerMatch,omitempty"`
+	HeaderCriteria       []headerCriteriaDTO `json:"headerCriteria"`
+	HeaderGroup          *headerGroupDTO     `json:"headerGroup,omitempty"`
+	BodyCriteria         []bodyCriteriaDTO   `json:"bodyCriteria,omitempty"`
+	Expression           string              `json:"expression,omitempty"`
+	Rules                []ruleDTO           `json:"rules,omitempty"`
+	SampleRate           *float64            `json:"sampleRate,omitempty"`
+	MaxRequestsPerSecond int                 `json:"maxRequestsPerSecond,omitempty"`
+	Stratification       *stratificationDTO  `json:"stratification,omitempty"`
+}
+
+// stratificationDTO is the key of the stratification, its limits are stored in the collection columns.
+type stratificationDTO struct {
+	HeaderName string `json:"headerName,omitempty"`
+	BodyPath   string `json:"bodyPath,omitempty"`
+}
+
+type ruleDTO struct {
+	Name              string      `json:"name"`
+	RequestCountLimit int         `json:"requestCountLimit"`
+	Percent           *int        `json:"percent,omitempty"`
+	Criteria          criteriaDTO `json:"criteria"`
+}
+
+type handlerCriteriaDTO struct {
+	Mode     int      `json:"mode"`
+	Patterns []string `json:"patterns"`
+}
+
+type headerCriteriaDTO struct {
+	HeaderName string `json:"headerName"`
+	Pattern    string `json:"pattern"`
+}
+
+type headerGroupDTO struct {
+	Operator       int                 `json:"operator"`
+	HeaderCriteria []headerCriteriaDTO `json:"headerCriteria,omitempty"`
+	Groups         []headerGroupDTO    `json:"groups,omitempty"`
+}
+
+type bodyCriteriaDTO struct {
+	Path     string `json:"path"`
+	Operator int    `json:"operator"`
+	Value    string `json:"value,omitempty"`
 }
 
 // ConvertCollectionToEntity converts database Collection to an entity.Collection.
@@ -36,6 +83,22 @@ func ConvertCollectionToEntity(collection dbmodel.Collection) (entity.Collection
 		completedAt = mo.Some(collection.CompletedAt.Time)
 	}
 
+	var lastRequestAt mo.Option[time.Time]
+	if collection.LastRequestAt.Valid {
+		lastRequestAt = mo.Some(collection.LastRequestAt.Time)
+	}
+
+	pause := entity.PauseState{
+		FreezeTimeLimit: collection.FreezeTimeLimit,
+		FrozenDuration:  collection.FrozenDuration,
+	}
+	if collection.PausedAt.Valid {
+		pause.PausedAt = mo.Some(collection.PausedAt.Time)
+	}
+	if collection.ResumedAt.Valid {
+		pause.ResumedAt = mo.Some(collection.ResumedAt.Time)
+	}
+
 	var resultID mo.Option[entity.ResultID]
 	if collection.ResultID.Valid {
 		resultID = mo.Some(entity.ResultID(collection.ResultID.String))
@@ -46,9 +109,9 @@ func ConvertCollectionToEntity(collection dbmodel.Collection) (entity.Collection
 		errorMessage = mo.Some(collection.ErrorMessage.String)
 	}
 
-	var errorCode mo.Option[int]
+	var errorCode mo.Option[entity.ErrorCode]
 	if collection.ErrorCode.Valid {
-		errorCode = mo.Some(int(collection.ErrorCode.Int32))
+		errorCode = mo.Some(entity.ErrorCode(collection.ErrorCode.Int32))
 	}
 
 	var (
@@ -60,17 +123,22 @@ func ConvertCollectionToEntity(collection dbmodel.Collection) (entity.Collection
 	}
 
 	return entity.Collection{ //exhaustruct:enforce
-		ID:           entity.CollectionID(collection.ID),
-		Task:         task,
-		Status:       entity.CollectionStatus(collection.Status),
-		RequestCount: collection.RequestCount,
-		CreatedAt:    collection.CreatedAt,
-		StartedAt:    startedAt,
-		UpdatedAt:    updatedAt,
-		CompletedAt:  completedAt,
-		ResultID:     resultID,
-		ErrorMessage: errorMessage,
-		ErrorCode:    errorCode,
+		ID:             entity.CollectionID(collection.ID),
+		Task:           task,
+		Status:         entity.CollectionStatus(collection.Status),
+		RequestCount:   collection.RequestCount,
+		DuplicateCount: collection.DuplicateCount,
+		SeenCount:      collection.SeenCount,
+		ByteCount:      collection.ByteCount,
+		LastRequestAt:  lastRequestAt,
+		CreatedAt:      collection.CreatedAt,
+		StartedAt:      startedAt,
+		UpdatedAt:      updatedAt,
+		CompletedAt:    completedAt,
+		Pause:          pause,
+		ResultID:       resultID,
+		ErrorMessage:   errorMessage,
+		ErrorCode:      errorCode,
 	}, nil
 }
 
@@ -82,11 +150,208 @@ func ConvertTaskToEntity(collection dbmodel.Collection) (entity.Task, error) {
 		return entity.Task{}, fmt.Errorf("convertTaskFromBytes: failed to unmarshal task to JSON: %w", err)
 	}
 
-	headerCriteria := make([]entity.HeaderCriteria, len(dto.HeaderCriteria))
-	for i, hc := range dto.HeaderCriteria {
+	selection, err := convertSelectionToEntity(dto, collection.DistinctBodies)
+	if err != nil {
+		return entity.Task{}, err
+	}
+
+	rules := make([]entity.SelectionRule, len(dto.Rules))
+	for i, r := range dto.Rules {
+		ruleSelection, err := convertSelectionToEntity(r.Criteria, false)
+		if err != nil {
+			return entity.Task{}, err
+		}
+		rules[i] = entity.SelectionRule{
+			Name:              r.Name,
+			Selection:         ruleSelection,
+			RequestCountLimit: r.RequestCountLimit,
+			Percent:           mo.PointerToOption(r.Percent),
+		}
+	}
+
+	var retentionPeriod mo.Option[time.Duration]
+	if collection.RetentionPeriod.Valid {
+		retentionPeriod = mo.Some(convertIntervalToDuration(collection.RetentionPeriod))
+	}
+
+	stratification, err := convertStratificationToEntity(dto.Stratification, collection)
+	if err != nil {
+		return entity.Task{}, err
+	}
+
+	var startAt mo.Option[time.Time]
+	if collection.StartAt.Valid {
+		startAt = mo.Some(collection.StartAt.Time)
+	}
+
+	var labels map[string]string
+	if err := json.Unmarshal(collection.Labels, &labels); err != nil {
+		return entity.Task{}, fmt.Errorf("ConvertTaskToEntity: failed to unmarshal labels: %w", err)
+	}
+	if len(labels) == 0 {
+		labels = nil
+	}
+
+	return entity.Task{
+		MessageSelection: selection,
+		Rules:            rules,
+		Stratification:   stratification,
+		StartAt:          startAt,
+		Completion: entity.CompletionCriteria{
+			TimeLimit:                 collection.RequestDurationLimit,
+			RequestCountLimit:         collection.RequestCountLimit,
+			Reservoir:                 collection.Reservoir,
+			IdleTimeout:               collection.IdleTimeout,
+			ByteSizeLimit:             collection.ByteSizeLimit,
+			MinRequestCount:           collection.MinRequestCount,
+			TimeLimitFromFirstRequest: collection.TimeLimitFromFirstRequest,
+			MaxWait:                   collection.MaxWait,
+		},
+		Retention: entity.RetentionPolicy{
+			Period: retentionPeriod,
+			Pinned: collection.Pinned,
+		},
+		Metadata: entity.Metadata{
+			Name:        collection.Name,
+			Description: collection.Description,
+			Owner:       collection.Owner,
+			Labels:      labels,
+		},
+	}, nil
+}
+
+// ConvertScheduleToEntity converts database Schedule to an entity.Schedule.
+func ConvertScheduleToEntity(schedule dbmodel.Schedule) (entity.Schedule, error) {
+	cronExpression, err := entity.ParseCronExpression(schedule.CronExpression)
+	if err != nil {
+		return entity.Schedule{}, fmt.Errorf("ConvertScheduleToEntity: failed to parse cron expression: %w", err)
+	}
+
+	// the template is stored in the same way as the collection parameters
+	task, err := ConvertTaskToEntity(dbmodel.Collection{
+		RequestCountLimit:         schedule.RequestCountLimit,
+		RequestDurationLimit:      schedule.RequestDurationLimit,
+		Criteria:                  schedule.Criteria,
+		RetentionPeriod:           schedule.RetentionPeriod,
+		Pinned:                    schedule.Pinned,
+		DistinctBodies:            schedule.DistinctBodies,
+		Reservoir:                 schedule.Reservoir,
+		MaxPerValue:               schedule.MaxPerValue,
+		MinDistinctValues:         schedule.MinDistinctValues,
+		IdleTimeout:               schedule.IdleTimeout,
+		ByteSizeLimit:             schedule.ByteSizeLimit,
+		MinRequestCount:           schedule.MinRequestCount,
+		TimeLimitFromFirstRequest: schedule.TimeLimitFromFirstRequest,
+		MaxWait:                   schedule.MaxWait,
+		Name:                      schedule.Name,
+		Description:               schedule.Description,
+		Owner:                     schedule.Owner,
+		Labels:                    schedule.Labels,
+	})
+	if err != nil {
+		return entity.Schedule{}, err
+	}
+
+	var lastRunAt mo.Option[time.Time]
+	if schedule.LastRunAt.Valid {
+		lastRunAt = mo.Some(schedule.LastRunAt.Time)
+	}
+
+	var lastCollectionID mo.Option[entity.CollectionID]
+	if schedule.LastCollectionID.Valid {
+		lastCollectionID = mo.Some(entity.CollectionID(schedule.LastCollectionID.Int64))
+	}
+
+	return entity.Schedule{ //exhaustruct:enforce
+		ID:               entity.ScheduleID(schedule.ID),
+		Cron:             cronExpression,
+		Task:             task,
+		NextRunAt:        schedule.NextRunAt,
+		LastRunAt:        lastRunAt,
+		LastCollectionID: lastCollectionID,
+		CreatedAt:        schedule.CreatedAt,
+	}, nil
+}
+
+func convertStratificationToEntity(
+	dto *stratificationDTO, collection dbmodel.Collection,
+) (mo.Option[entity.Stratification], error) {
+	if dto == nil {
+		return mo.None[entity.Stratification](), nil
+	}
+
+	stratification := entity.Stratification{
+		HeaderName:        dto.HeaderName,
+		MaxPerValue:       collection.MaxPerValue,
+		MinDistinctValues: collection.MinDistinctValues,
+	}
+	if dto.BodyPath != "" {
+		path, err := entity.ParseJSONPath(dto.BodyPath)
+		if err != nil {
+			return mo.None[entity.Stratification](), fmt.Errorf(
+				"convertTaskFromBytes: failed to parse stratification path: %w", err)
+		}
+		stratification.BodyPath = mo.Some(path)
+	}
+
+	return mo.Some(stratification), nil
+}
+
+func convertSelectionToEntity(dto criteriaDTO, distinctBodies bool) (entity.MessageSelectionCriteria, error) {
+	headerCriteria, err := convertHeaderCriteriaToEntity(dto.HeaderCriteria)
+	if err != nil {
+		return entity.MessageSelectionCriteria{}, err
+	}
+
+	var handlerCriteria mo.Option[entity.HandlerCriteria]
+	if dto.HandlerMatch != nil {
+		hc, err := entity.NewHandlerCriteria(entity.HandlerMatchMode(dto.HandlerMatch.Mode), dto.HandlerMatch.Patterns)
+		if err != nil {
+			return entity.MessageSelectionCriteria{}, fmt.Errorf(
+				"convertTaskFromBytes: failed to parse handler criteria: %w", err)
+		}
+		handlerCriteria = mo.Some(hc)
+	}
+
+	var headerGroup mo.Option[entity.HeaderGroup]
+	if dto.HeaderGroup != nil {
+		group, err := convertHeaderGroupToEntity(*dto.HeaderGroup)
+		if err != nil {
+			return entity.MessageSelectionCriteria{}, err
+		}
+		headerGroup = mo.Some(group)
+	}
+
+	bodyCriteria := make([]entity.BodyCriteria, len(dto.BodyCriteria))
+	for i, bc := range dto.BodyCriteria {
+		c, err := entity.NewBodyCriteria(bc.Path, entity.BodyOperator(bc.Operator), bc.Value)
+		if err != nil {
+			return entity.MessageSelectionCriteria{}, fmt.Errorf(
+				"convertTaskFromBytes: failed to parse body criteria: %w", err)
+		}
+		bodyCriteria[i] = c
+	}
+
+	return entity.MessageSelectionCriteria{
+		Handler:         dto.Handler,
+		HandlerCriteria: handlerCriteria,
+		HeaderCriteria:  headerCriteria,
+		HeaderGroup:     headerGroup,
+		BodyCriteria:    bodyCriteria,
+		// compiled by the collection cache only, because compilation is expensive
+		Expression:           entity.Expression{Source: dto.Expression},
+		DistinctBodies:       distinctBodies,
+		SampleRate:           mo.PointerToOption(dto.SampleRate),
+		MaxRequestsPerSecond: dto.MaxRequestsPerSecond,
+	}, nil
+}
+
+func convertHeaderCriteriaToEntity(dto []headerCriteriaDTO) ([]entity.HeaderCriteria, error) {
+	headerCriteria := make([]entity.HeaderCriteria, len(dto))
+	for i, hc := range dto {
 		pattern, err := regexp.Compile(hc.Pattern)
 		if err != nil {
-			return entity.Task{}, fmt.Errorf("convertTaskFromBytes:failed to compile regex: %w", err)
+			return nil, fmt.Errorf("convertTaskFromBytes:failed to compile regex: %w", err)
 		}
 		headerCriteria[i] = entity.HeaderCriteria{
 			HeaderName: hc.HeaderName,
@@ -94,39 +359,173 @@ func ConvertTaskToEntity(collection dbmodel.Collection) (entity.Task, error) {
 		}
 	}
 
-	return entity.Task{
-		MessageSelection: entity.MessageSelectionCriteria{
-			Handler:        dto.Handler,
-			HeaderCriteria: headerCriteria,
-		},
-		Completion: entity.CompletionCriteria{
-			TimeLimit:         collection.RequestDurationLimit,
-			RequestCountLimit: collection.RequestCountLimit,
-		},
+	return headerCriteria, nil
+}
+
+func convertHeaderGroupToEntity(dto headerGroupDTO) (entity.HeaderGroup, error) {
+	criteria, err := convertHeaderCriteriaToEntity(dto.HeaderCriteria)
+	if err != nil {
+		return entity.HeaderGroup{}, err
+	}
+
+	groups := make([]entity.HeaderGroup, len(dto.Groups))
+	for i, g := range dto.Groups {
+		if groups[i], err = convertHeaderGroupToEntity(g); err != nil {
+			return entity.HeaderGroup{}, err
+		}
+	}
+
+	return entity.HeaderGroup{
+		Operator: entity.HeaderGroupOperator(dto.Operator),
+		Criteria: criteria,
+		Groups:   groups,
 	}, nil
 }
 
+// convertIntervalToDuration converts database interval to time.Duration.
+func convertIntervalToDuration(interval pgtype.Interval) time.Duration {
+	return time.Duration(interval.Microseconds)*time.Microsecond +
+		time.Duration(interval.Days)*day +
+		time.Duration(interval.Months)*month
+}
+
 // ConvertTaskToCriteriaDB converts Task to a criteriaDTO.
 func ConvertTaskToCriteriaDB(task entity.Task) ([]byte, error) {
-	dto := criteriaDTO{}
-	dto.Handler = task.MessageSelection.Handler
-	dto.HeaderCriteria = make([]struct {
-		HeaderName string `json:"headerName"`
-		Pattern    string `json:"pattern"`
-	}, len(task.MessageSelection.HeaderCriteria))
-
-	for i, hc := range task.MessageSelection.HeaderCriteria {
-		dto.HeaderCriteria[i] = struct {
-			HeaderName string `json:"headerName"`
-			Pattern    string `json:"pattern"`
-		}{
-			HeaderName: hc.HeaderName,
-			Pattern:    hc.Pattern.String(),
+	dto := convertSelectionToDB(task.MessageSelection)
+
+	if len(task.Rules) > 0 {
+		dto.Rules = make([]ruleDTO, len(task.Rules))
+		for i, r := range task.Rules {
+			dto.Rules[i] = ruleDTO{
+				Name:              r.Name,
+				RequestCountLimit: r.RequestCountLimit,
+				Percent:           r.Percent.ToPointer(),
+				Criteria:          convertSelectionToDB(r.Selection),
+			}
 		}
 	}
+
+	if stratification, ok := task.Stratification.Get(); ok {
+		dto.Stratification = &stratificationDTO{
+			HeaderName: stratification.HeaderName,
+		}
+		if path, ok := stratification.BodyPath.Get(); ok {
+			dto.Stratification.BodyPath = path.String()
+		}
+	}
+
 	data, err := json.Marshal(dto)
 	if err != nil {
 		return nil, fmt.Errorf("convertTaskToBytes: failed to marshal task to JSON: %w", err)
 	}
 	return data, nil
 }
+
+// changeDTO contains the changed fields of a collection, the other fields are not set.
+type changeDTO struct {
+	RequestCountLimit *int         `json:"requestCountLimit,omitempty"`
+	TimeLimit         *string      `json:"timeLimit,omitempty"`
+	IdleTimeout       *string      `json:"idleTimeout,omitempty"`
+	ByteSizeLimit     *int64       `json:"byteSizeLimit,omitempty"`
+	SelectionCriteria *criteriaDTO `json:"selectionCriteria,omitempty"`
+	Expression        *string      `json:"expression,omitempty"`
+}
+
+// ConvertLabelsToDB converts labels of the collection to JSON.
+func ConvertLabelsToDB(labels map[string]string) ([]byte, error) {
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	data, err := json.Marshal(labels)
+	if err != nil {
+		return nil, fmt.Errorf("ConvertLabelsToDB: failed to marshal labels to JSON: %w", err)
+	}
+	return data, nil
+}
+
+// ConvertChangeToDB converts the changed fields of the task to JSON for the audit of the collection changes.
+func ConvertChangeToDB(task entity.Task, fields []string) ([]byte, error) {
+	var dto changeDTO
+	for _, field := range fields {
+		switch field {
+		case entity.CollectionFieldRequestCountLimit:
+			dto.RequestCountLimit = lo.ToPtr(task.Completion.RequestCountLimit)
+		case entity.CollectionFieldTimeLimit:
+			dto.TimeLimit = lo.ToPtr(task.Completion.TimeLimit.String())
+		case entity.CollectionFieldIdleTimeout:
+			dto.IdleTimeout = lo.ToPtr(task.Completion.IdleTimeout.String())
+		case entity.CollectionFieldByteSizeLimit:
+			dto.ByteSizeLimit = lo.ToPtr(task.Completion.ByteSizeLimit)
+		case entity.CollectionFieldSelection:
+			selection := convertSelectionToDB(task.MessageSelection)
+			selection.Expression = "" // the expression is a separate field
+			dto.SelectionCriteria = &selection
+		case entity.CollectionFieldExpression:
+			dto.Expression = lo.ToPtr(task.MessageSelection.Expression.Source)
+		default:
+			return nil, fmt.Errorf("ConvertChangeToDB: unknown field %q", field)
+		}
+	}
+
+	data, err := json.Marshal(dto)
+	if err != nil {
+		return nil, fmt.Errorf("ConvertChangeToDB: failed to marshal change to JSON: %w", err)
+	}
+	return data, nil
+}
+
+func convertSelectionToDB(selection entity.MessageSelectionCriteria) criteriaDTO {
+	dto := criteriaDTO{}
+	dto.Handler = selection.Handler
+	if hc, ok := selection.HandlerCriteria.Get(); ok {
+		dto.HandlerMatch = &handlerCriteriaDTO{
+			Mode:     int(hc.Mode),
+			Patterns: hc.Patterns,
+		}
+	}
+	dto.HeaderCriteria = convertHeaderCriteriaToDB(selection.HeaderCriteria)
+	if group, ok := selection.HeaderGroup.Get(); ok {
+		dto.HeaderGroup = lo.ToPtr(convertHeaderGroupToDB(group))
+	}
+
+	dto.BodyCriteria = make([]bodyCriteriaDTO, len(selection.BodyCriteria))
+	for i, bc := range selection.BodyCriteria {
+		dto.BodyCriteria[i] = bodyCriteriaDTO{
+			Path:     bc.Path.String(),
+			Operator: int(bc.Operator),
+			Value:    bc.Value,
+		}
+	}
+
+	dto.Expression = selection.Expression.Source
+	dto.SampleRate = selection.SampleRate.ToPointer()
+	dto.MaxRequestsPerSecond = selection.MaxRequestsPerSecond
+
+	return dto
+}
+
+func convertHeaderCriteriaToDB(criteria []entity.HeaderCriteria) []headerCriteriaDTO {
+	dto := make([]headerCriteriaDTO, len(criteria))
+	for i, hc := range criteria {
+		dto[i] = headerCriteriaDTO{
+			HeaderName: hc.HeaderName,
+			Pattern:    hc.Pattern.String(),
+		}
+	}
+
+	return dto
+}
+
+func convertHeaderGroupToDB(group entity.HeaderGroup) headerGroupDTO {
+	dto := headerGroupDTO{
+		Operator:       int(group.Operator),
+		HeaderCriteria: convertHeaderCriteriaToDB(group.Criteria),
+		Groups:         make([]headerGroupDTO, len(group.Groups)),
+	}
+	for i, g := range group.Groups {
+		dto.Groups[i] = convertHeaderGroupToDB(g)
+	}
+
+	return dto
+}
@@ -14,19 +14,45 @@ import (
 
 // Collection represents a row from 'public.collections'.
 type Collection struct {
-	ID                   int64              `json:"id" db:"id"`                                         // id
-	Status               int                `json:"status" db:"status"`                                 // status
-	RequestCountLimit    int                `json:"request_count_limit" db:"request_count_limit"`       // request_count_limit
-	RequestDurationLimit time.Duration      `json:"request_duration_limit" db:"request_duration_limit"` // request_duration_limit
-	Criteria             []byte             `json:"criteria" db:"criteria"`                             // criteria
-	RequestCount         int                `json:"request_count" db:"request_count"`                   // request_count
-	CreatedAt            time.Time          `json:"created_at" db:"created_at"`                         // created_at
-	StartedAt            pgtype.Timestamptz `json:"started_at" db:"started_at"`                         // started_at
-	UpdatedAt            pgtype.Timestamptz `json:"updated_at" db:"updated_at"`                         // updated_at
-	CompletedAt          pgtype.Timestamptz `json:"completed_at" db:"completed_at"`                     // completed_at
-	ResultID             pgtype.Text        `json:"result_id" db:"result_id"`                           // result_id
-	ErrorMessage         pgtype.Text        `json:"error_message" db:"error_message"`                   // error_message
-	ErrorCode            pgtype.Int4        `json:"error_code" db:"error_code"`                         // error_code
+	ID                        int64              `json:"id" db:"id"`                                                       // id
+	Status                    int                `json:"status" db:"status"`                                               // status
+	RequestCountLimit         int                `json:"request_count_limit" db:"request_count_limit"`                     // request_count_limit
+	RequestDurationLimit      time.Duration      `json:"request_duration_limit" db:"request_duration_limit"`               // request_duration_limit
+	Criteria                  []byte             `json:"criteria" db:"criteria"`                                           // criteria
+	RequestCount              int                `json:"request_count" db:"request_count"`                                 // request_count
+	CreatedAt                 time.Time          `json:"created_at" db:"created_at"`                                       // created_at
+	StartedAt                 pgtype.Timestamptz `json:"started_at" db:"started_at"`                                       // started_at
+	UpdatedAt                 pgtype.Timestamptz `json:"updated_at" db:"updated_at"`                                       // updated_at
+	CompletedAt               pgtype.Timestamptz `json:"completed_at" db:"completed_at"`                                   // completed_at
+	ResultID                  pgtype.Text        `json:"result_id" db:"result_id"`                                         // result_id
+	ErrorMessage              pgtype.Text        `json:"error_message" db:"error_message"`                                 // error_message
+	ErrorCode                 pgtype.Int4        `json:"error_code" db:"error_code"`                                       // error_code
+	RetentionPeriod           pgtype.Interval    `json:"retention_period" db:"retention_period"`                           // retention_period
+	Pinned                    bool               `json:"pinned" db:"pinned"`                                               // pinned
+	DistinctBodies            bool               `json:"distinct_bodies" db:"distinct_bodies"`                             // distinct_bodies
+	DuplicateCount            int                `json:"duplicate_count" db:"duplicate_count"`                             // duplicate_count
+	Reservoir                 bool               `json:"reservoir" db:"reservoir"`                                         // reservoir
+	SeenCount                 int                `json:"seen_count" db:"seen_count"`                                       // seen_count
+	MaxPerValue               int                `json:"max_per_value" db:"max_per_value"`                                 // max_per_value
+	MinDistinctValues         int                `json:"min_distinct_values" db:"min_distinct_values"`                     // min_distinct_values
+	StartAt                   pgtype.Timestamptz `json:"start_at" db:"start_at"`                                           // start_at
+	IdleTimeout               time.Duration      `json:"idle_timeout" db:"idle_timeout"`                                   // idle_timeout
+	ByteSizeLimit             int64              `json:"byte_size_limit" db:"byte_size_limit"`                             // byte_size_limit
+	ByteCount                 int64              `json:"byte_count" db:"byte_count"`                                       // byte_count
+	LastRequestAt             pgtype.Timestamptz `json:"last_request_at" db:"last_request_at"`                             // last_request_at
+	MinRequestCount           int                `json:"min_request_count" db:"min_request_count"`                         // min_request_count
+	FinalizationAttempts      int                `json:"finalization_attempts" db:"finalization_attempts"`                 // finalization_attempts
+	TimeLimitFromFirstRequest bool               `json:"time_limit_from_first_request" db:"time_limit_from_first_request"` // time_limit_from_first_request
+	MaxWait                   time.Duration      `json:"max_wait" db:"max_wait"`                                           // max_wait
+	PausedAt                  pgtype.Timestamptz `json:"paused_at" db:"paused_at"`                                         // paused_at
+	ResumedAt                 pgtype.Timestamptz `json:"resumed_at" db:"resumed_at"`                                       // resumed_at
+	FreezeTimeLimit           bool               `json:"freeze_time_limit" db:"freeze_time_limit"`                         // freeze_time_limit
+	FrozenDuration            time.Duration      `json:"frozen_duration" db:"frozen_duration"`                             // frozen_duration
+	Name                      string             `json:"name" db:"name"`                                                   // name
+	Description               string             `json:"description" db:"description"`                                     // description
+	Owner                     string             `json:"owner" db:"owner"`                                                 // owner
+	Labels                    []byte             `json:"labels" db:"labels"`                                               // labels
+	Handlers                  []byte             `json:"handlers" db:"handlers"`                                           // handlers
 	// xo fields
 	_exists, _deleted bool
 }
@@ -52,13 +78,13 @@ func (c *Collection) Insert(ctx context.Context, db DB) error {
 	}
 	// insert (primary key generated and returned by database)
 	const sqlstr = `INSERT INTO public.collections (` +
-		`status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code` +
+		`status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code, retention_period, pinned, distinct_bodies, duplicate_count, reservoir, seen_count, max_per_value, min_distinct_values, start_at, idle_timeout, byte_size_limit, byte_count, last_request_at, min_request_count, finalization_attempts, time_limit_from_first_request, max_wait, paused_at, resumed_at, freeze_time_limit, frozen_duration, name, description, owner, labels` +
 		`) VALUES (` +
-		`$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12` +
+		`$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, $35, $36, $37` +
 		`) RETURNING id`
 	// run
-	logf(sqlstr, c.Status, c.RequestCountLimit, c.RequestDurationLimit, c.Criteria, c.RequestCount, c.CreatedAt, c.StartedAt, c.UpdatedAt, c.CompletedAt, c.ResultID, c.ErrorMessage, c.ErrorCode)
-	if err := db.QueryRow(ctx, sqlstr, c.Status, c.RequestCountLimit, c.RequestDurationLimit, c.Criteria, c.RequestCount, c.CreatedAt, lo.Ternary(c.StartedAt.Valid == false, nil, &c.StartedAt), lo.Ternary(c.UpdatedAt.Valid == false, nil, &c.UpdatedAt), lo.Ternary(c.CompletedAt.Valid == false, nil, &c.CompletedAt), lo.Ternary(c.ResultID.Valid == false, nil, &c.ResultID), lo.Ternary(c.ErrorMessage.Valid == false, nil, &c.ErrorMessage), lo.Ternary(c.ErrorCode.Valid == false, nil, &c.ErrorCode)).Scan(&c.ID); err != nil {
+	logf(sqlstr, c.Status, c.RequestCountLimit, c.RequestDurationLimit, c.Criteria, c.RequestCount, c.CreatedAt, c.StartedAt, c.UpdatedAt, c.CompletedAt, c.ResultID, c.ErrorMessage, c.ErrorCode, c.RetentionPeriod, c.Pinned, c.DistinctBodies, c.DuplicateCount, c.Reservoir, c.SeenCount, c.MaxPerValue, c.MinDistinctValues, c.StartAt, c.IdleTimeout, c.ByteSizeLimit, c.ByteCount, c.LastRequestAt, c.MinRequestCount, c.FinalizationAttempts, c.TimeLimitFromFirstRequest, c.MaxWait, c.PausedAt, c.ResumedAt, c.FreezeTimeLimit, c.FrozenDuration, c.Name, c.Description, c.Owner, c.Labels)
+	if err := db.QueryRow(ctx, sqlstr, c.Status, c.RequestCountLimit, c.RequestDurationLimit, c.Criteria, c.RequestCount, c.CreatedAt, lo.Ternary(c.StartedAt.Valid == false, nil, &c.StartedAt), lo.Ternary(c.UpdatedAt.Valid == false, nil, &c.UpdatedAt), lo.Ternary(c.CompletedAt.Valid == false, nil, &c.CompletedAt), lo.Ternary(c.ResultID.Valid == false, nil, &c.ResultID), lo.Ternary(c.ErrorMessage.Valid == false, nil, &c.ErrorMessage), lo.Ternary(c.ErrorCode.Valid == false, nil, &c.ErrorCode), lo.Ternary(c.RetentionPeriod.Valid == false, nil, &c.RetentionPeriod), c.Pinned, c.DistinctBodies, c.DuplicateCount, c.Reservoir, c.SeenCount, c.MaxPerValue, c.MinDistinctValues, lo.Ternary(c.StartAt.Valid == false, nil, &c.StartAt), c.IdleTimeout, c.ByteSizeLimit, c.ByteCount, lo.Ternary(c.LastRequestAt.Valid == false, nil, &c.LastRequestAt), c.MinRequestCount, c.FinalizationAttempts, c.TimeLimitFromFirstRequest, c.MaxWait, lo.Ternary(c.PausedAt.Valid == false, nil, &c.PausedAt), lo.Ternary(c.ResumedAt.Valid == false, nil, &c.ResumedAt), c.FreezeTimeLimit, c.FrozenDuration, c.Name, c.Description, c.Owner, c.Labels).Scan(&c.ID); err != nil {
 		return logerror(err)
 	}
 	// set exists
@@ -76,11 +102,11 @@ func (c *Collection) Update(ctx context.Context, db DB) error {
 	}
 	// update with composite primary key
 	const sqlstr = `UPDATE public.collections SET ` +
-		`status = $1, request_count_limit = $2, request_duration_limit = $3, criteria = $4, request_count = $5, created_at = $6, started_at = $7, updated_at = $8, completed_at = $9, result_id = $10, error_message = $11, error_code = $12 ` +
-		`WHERE id = $13`
+		`status = $1, request_count_limit = $2, request_duration_limit = $3, criteria = $4, request_count = $5, created_at = $6, started_at = $7, updated_at = $8, completed_at = $9, result_id = $10, error_message = $11, error_code = $12, retention_period = $13, pinned = $14, distinct_bodies = $15, duplicate_count = $16, reservoir = $17, seen_count = $18, max_per_value = $19, min_distinct_values = $20, start_at = $21, idle_timeout = $22, byte_size_limit = $23, byte_count = $24, last_request_at = $25, min_request_count = $26, finalization_attempts = $27, time_limit_from_first_request = $28, max_wait = $29, paused_at = $30, resumed_at = $31, freeze_time_limit = $32, frozen_duration = $33, name = $34, description = $35, owner = $36, labels = $37 ` +
+		`WHERE id = $38`
 	// run
-	logf(sqlstr, c.Status, c.RequestCountLimit, c.RequestDurationLimit, c.Criteria, c.RequestCount, c.CreatedAt, c.StartedAt, c.UpdatedAt, c.CompletedAt, c.ResultID, c.ErrorMessage, c.ErrorCode, c.ID)
-	if _, err := db.Exec(ctx, sqlstr, c.Status, c.RequestCountLimit, c.RequestDurationLimit, c.Criteria, c.RequestCount, c.CreatedAt, lo.Ternary(c.StartedAt.Valid == false, nil, &c.StartedAt), lo.Ternary(c.UpdatedAt.Valid == false, nil, &c.UpdatedAt), lo.Ternary(c.CompletedAt.Valid == false, nil, &c.CompletedAt), lo.Ternary(c.ResultID.Valid == false, nil, &c.ResultID), lo.Ternary(c.ErrorMessage.Valid == false, nil, &c.ErrorMessage), lo.Ternary(c.ErrorCode.Valid == false, nil, &c.ErrorCode), c.ID); err != nil {
+	logf(sqlstr, c.Status, c.RequestCountLimit, c.RequestDurationLimit, c.Criteria, c.RequestCount, c.CreatedAt, c.StartedAt, c.UpdatedAt, c.CompletedAt, c.ResultID, c.ErrorMessage, c.ErrorCode, c.RetentionPeriod, c.Pinned, c.DistinctBodies, c.DuplicateCount, c.Reservoir, c.SeenCount, c.MaxPerValue, c.MinDistinctValues, c.StartAt, c.IdleTimeout, c.ByteSizeLimit, c.ByteCount, c.LastRequestAt, c.MinRequestCount, c.FinalizationAttempts, c.TimeLimitFromFirstRequest, c.MaxWait, c.PausedAt, c.ResumedAt, c.FreezeTimeLimit, c.FrozenDuration, c.Name, c.Description, c.Owner, c.Labels, c.ID)
+	if _, err := db.Exec(ctx, sqlstr, c.Status, c.RequestCountLimit, c.RequestDurationLimit, c.Criteria, c.RequestCount, c.CreatedAt, lo.Ternary(c.StartedAt.Valid == false, nil, &c.StartedAt), lo.Ternary(c.UpdatedAt.Valid == false, nil, &c.UpdatedAt), lo.Ternary(c.CompletedAt.Valid == false, nil, &c.CompletedAt), lo.Ternary(c.ResultID.Valid == false, nil, &c.ResultID), lo.Ternary(c.ErrorMessage.Valid == false, nil, &c.ErrorMessage), lo.Ternary(c.ErrorCode.Valid == false, nil, &c.ErrorCode), lo.Ternary(c.RetentionPeriod.Valid == false, nil, &c.RetentionPeriod), c.Pinned, c.DistinctBodies, c.DuplicateCount, c.Reservoir, c.SeenCount, c.MaxPerValue, c.MinDistinctValues, lo.Ternary(c.StartAt.Valid == false, nil, &c.StartAt), c.IdleTimeout, c.ByteSizeLimit, c.ByteCount, lo.Ternary(c.LastRequestAt.Valid == false, nil, &c.LastRequestAt), c.MinRequestCount, c.FinalizationAttempts, c.TimeLimitFromFirstRequest, c.MaxWait, lo.Ternary(c.PausedAt.Valid == false, nil, &c.PausedAt), lo.Ternary(c.ResumedAt.Valid == false, nil, &c.ResumedAt), c.FreezeTimeLimit, c.FrozenDuration, c.Name, c.Description, c.Owner, c.Labels, c.ID); err != nil {
 		return logerror(err)
 	}
 	return nil
@@ -102,16 +128,16 @@ func (c *Collection) Upsert(ctx context.Context, db DB) error {
 	}
 	// upsert
 	const sqlstr = `INSERT INTO public.collections (` +
-		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code` +
+		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code, retention_period, pinned, distinct_bodies, duplicate_count, reservoir, seen_count, max_per_value, min_distinct_values, start_at, idle_timeout, byte_size_limit, byte_count, last_request_at, min_request_count, finalization_attempts, time_limit_from_first_request, max_wait, paused_at, resumed_at, freeze_time_limit, frozen_duration, name, description, owner, labels` +
 		`) VALUES (` +
-		`$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13` +
+		`$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31, $32, $33, $34, $35, $36, $37, $38` +
 		`)` +
 		` ON CONFLICT (id) DO ` +
 		`UPDATE SET ` +
-		`status = EXCLUDED.status, request_count_limit = EXCLUDED.request_count_limit, request_duration_limit = EXCLUDED.request_duration_limit, criteria = EXCLUDED.criteria, request_count = EXCLUDED.request_count, created_at = EXCLUDED.created_at, started_at = EXCLUDED.started_at, updated_at = EXCLUDED.updated_at, completed_at = EXCLUDED.completed_at, result_id = EXCLUDED.result_id, error_message = EXCLUDED.error_message, error_code = EXCLUDED.error_code `
+		`status = EXCLUDED.status, request_count_limit = EXCLUDED.request_count_limit, request_duration_limit = EXCLUDED.request_duration_limit, criteria = EXCLUDED.criteria, request_count = EXCLUDED.request_count, created_at = EXCLUDED.created_at, started_at = EXCLUDED.started_at, updated_at = EXCLUDED.updated_at, completed_at = EXCLUDED.completed_at, result_id = EXCLUDED.result_id, error_message = EXCLUDED.error_message, error_code = EXCLUDED.error_code, retention_period = EXCLUDED.retention_period, pinned = EXCLUDED.pinned, distinct_bodies = EXCLUDED.distinct_bodies, duplicate_count = EXCLUDED.duplicate_count, reservoir = EXCLUDED.reservoir, seen_count = EXCLUDED.seen_count, max_per_value = EXCLUDED.max_per_value, min_distinct_values = EXCLUDED.min_distinct_values, start_at = EXCLUDED.start_at, idle_timeout = EXCLUDED.idle_timeout, byte_size_limit = EXCLUDED.byte_size_limit, byte_count = EXCLUDED.byte_count, last_request_at = EXCLUDED.last_request_at, min_request_count = EXCLUDED.min_request_count, finalization_attempts = EXCLUDED.finalization_attempts, time_limit_from_first_request = EXCLUDED.time_limit_from_first_request, max_wait = EXCLUDED.max_wait, paused_at = EXCLUDED.paused_at, resumed_at = EXCLUDED.resumed_at, freeze_time_limit = EXCLUDED.freeze_time_limit, frozen_duration = EXCLUDED.frozen_duration, name = EXCLUDED.name, description = EXCLUDED.description, owner = EXCLUDED.owner, labels = EXCLUDED.labels `
 	// run
-	logf(sqlstr, c.ID, c.Status, c.RequestCountLimit, c.RequestDurationLimit, c.Criteria, c.RequestCount, c.CreatedAt, c.StartedAt, c.UpdatedAt, c.CompletedAt, c.ResultID, c.ErrorMessage, c.ErrorCode)
-	if _, err := db.Exec(ctx, sqlstr, c.ID, c.Status, c.RequestCountLimit, c.RequestDurationLimit, c.Criteria, c.RequestCount, c.CreatedAt, lo.Ternary(c.StartedAt.Valid == false, nil, &c.StartedAt), lo.Ternary(c.UpdatedAt.Valid == false, nil, &c.UpdatedAt), lo.Ternary(c.CompletedAt.Valid == false, nil, &c.CompletedAt), lo.Ternary(c.ResultID.Valid == false, nil, &c.ResultID), lo.Ternary(c.ErrorMessage.Valid == false, nil, &c.ErrorMessage), lo.Ternary(c.ErrorCode.Valid == false, nil, &c.ErrorCode)); err != nil {
+	logf(sqlstr, c.ID, c.Status, c.RequestCountLimit, c.RequestDurationLimit, c.Criteria, c.RequestCount, c.CreatedAt, c.StartedAt, c.UpdatedAt, c.CompletedAt, c.ResultID, c.ErrorMessage, c.ErrorCode, c.RetentionPeriod, c.Pinned, c.DistinctBodies, c.DuplicateCount, c.Reservoir, c.SeenCount, c.MaxPerValue, c.MinDistinctValues, c.StartAt, c.IdleTimeout, c.ByteSizeLimit, c.ByteCount, c.LastRequestAt, c.MinRequestCount, c.FinalizationAttempts, c.TimeLimitFromFirstRequest, c.MaxWait, c.PausedAt, c.ResumedAt, c.FreezeTimeLimit, c.FrozenDuration, c.Name, c.Description, c.Owner, c.Labels)
+	if _, err := db.Exec(ctx, sqlstr, c.ID, c.Status, c.RequestCountLimit, c.RequestDurationLimit, c.Criteria, c.RequestCount, c.CreatedAt, lo.Ternary(c.StartedAt.Valid == false, nil, &c.StartedAt), lo.Ternary(c.UpdatedAt.Valid == false, nil, &c.UpdatedAt), lo.Ternary(c.CompletedAt.Valid == false, nil, &c.CompletedAt), lo.Ternary(c.ResultID.Valid == false, nil, &c.ResultID), lo.Ternary(c.ErrorMessage.Valid == false, nil, &c.ErrorMessage), lo.Ternary(c.ErrorCode.Valid == false, nil, &c.ErrorCode), lo.Ternary(c.RetentionPeriod.Valid == false, nil, &c.RetentionPeriod), c.Pinned, c.DistinctBodies, c.DuplicateCount, c.Reservoir, c.SeenCount, c.MaxPerValue, c.MinDistinctValues, lo.Ternary(c.StartAt.Valid == false, nil, &c.StartAt), c.IdleTimeout, c.ByteSizeLimit, c.ByteCount, lo.Ternary(c.LastRequestAt.Valid == false, nil, &c.LastRequestAt), c.MinRequestCount, c.FinalizationAttempts, c.TimeLimitFromFirstRequest, c.MaxWait, lo.Ternary(c.PausedAt.Valid == false, nil, &c.PausedAt), lo.Ternary(c.ResumedAt.Valid == false, nil, &c.ResumedAt), c.FreezeTimeLimit, c.FrozenDuration, c.Name, c.Description, c.Owner, c.Labels); err != nil {
 		return logerror(err)
 	}
 	// set exists
@@ -146,7 +172,7 @@ func (c *Collection) Delete(ctx context.Context, db DB) error {
 func CollectionByID(ctx context.Context, db DB, id int64) (*Collection, error) {
 	// query
 	const sqlstr = `SELECT ` +
-		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code ` +
+		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code, retention_period, pinned, distinct_bodies, duplicate_count, reservoir, seen_count, max_per_value, min_distinct_values, start_at, idle_timeout, byte_size_limit, byte_count, last_request_at, min_request_count, finalization_attempts, time_limit_from_first_request, max_wait, paused_at, resumed_at, freeze_time_limit, frozen_duration, name, description, owner, labels, handlers ` +
 		`FROM public.collections ` +
 		`WHERE id = $1`
 	// run
@@ -154,7 +180,7 @@ func CollectionByID(ctx context.Context, db DB, id int64) (*Collection, error) {
 	c := Collection{
 		_exists: true,
 	}
-	if err := db.QueryRow(ctx, sqlstr, id).Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode); err != nil {
+	if err := db.QueryRow(ctx, sqlstr, id).Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode, &c.RetentionPeriod, &c.Pinned, &c.DistinctBodies, &c.DuplicateCount, &c.Reservoir, &c.SeenCount, &c.MaxPerValue, &c.MinDistinctValues, &c.StartAt, &c.IdleTimeout, &c.ByteSizeLimit, &c.ByteCount, &c.LastRequestAt, &c.MinRequestCount, &c.FinalizationAttempts, &c.TimeLimitFromFirstRequest, &c.MaxWait, &c.PausedAt, &c.ResumedAt, &c.FreezeTimeLimit, &c.FrozenDuration, &c.Name, &c.Description, &c.Owner, &c.Labels, &c.Handlers); err != nil {
 		return nil, logerror(err)
 	}
 	return &c, nil
@@ -166,7 +192,7 @@ func CollectionByID(ctx context.Context, db DB, id int64) (*Collection, error) {
 func CollectionByIDs(ctx context.Context, db DB, id []int64) ([]*Collection, error) {
 	// query
 	const sqlstr = `SELECT ` +
-		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code ` +
+		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code, retention_period, pinned, distinct_bodies, duplicate_count, reservoir, seen_count, max_per_value, min_distinct_values, start_at, idle_timeout, byte_size_limit, byte_count, last_request_at, min_request_count, finalization_attempts, time_limit_from_first_request, max_wait, paused_at, resumed_at, freeze_time_limit, frozen_duration, name, description, owner, labels, handlers ` +
 		`FROM public.collections ` +
 		`WHERE id = ANY($1) ` +
 		`ORDER BY id`
@@ -185,7 +211,7 @@ func CollectionByIDs(ctx context.Context, db DB, id []int64) ([]*Collection, err
 			_exists: true,
 		}
 		// scan
-		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode); err != nil {
+		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode, &c.RetentionPeriod, &c.Pinned, &c.DistinctBodies, &c.DuplicateCount, &c.Reservoir, &c.SeenCount, &c.MaxPerValue, &c.MinDistinctValues, &c.StartAt, &c.IdleTimeout, &c.ByteSizeLimit, &c.ByteCount, &c.LastRequestAt, &c.MinRequestCount, &c.FinalizationAttempts, &c.TimeLimitFromFirstRequest, &c.MaxWait, &c.PausedAt, &c.ResumedAt, &c.FreezeTimeLimit, &c.FrozenDuration, &c.Name, &c.Description, &c.Owner, &c.Labels, &c.Handlers); err != nil {
 			return nil, logerror(err)
 		}
 		res = append(res, &c)
@@ -202,7 +228,7 @@ func CollectionByIDs(ctx context.Context, db DB, id []int64) ([]*Collection, err
 func CollectionsByCompletedAt(ctx context.Context, db DB, completedAt pgtype.Timestamptz) ([]*Collection, error) {
 	// query
 	const sqlstr = `SELECT ` +
-		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code ` +
+		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code, retention_period, pinned, distinct_bodies, duplicate_count, reservoir, seen_count, max_per_value, min_distinct_values, start_at, idle_timeout, byte_size_limit, byte_count, last_request_at, min_request_count, finalization_attempts, time_limit_from_first_request, max_wait, paused_at, resumed_at, freeze_time_limit, frozen_duration, name, description, owner, labels, handlers ` +
 		`FROM public.collections ` +
 		`WHERE completed_at = $1`
 	// run
@@ -219,7 +245,7 @@ func CollectionsByCompletedAt(ctx context.Context, db DB, completedAt pgtype.Tim
 			_exists: true,
 		}
 		// scan
-		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode); err != nil {
+		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode, &c.RetentionPeriod, &c.Pinned, &c.DistinctBodies, &c.DuplicateCount, &c.Reservoir, &c.SeenCount, &c.MaxPerValue, &c.MinDistinctValues, &c.StartAt, &c.IdleTimeout, &c.ByteSizeLimit, &c.ByteCount, &c.LastRequestAt, &c.MinRequestCount, &c.FinalizationAttempts, &c.TimeLimitFromFirstRequest, &c.MaxWait, &c.PausedAt, &c.ResumedAt, &c.FreezeTimeLimit, &c.FrozenDuration, &c.Name, &c.Description, &c.Owner, &c.Labels, &c.Handlers); err != nil {
 			return nil, logerror(err)
 		}
 		res = append(res, &c)
@@ -236,7 +262,7 @@ func CollectionsByCompletedAt(ctx context.Context, db DB, completedAt pgtype.Tim
 func CollectionsByCompletedAts(ctx context.Context, db DB, completedAt []pgtype.Timestamptz) ([]*Collection, error) {
 	// query
 	const sqlstr = `SELECT ` +
-		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code ` +
+		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code, retention_period, pinned, distinct_bodies, duplicate_count, reservoir, seen_count, max_per_value, min_distinct_values, start_at, idle_timeout, byte_size_limit, byte_count, last_request_at, min_request_count, finalization_attempts, time_limit_from_first_request, max_wait, paused_at, resumed_at, freeze_time_limit, frozen_duration, name, description, owner, labels, handlers ` +
 		`FROM public.collections ` +
 		`WHERE completed_at = ANY($1) ` +
 		`ORDER BY completed_at`
@@ -255,7 +281,7 @@ func CollectionsByCompletedAts(ctx context.Context, db DB, completedAt []pgtype.
 			_exists: true,
 		}
 		// scan
-		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode); err != nil {
+		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode, &c.RetentionPeriod, &c.Pinned, &c.DistinctBodies, &c.DuplicateCount, &c.Reservoir, &c.SeenCount, &c.MaxPerValue, &c.MinDistinctValues, &c.StartAt, &c.IdleTimeout, &c.ByteSizeLimit, &c.ByteCount, &c.LastRequestAt, &c.MinRequestCount, &c.FinalizationAttempts, &c.TimeLimitFromFirstRequest, &c.MaxWait, &c.PausedAt, &c.ResumedAt, &c.FreezeTimeLimit, &c.FrozenDuration, &c.Name, &c.Description, &c.Owner, &c.Labels, &c.Handlers); err != nil {
 			return nil, logerror(err)
 		}
 		res = append(res, &c)
@@ -266,18 +292,18 @@ func CollectionsByCompletedAts(ctx context.Context, db DB, completedAt []pgtype.
 	return res, nil
 }
 
-// CollectionsByCreatedAt retrieves a row from 'public.collections' as a [Collection].
+// CollectionsByCreatedAtID retrieves a row from 'public.collections' as a [Collection].
 //
-// Generated from index 'idx_collections_created_at'.
-func CollectionsByCreatedAt(ctx context.Context, db DB, createdAt time.Time) ([]*Collection, error) {
+// Generated from index 'idx_collections_created_at_id'.
+func CollectionsByCreatedAtID(ctx context.Context, db DB, createdAt time.Time, id int64) ([]*Collection, error) {
 	// query
 	const sqlstr = `SELECT ` +
-		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code ` +
+		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code, retention_period, pinned, distinct_bodies, duplicate_count, reservoir, seen_count, max_per_value, min_distinct_values, start_at, idle_timeout, byte_size_limit, byte_count, last_request_at, min_request_count, finalization_attempts, time_limit_from_first_request, max_wait, paused_at, resumed_at, freeze_time_limit, frozen_duration, name, description, owner, labels, handlers ` +
 		`FROM public.collections ` +
-		`WHERE created_at = $1`
+		`WHERE created_at = $1 AND id = $2`
 	// run
-	logf(sqlstr, createdAt)
-	rows, err := db.Query(ctx, sqlstr, createdAt)
+	logf(sqlstr, createdAt, id)
+	rows, err := db.Query(ctx, sqlstr, createdAt, id)
 	if err != nil {
 		return nil, logerror(err)
 	}
@@ -289,7 +315,7 @@ func CollectionsByCreatedAt(ctx context.Context, db DB, createdAt time.Time) ([]
 			_exists: true,
 		}
 		// scan
-		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode); err != nil {
+		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode, &c.RetentionPeriod, &c.Pinned, &c.DistinctBodies, &c.DuplicateCount, &c.Reservoir, &c.SeenCount, &c.MaxPerValue, &c.MinDistinctValues, &c.StartAt, &c.IdleTimeout, &c.ByteSizeLimit, &c.ByteCount, &c.LastRequestAt, &c.MinRequestCount, &c.FinalizationAttempts, &c.TimeLimitFromFirstRequest, &c.MaxWait, &c.PausedAt, &c.ResumedAt, &c.FreezeTimeLimit, &c.FrozenDuration, &c.Name, &c.Description, &c.Owner, &c.Labels, &c.Handlers); err != nil {
 			return nil, logerror(err)
 		}
 		res = append(res, &c)
@@ -300,20 +326,194 @@ func CollectionsByCreatedAt(ctx context.Context, db DB, createdAt time.Time) ([]
 	return res, nil
 }
 
-// CollectionsByCreatedAts retrieves a row from 'public.collections' as a [Collection].
+// CollectionsByHandlers retrieves a row from 'public.collections' as a [Collection].
 //
-// Generated from index 'idx_collections_created_at'.
-func CollectionsByCreatedAts(ctx context.Context, db DB, createdAt []time.Time) ([]*Collection, error) {
+// Generated from index 'idx_collections_handlers'.
+func CollectionsByHandlers(ctx context.Context, db DB, handlers []byte) ([]*Collection, error) {
 	// query
 	const sqlstr = `SELECT ` +
-		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code ` +
+		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code, retention_period, pinned, distinct_bodies, duplicate_count, reservoir, seen_count, max_per_value, min_distinct_values, start_at, idle_timeout, byte_size_limit, byte_count, last_request_at, min_request_count, finalization_attempts, time_limit_from_first_request, max_wait, paused_at, resumed_at, freeze_time_limit, frozen_duration, name, description, owner, labels, handlers ` +
 		`FROM public.collections ` +
-		`WHERE created_at = ANY($1) ` +
-		`ORDER BY created_at`
+		`WHERE handlers = $1`
 	// run
-	logf(sqlstr, createdAt)
+	logf(sqlstr, handlers)
+	rows, err := db.Query(ctx, sqlstr, handlers)
+	if err != nil {
+		return nil, logerror(err)
+	}
+	defer rows.Close()
+	// process
+	var res []*Collection
+	for rows.Next() {
+		c := Collection{
+			_exists: true,
+		}
+		// scan
+		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode, &c.RetentionPeriod, &c.Pinned, &c.DistinctBodies, &c.DuplicateCount, &c.Reservoir, &c.SeenCount, &c.MaxPerValue, &c.MinDistinctValues, &c.StartAt, &c.IdleTimeout, &c.ByteSizeLimit, &c.ByteCount, &c.LastRequestAt, &c.MinRequestCount, &c.FinalizationAttempts, &c.TimeLimitFromFirstRequest, &c.MaxWait, &c.PausedAt, &c.ResumedAt, &c.FreezeTimeLimit, &c.FrozenDuration, &c.Name, &c.Description, &c.Owner, &c.Labels, &c.Handlers); err != nil {
+			return nil, logerror(err)
+		}
+		res = append(res, &c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, logerror(err)
+	}
+	return res, nil
+}
+
+// CollectionsByHandlerss retrieves a row from 'public.collections' as a [Collection].
+//
+// Generated from index 'idx_collections_handlers'.
+func CollectionsByHandlerss(ctx context.Context, db DB, handlers [][]byte) ([]*Collection, error) {
+	// query
+	const sqlstr = `SELECT ` +
+		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code, retention_period, pinned, distinct_bodies, duplicate_count, reservoir, seen_count, max_per_value, min_distinct_values, start_at, idle_timeout, byte_size_limit, byte_count, last_request_at, min_request_count, finalization_attempts, time_limit_from_first_request, max_wait, paused_at, resumed_at, freeze_time_limit, frozen_duration, name, description, owner, labels, handlers ` +
+		`FROM public.collections ` +
+		`WHERE handlers = ANY($1) ` +
+		`ORDER BY handlers`
+	// run
+	logf(sqlstr, handlers)
+
+	rows, err := db.Query(ctx, sqlstr, handlers)
+	if err != nil {
+		return nil, logerror(err)
+	}
+	defer rows.Close()
+	// process
+	var res []*Collection
+	for rows.Next() {
+		c := Collection{
+			_exists: true,
+		}
+		// scan
+		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode, &c.RetentionPeriod, &c.Pinned, &c.DistinctBodies, &c.DuplicateCount, &c.Reservoir, &c.SeenCount, &c.MaxPerValue, &c.MinDistinctValues, &c.StartAt, &c.IdleTimeout, &c.ByteSizeLimit, &c.ByteCount, &c.LastRequestAt, &c.MinRequestCount, &c.FinalizationAttempts, &c.TimeLimitFromFirstRequest, &c.MaxWait, &c.PausedAt, &c.ResumedAt, &c.FreezeTimeLimit, &c.FrozenDuration, &c.Name, &c.Description, &c.Owner, &c.Labels, &c.Handlers); err != nil {
+			return nil, logerror(err)
+		}
+		res = append(res, &c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, logerror(err)
+	}
+	return res, nil
+}
+
+// CollectionsByLabels retrieves a row from 'public.collections' as a [Collection].
+//
+// Generated from index 'idx_collections_labels'.
+func CollectionsByLabels(ctx context.Context, db DB, labels []byte) ([]*Collection, error) {
+	// query
+	const sqlstr = `SELECT ` +
+		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code, retention_period, pinned, distinct_bodies, duplicate_count, reservoir, seen_count, max_per_value, min_distinct_values, start_at, idle_timeout, byte_size_limit, byte_count, last_request_at, min_request_count, finalization_attempts, time_limit_from_first_request, max_wait, paused_at, resumed_at, freeze_time_limit, frozen_duration, name, description, owner, labels, handlers ` +
+		`FROM public.collections ` +
+		`WHERE labels = $1`
+	// run
+	logf(sqlstr, labels)
+	rows, err := db.Query(ctx, sqlstr, labels)
+	if err != nil {
+		return nil, logerror(err)
+	}
+	defer rows.Close()
+	// process
+	var res []*Collection
+	for rows.Next() {
+		c := Collection{
+			_exists: true,
+		}
+		// scan
+		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode, &c.RetentionPeriod, &c.Pinned, &c.DistinctBodies, &c.DuplicateCount, &c.Reservoir, &c.SeenCount, &c.MaxPerValue, &c.MinDistinctValues, &c.StartAt, &c.IdleTimeout, &c.ByteSizeLimit, &c.ByteCount, &c.LastRequestAt, &c.MinRequestCount, &c.FinalizationAttempts, &c.TimeLimitFromFirstRequest, &c.MaxWait, &c.PausedAt, &c.ResumedAt, &c.FreezeTimeLimit, &c.FrozenDuration, &c.Name, &c.Description, &c.Owner, &c.Labels, &c.Handlers); err != nil {
+			return nil, logerror(err)
+		}
+		res = append(res, &c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, logerror(err)
+	}
+	return res, nil
+}
+
+// CollectionsByLabelss retrieves a row from 'public.collections' as a [Collection].
+//
+// Generated from index 'idx_collections_labels'.
+func CollectionsByLabelss(ctx context.Context, db DB, labels [][]byte) ([]*Collection, error) {
+	// query
+	const sqlstr = `SELECT ` +
+		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code, retention_period, pinned, distinct_bodies, duplicate_count, reservoir, seen_count, max_per_value, min_distinct_values, start_at, idle_timeout, byte_size_limit, byte_count, last_request_at, min_request_count, finalization_attempts, time_limit_from_first_request, max_wait, paused_at, resumed_at, freeze_time_limit, frozen_duration, name, description, owner, labels, handlers ` +
+		`FROM public.collections ` +
+		`WHERE labels = ANY($1) ` +
+		`ORDER BY labels`
+	// run
+	logf(sqlstr, labels)
+
+	rows, err := db.Query(ctx, sqlstr, labels)
+	if err != nil {
+		return nil, logerror(err)
+	}
+	defer rows.Close()
+	// process
+	var res []*Collection
+	for rows.Next() {
+		c := Collection{
+			_exists: true,
+		}
+		// scan
+		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode, &c.RetentionPeriod, &c.Pinned, &c.DistinctBodies, &c.DuplicateCount, &c.Reservoir, &c.SeenCount, &c.MaxPerValue, &c.MinDistinctValues, &c.StartAt, &c.IdleTimeout, &c.ByteSizeLimit, &c.ByteCount, &c.LastRequestAt, &c.MinRequestCount, &c.FinalizationAttempts, &c.TimeLimitFromFirstRequest, &c.MaxWait, &c.PausedAt, &c.ResumedAt, &c.FreezeTimeLimit, &c.FrozenDuration, &c.Name, &c.Description, &c.Owner, &c.Labels, &c.Handlers); err != nil {
+			return nil, logerror(err)
+		}
+		res = append(res, &c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, logerror(err)
+	}
+	return res, nil
+}
+
+// CollectionsByOwner retrieves a row from 'public.collections' as a [Collection].
+//
+// Generated from index 'idx_collections_owner'.
+func CollectionsByOwner(ctx context.Context, db DB, owner string) ([]*Collection, error) {
+	// query
+	const sqlstr = `SELECT ` +
+		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code, retention_period, pinned, distinct_bodies, duplicate_count, reservoir, seen_count, max_per_value, min_distinct_values, start_at, idle_timeout, byte_size_limit, byte_count, last_request_at, min_request_count, finalization_attempts, time_limit_from_first_request, max_wait, paused_at, resumed_at, freeze_time_limit, frozen_duration, name, description, owner, labels, handlers ` +
+		`FROM public.collections ` +
+		`WHERE owner = $1`
+	// run
+	logf(sqlstr, owner)
+	rows, err := db.Query(ctx, sqlstr, owner)
+	if err != nil {
+		return nil, logerror(err)
+	}
+	defer rows.Close()
+	// process
+	var res []*Collection
+	for rows.Next() {
+		c := Collection{
+			_exists: true,
+		}
+		// scan
+		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode, &c.RetentionPeriod, &c.Pinned, &c.DistinctBodies, &c.DuplicateCount, &c.Reservoir, &c.SeenCount, &c.MaxPerValue, &c.MinDistinctValues, &c.StartAt, &c.IdleTimeout, &c.ByteSizeLimit, &c.ByteCount, &c.LastRequestAt, &c.MinRequestCount, &c.FinalizationAttempts, &c.TimeLimitFromFirstRequest, &c.MaxWait, &c.PausedAt, &c.ResumedAt, &c.FreezeTimeLimit, &c.FrozenDuration, &c.Name, &c.Description, &c.Owner, &c.Labels, &c.Handlers); err != nil {
+			return nil, logerror(err)
+		}
+		res = append(res, &c)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, logerror(err)
+	}
+	return res, nil
+}
+
+// CollectionsByOwners retrieves a row from 'public.collections' as a [Collection].
+//
+// Generated from index 'idx_collections_owner'.
+func CollectionsByOwners(ctx context.Context, db DB, owner []string) ([]*Collection, error) {
+	// query
+	const sqlstr = `SELECT ` +
+		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code, retention_period, pinned, distinct_bodies, duplicate_count, reservoir, seen_count, max_per_value, min_distinct_values, start_at, idle_timeout, byte_size_limit, byte_count, last_request_at, min_request_count, finalization_attempts, time_limit_from_first_request, max_wait, paused_at, resumed_at, freeze_time_limit, frozen_duration, name, description, owner, labels, handlers ` +
+		`FROM public.collections ` +
+		`WHERE owner = ANY($1) ` +
+		`ORDER BY owner`
+	// run
+	logf(sqlstr, owner)
 
-	rows, err := db.Query(ctx, sqlstr, createdAt)
+	rows, err := db.Query(ctx, sqlstr, owner)
 	if err != nil {
 		return nil, logerror(err)
 	}
@@ -325,7 +525,7 @@ func CollectionsByCreatedAts(ctx context.Context, db DB, createdAt []time.Time)
 			_exists: true,
 		}
 		// scan
-		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode); err != nil {
+		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode, &c.RetentionPeriod, &c.Pinned, &c.DistinctBodies, &c.DuplicateCount, &c.Reservoir, &c.SeenCount, &c.MaxPerValue, &c.MinDistinctValues, &c.StartAt, &c.IdleTimeout, &c.ByteSizeLimit, &c.ByteCount, &c.LastRequestAt, &c.MinRequestCount, &c.FinalizationAttempts, &c.TimeLimitFromFirstRequest, &c.MaxWait, &c.PausedAt, &c.ResumedAt, &c.FreezeTimeLimit, &c.FrozenDuration, &c.Name, &c.Description, &c.Owner, &c.Labels, &c.Handlers); err != nil {
 			return nil, logerror(err)
 		}
 		res = append(res, &c)
@@ -342,7 +542,7 @@ func CollectionsByCreatedAts(ctx context.Context, db DB, createdAt []time.Time)
 func CollectionsByStatus(ctx context.Context, db DB, status int) ([]*Collection, error) {
 	// query
 	const sqlstr = `SELECT ` +
-		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code ` +
+		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code, retention_period, pinned, distinct_bodies, duplicate_count, reservoir, seen_count, max_per_value, min_distinct_values, start_at, idle_timeout, byte_size_limit, byte_count, last_request_at, min_request_count, finalization_attempts, time_limit_from_first_request, max_wait, paused_at, resumed_at, freeze_time_limit, frozen_duration, name, description, owner, labels, handlers ` +
 		`FROM public.collections ` +
 		`WHERE status = $1`
 	// run
@@ -359,7 +559,7 @@ func CollectionsByStatus(ctx context.Context, db DB, status int) ([]*Collection,
 			_exists: true,
 		}
 		// scan
-		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode); err != nil {
+		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode, &c.RetentionPeriod, &c.Pinned, &c.DistinctBodies, &c.DuplicateCount, &c.Reservoir, &c.SeenCount, &c.MaxPerValue, &c.MinDistinctValues, &c.StartAt, &c.IdleTimeout, &c.ByteSizeLimit, &c.ByteCount, &c.LastRequestAt, &c.MinRequestCount, &c.FinalizationAttempts, &c.TimeLimitFromFirstRequest, &c.MaxWait, &c.PausedAt, &c.ResumedAt, &c.FreezeTimeLimit, &c.FrozenDuration, &c.Name, &c.Description, &c.Owner, &c.Labels, &c.Handlers); err != nil {
 			return nil, logerror(err)
 		}
 		res = append(res, &c)
@@ -376,7 +576,7 @@ func CollectionsByStatus(ctx context.Context, db DB, status int) ([]*Collection,
 func CollectionsByStatuss(ctx context.Context, db DB, status []int) ([]*Collection, error) {
 	// query
 	const sqlstr = `SELECT ` +
-		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code ` +
+		`id, status, request_count_limit, request_duration_limit, criteria, request_count, created_at, started_at, updated_at, completed_at, result_id, error_message, error_code, retention_period, pinned, distinct_bodies, duplicate_count, reservoir, seen_count, max_per_value, min_distinct_values, start_at, idle_timeout, byte_size_limit, byte_count, last_request_at, min_request_count, finalization_attempts, time_limit_from_first_request, max_wait, paused_at, resumed_at, freeze_time_limit, frozen_duration, name, description, owner, labels, handlers ` +
 		`FROM public.collections ` +
 		`WHERE status = ANY($1) ` +
 		`ORDER BY status`
@@ -395,7 +595,7 @@ func CollectionsByStatuss(ctx context.Context, db DB, status []int) ([]*Collecti
 			_exists: true,
 		}
 		// scan
-		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode); err != nil {
+		if err := rows.Scan(&c.ID, &c.Status, &c.RequestCountLimit, &c.RequestDurationLimit, &c.Criteria, &c.RequestCount, &c.CreatedAt, &c.StartedAt, &c.UpdatedAt, &c.CompletedAt, &c.ResultID, &c.ErrorMessage, &c.ErrorCode, &c.RetentionPeriod, &c.Pinned, &c.DistinctBodies, &c.DuplicateCount, &c.Reservoir, &c.SeenCount, &c.MaxPerValue, &c.MinDistinctValues, &c.StartAt, &c.IdleTimeout, &c.ByteSizeLimit, &c.ByteCount, &c.LastRequestAt, &c.MinRequestCount, &c.FinalizationAttempts, &c.TimeLimitFromFirstRequest, &c.MaxWait, &c.PausedAt, &c.ResumedAt, &c.FreezeTimeLimit, &c.FrozenDuration, &c.Name, &c.Description, &c.Owner, &c.Labels, &c.Handlers); err != nil {
 			return nil, logerror(err)
 		}
 		res = append(res, &c)
@@ -14,7 +14,7 @@ type Request struct {
 	ID        int64     `json:"id" db:"id"`                 // id
 	Handler   string    `json:"handler" db:"handler"`       // handler
 	Headers   []byte    `json:"headers" db:"headers"`       // headers
-	Body      []byte    `json:"body" db:"body"`             // body
+	BodyHash  []byte    `json:"body_hash" db:"body_hash"`   // body_hash
 	CreatedAt time.Time `json:"created_at" db:"created_at"` // created_at
 	// xo fields
 	_exists, _deleted bool
@@ -41,13 +41,13 @@ func (r *Request) Insert(ctx context.Context, db DB) error {
 	}
 	// insert (primary key generated and returned by database)
 	const sqlstr = `INSERT INTO public.requests (` +
-		`handler, headers, body, created_at` +
+		`handler, headers, body_hash, created_at` +
 		`) VALUES (` +
 		`$1, $2, $3, $4` +
 		`) RETURNING id`
 	// run
-	logf(sqlstr, r.Handler, r.Headers, r.Body, r.CreatedAt)
-	if err := db.QueryRow(ctx, sqlstr, r.Handler, r.Headers, r.Body, r.CreatedAt).Scan(&r.ID); err != nil {
+	logf(sqlstr, r.Handler, r.Headers, r.BodyHash, r.CreatedAt)
+	if err := db.QueryRow(ctx, sqlstr, r.Handler, r.Headers, r.BodyHash, r.CreatedAt).Scan(&r.ID); err != nil {
 		return logerror(err)
 	}
 	// set exists
@@ -65,11 +65,11 @@ func (r *Request) Update(ctx context.Context, db DB) error {
 	}
 	// update with composite primary key
 	const sqlstr = `UPDATE public.requests SET ` +
-		`handler = $1, headers = $2, body = $3, created_at = $4 ` +
-		`WHERE id = $5`
+		`handler = $1, headers = $2, body_hash = $3 ` +
+		`WHERE id = $4 AND created_at = $5`
 	// run
-	logf(sqlstr, r.Handler, r.Headers, r.Body, r.CreatedAt, r.ID)
-	if _, err := db.Exec(ctx, sqlstr, r.Handler, r.Headers, r.Body, r.CreatedAt, r.ID); err != nil {
+	logf(sqlstr, r.Handler, r.Headers, r.BodyHash, r.ID, r.CreatedAt)
+	if _, err := db.Exec(ctx, sqlstr, r.Handler, r.Headers, r.BodyHash, r.ID, r.CreatedAt); err != nil {
 		return logerror(err)
 	}
 	return nil
@@ -91,16 +91,16 @@ func (r *Request) Upsert(ctx context.Context, db DB) error {
 	}
 	// upsert
 	const sqlstr = `INSERT INTO public.requests (` +
-		`id, handler, headers, body, created_at` +
+		`id, handler, headers, body_hash, created_at` +
 		`) VALUES (` +
 		`$1, $2, $3, $4, $5` +
 		`)` +
-		` ON CONFLICT (id) DO ` +
+		` ON CONFLICT (id, created_at) DO ` +
 		`UPDATE SET ` +
-		`handler = EXCLUDED.handler, headers = EXCLUDED.headers, body = EXCLUDED.body, created_at = EXCLUDED.created_at `
+		`handler = EXCLUDED.handler, headers = EXCLUDED.headers, body_hash = EXCLUDED.body_hash `
 	// run
-	logf(sqlstr, r.ID, r.Handler, r.Headers, r.Body, r.CreatedAt)
-	if _, err := db.Exec(ctx, sqlstr, r.ID, r.Handler, r.Headers, r.Body, r.CreatedAt); err != nil {
+	logf(sqlstr, r.ID, r.Handler, r.Headers, r.BodyHash, r.CreatedAt)
+	if _, err := db.Exec(ctx, sqlstr, r.ID, r.Handler, r.Headers, r.BodyHash, r.CreatedAt); err != nil {
 		return logerror(err)
 	}
 	// set exists
@@ -116,12 +116,12 @@ func (r *Request) Delete(ctx context.Context, db DB) error {
 	case r._deleted: // deleted
 		return nil
 	}
-	// delete with single primary key
+	// delete with composite primary key
 	const sqlstr = `DELETE FROM public.requests ` +
-		`WHERE id = $1`
+		`WHERE id = $1 AND created_at = $2`
 	// run
-	logf(sqlstr, r.ID)
-	if _, err := db.Exec(ctx, sqlstr, r.ID); err != nil {
+	logf(sqlstr, r.ID, r.CreatedAt)
+	if _, err := db.Exec(ctx, sqlstr, r.ID, r.CreatedAt); err != nil {
 		return logerror(err)
 	}
 	// set deleted
@@ -129,18 +129,18 @@ func (r *Request) Delete(ctx context.Context, db DB) error {
 	return nil
 }
 
-// RequestsByCreatedAt retrieves a row from 'public.requests' as a [Request].
+// RequestsByBodyHash retrieves a row from 'public.requests' as a [Request].
 //
-// Generated from index 'idx_requests_created_at'.
-func RequestsByCreatedAt(ctx context.Context, db DB, createdAt time.Time) ([]*Request, error) {
+// Generated from index 'idx_requests_body_hash'.
+func RequestsByBodyHash(ctx context.Context, db DB, bodyHash []byte) ([]*Request, error) {
 	// query
 	const sqlstr = `SELECT ` +
-		`id, handler, headers, body, created_at ` +
+		`id, handler, headers, body_hash, created_at ` +
 		`FROM public.requests ` +
-		`WHERE created_at = $1`
+		`WHERE body_hash = $1`
 	// run
-	logf(sqlstr, createdAt)
-	rows, err := db.Query(ctx, sqlstr, createdAt)
+	logf(sqlstr, bodyHash)
+	rows, err := db.Query(ctx, sqlstr, bodyHash)
 	if err != nil {
 		return nil, logerror(err)
 	}
@@ -152,7 +152,7 @@ func RequestsByCreatedAt(ctx context.Context, db DB, createdAt time.Time) ([]*Re
 			_exists: true,
 		}
 		// scan
-		if err := rows.Scan(&r.ID, &r.Handler, &r.Headers, &r.Body, &r.CreatedAt); err != nil {
+		if err := rows.Scan(&r.ID, &r.Handler, &r.Headers, &r.BodyHash, &r.CreatedAt); err != nil {
 			return nil, logerror(err)
 		}
 		res = append(res, &r)
@@ -163,20 +163,20 @@ func RequestsByCreatedAt(ctx context.Context, db DB, createdAt time.Time) ([]*Re
 	return res, nil
 }
 
-// RequestsByCreatedAts retrieves a row from 'public.requests' as a [Request].
+// RequestsByBodyHashs retrieves a row from 'public.requests' as a [Request].
 //
-// Generated from index 'idx_requests_created_at'.
-func RequestsByCreatedAts(ctx context.Context, db DB, createdAt []time.Time) ([]*Request, error) {
+// Generated from index 'idx_requests_body_hash'.
+func RequestsByBodyHashs(ctx context.Context, db DB, bodyHash [][]byte) ([]*Request, error) {
 	// query
 	const sqlstr = `SELECT ` +
-		`id, handler, headers, body, created_at ` +
+		`id, handler, headers, body_hash, created_at ` +
 		`FROM public.requests ` +
-		`WHERE created_at = ANY($1) ` +
-		`ORDER BY created_at`
+		`WHERE body_hash = ANY($1) ` +
+		`ORDER BY body_hash`
 	// run
-	logf(sqlstr, createdAt)
+	logf(sqlstr, bodyHash)
 
-	rows, err := db.Query(ctx, sqlstr, createdAt)
+	rows, err := db.Query(ctx, sqlstr, bodyHash)
 	if err != nil {
 		return nil, logerror(err)
 	}
@@ -188,7 +188,7 @@ func RequestsByCreatedAts(ctx context.Context, db DB, createdAt []time.Time) ([]
 			_exists: true,
 		}
 		// scan
-		if err := rows.Scan(&r.ID, &r.Handler, &r.Headers, &r.Body, &r.CreatedAt); err != nil {
+		if err := rows.Scan(&r.ID, &r.Handler, &r.Headers, &r.BodyHash, &r.CreatedAt); err != nil {
 			return nil, logerror(err)
 		}
 		res = append(res, &r)
@@ -199,40 +199,54 @@ func RequestsByCreatedAts(ctx context.Context, db DB, createdAt []time.Time) ([]
 	return res, nil
 }
 
-// RequestByID retrieves a row from 'public.requests' as a [Request].
+// RequestsByCreatedAt retrieves a row from 'public.requests' as a [Request].
 //
-// Generated from index 'requests_pkey'.
-func RequestByID(ctx context.Context, db DB, id int64) (*Request, error) {
+// Generated from index 'idx_requests_created_at'.
+func RequestsByCreatedAt(ctx context.Context, db DB, createdAt time.Time) ([]*Request, error) {
 	// query
 	const sqlstr = `SELECT ` +
-		`id, handler, headers, body, created_at ` +
+		`id, handler, headers, body_hash, created_at ` +
 		`FROM public.requests ` +
-		`WHERE id = $1`
+		`WHERE created_at = $1`
 	// run
-	logf(sqlstr, id)
-	r := Request{
-		_exists: true,
+	logf(sqlstr, createdAt)
+	rows, err := db.Query(ctx, sqlstr, createdAt)
+	if err != nil {
+		return nil, logerror(err)
 	}
-	if err := db.QueryRow(ctx, sqlstr, id).Scan(&r.ID, &r.Handler, &r.Headers, &r.Body, &r.CreatedAt); err != nil {
+	defer rows.Close()
+	// process
+	var res []*Request
+	for rows.Next() {
+		r := Request{
+			_exists: true,
+		}
+		// scan
+		if err := rows.Scan(&r.ID, &r.Handler, &r.Headers, &r.BodyHash, &r.CreatedAt); err != nil {
+			return nil, logerror(err)
+		}
+		res = append(res, &r)
+	}
+	if err := rows.Err(); err != nil {
 		return nil, logerror(err)
 	}
-	return &r, nil
+	return res, nil
 }
 
-// RequestByIDs retrieves a row from 'public.requests' as a [Request].
+// RequestsByCreatedAts retrieves a row from 'public.requests' as a [Request].
 //
-// Generated from index 'requests_pkey'.
-func RequestByIDs(ctx context.Context, db DB, id []int64) ([]*Request, error) {
+// Generated from index 'idx_requests_created_at'.
+func RequestsByCreatedAts(ctx context.Context, db DB, createdAt []time.Time) ([]*Request, error) {
 	// query
 	const sqlstr = `SELECT ` +
-		`id, handler, headers, body, created_at ` +
+		`id, handler, headers, body_hash, created_at ` +
 		`FROM public.requests ` +
-		`WHERE id = ANY($1) ` +
-		`ORDER BY id`
+		`WHERE created_at = ANY($1) ` +
+		`ORDER BY created_at`
 	// run
-	logf(sqlstr, id)
+	logf(sqlstr, createdAt)
 
-	rows, err := db.Query(ctx, sqlstr, id)
+	rows, err := db.Query(ctx, sqlstr, createdAt)
 	if err != nil {
 		return nil, logerror(err)
 	}
@@ -244,7 +258,7 @@ func RequestByIDs(ctx context.Context, db DB, id []int64) ([]*Request, error) {
 			_exists: true,
 		}
 		// scan
-		if err := rows.Scan(&r.ID, &r.Handler, &r.Headers, &r.Body, &r.CreatedAt); err != nil {
+		if err := rows.Scan(&r.ID, &r.Handler, &r.Headers, &r.BodyHash, &r.CreatedAt); err != nil {
 			return nil, logerror(err)
 		}
 		res = append(res, &r)
@@ -254,3 +268,23 @@ func RequestByIDs(ctx context.Context, db DB, id []int64) ([]*Request, error) {
 	}
 	return res, nil
 }
+
+// RequestByIDCreatedAt retrieves a row from 'public.requests' as a [Request].
+//
+// Generated from index 'requests_pkey'.
+func RequestByIDCreatedAt(ctx context.Context, db DB, id int64, createdAt time.Time) (*Request, error) {
+	// query
+	const sqlstr = `SELECT ` +
+		`id, handler, headers, body_hash, created_at ` +
+		`FROM public.requests ` +
+		`WHERE id = $1 AND created_at = $2`
+	// run
+	logf(sqlstr, id, createdAt)
+	r := Request{
+		_exists: true,
+	}
+	if err := db.QueryRow(ctx, sqlstr, id, createdAt).Scan(&r.ID, &r.Handler, &r.Headers, &r.BodyHash, &r.CreatedAt); err != nil {
+		return nil, logerror(err)
+	}
+	return &r, nil
+}
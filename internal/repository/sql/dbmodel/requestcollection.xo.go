@@ -4,14 +4,19 @@ package dbmodel
 
 import (
 	"context"
+	"time"
 
+	pgtype "github.com/jackc/pgx/v5/pgtype"
 	_ "github.com/jackc/pgx/v5/stdlib" // pgx postgres driver
 )
 
 // RequestCollection represents a row from 'public.request_collections'.
 type RequestCollection struct {
-	RequestID    int64 `json:"request_id" db:"request_id"`       // request_id
-	CollectionID int64 `json:"collection_id" db:"collection_id"` // collection_id
+	RequestID    int64       `json:"request_id" db:"request_id"`       // request_id
+	CollectionID int64       `json:"collection_id" db:"collection_id"` // collection_id
+	CreatedAt    time.Time   `json:"created_at" db:"created_at"`       // created_at
+	RuleID       pgtype.Int4 `json:"rule_id" db:"rule_id"`             // rule_id
+	Slot         pgtype.Int4 `json:"slot" db:"slot"`                   // slot
 	// xo fields
 	_exists, _deleted bool
 }
@@ -37,13 +42,13 @@ func (rc *RequestCollection) Insert(ctx context.Context, db DB) error {
 	}
 	// insert (manual)
 	const sqlstr = `INSERT INTO public.request_collections (` +
-		`request_id, collection_id` +
+		`request_id, collection_id, created_at, rule_id, slot` +
 		`) VALUES (` +
-		`$1, $2` +
+		`$1, $2, $3, $4, $5` +
 		`)`
 	// run
-	logf(sqlstr, rc.RequestID, rc.CollectionID)
-	if _, err := db.Exec(ctx, sqlstr, rc.RequestID, rc.CollectionID); err != nil {
+	logf(sqlstr, rc.RequestID, rc.CollectionID, rc.CreatedAt, rc.RuleID, rc.Slot)
+	if _, err := db.Exec(ctx, sqlstr, rc.RequestID, rc.CollectionID, rc.CreatedAt, rc.RuleID, rc.Slot); err != nil {
 		return logerror(err)
 	}
 	// set exists
@@ -51,7 +56,33 @@ func (rc *RequestCollection) Insert(ctx context.Context, db DB) error {
 	return nil
 }
 
-// ------ NOTE: Update statements omitted due to lack of fields other than primary key ------
+// Update updates a [RequestCollection] in the database.
+func (rc *RequestCollection) Update(ctx context.Context, db DB) error {
+	switch {
+	case !rc._exists: // doesn't exist
+		return logerror(&ErrUpdateFailed{ErrDoesNotExist})
+	case rc._deleted: // deleted
+		return logerror(&ErrUpdateFailed{ErrMarkedForDeletion})
+	}
+	// update with composite primary key
+	const sqlstr = `UPDATE public.request_collections SET ` +
+		`rule_id = $1, slot = $2 ` +
+		`WHERE request_id = $3 AND collection_id = $4 AND created_at = $5`
+	// run
+	logf(sqlstr, rc.RuleID, rc.Slot, rc.RequestID, rc.CollectionID, rc.CreatedAt)
+	if _, err := db.Exec(ctx, sqlstr, rc.RuleID, rc.Slot, rc.RequestID, rc.CollectionID, rc.CreatedAt); err != nil {
+		return logerror(err)
+	}
+	return nil
+}
+
+// Save saves the [RequestCollection] to the database.
+func (rc *RequestCollection) Save(ctx context.Context, db DB) error {
+	if rc.Exists() {
+		return rc.Update(ctx, db)
+	}
+	return rc.Insert(ctx, db)
+}
 
 // Delete deletes the [RequestCollection] from the database.
 func (rc *RequestCollection) Delete(ctx context.Context, db DB) error {
@@ -63,10 +94,10 @@ func (rc *RequestCollection) Delete(ctx context.Context, db DB) error {
 	}
 	// delete with composite primary key
 	const sqlstr = `DELETE FROM public.request_collections ` +
-		`WHERE request_id = $1 AND collection_id = $2`
+		`WHERE request_id = $1 AND collection_id = $2 AND created_at = $3`
 	// run
-	logf(sqlstr, rc.RequestID, rc.CollectionID)
-	if _, err := db.Exec(ctx, sqlstr, rc.RequestID, rc.CollectionID); err != nil {
+	logf(sqlstr, rc.RequestID, rc.CollectionID, rc.CreatedAt)
+	if _, err := db.Exec(ctx, sqlstr, rc.RequestID, rc.CollectionID, rc.CreatedAt); err != nil {
 		return logerror(err)
 	}
 	// set deleted
@@ -80,7 +111,7 @@ func (rc *RequestCollection) Delete(ctx context.Context, db DB) error {
 func RequestCollectionsByCollectionID(ctx context.Context, db DB, collectionID int64) ([]*RequestCollection, error) {
 	// query
 	const sqlstr = `SELECT ` +
-		`request_id, collection_id ` +
+		`request_id, collection_id, created_at, rule_id, slot ` +
 		`FROM public.request_collections ` +
 		`WHERE collection_id = $1`
 	// run
@@ -97,7 +128,7 @@ func RequestCollectionsByCollectionID(ctx context.Context, db DB, collectionID i
 			_exists: true,
 		}
 		// scan
-		if err := rows.Scan(&rc.RequestID, &rc.CollectionID); err != nil {
+		if err := rows.Scan(&rc.RequestID, &rc.CollectionID, &rc.CreatedAt, &rc.RuleID, &rc.Slot); err != nil {
 			return nil, logerror(err)
 		}
 		res = append(res, &rc)
@@ -114,7 +145,7 @@ func RequestCollectionsByCollectionID(ctx context.Context, db DB, collectionID i
 func RequestCollectionsByCollectionIDs(ctx context.Context, db DB, collectionID []int64) ([]*RequestCollection, error) {
 	// query
 	const sqlstr = `SELECT ` +
-		`request_id, collection_id ` +
+		`request_id, collection_id, created_at, rule_id, slot ` +
 		`FROM public.request_collections ` +
 		`WHERE collection_id = ANY($1) ` +
 		`ORDER BY collection_id`
@@ -133,7 +164,7 @@ func RequestCollectionsByCollectionIDs(ctx context.Context, db DB, collectionID
 			_exists: true,
 		}
 		// scan
-		if err := rows.Scan(&rc.RequestID, &rc.CollectionID); err != nil {
+		if err := rows.Scan(&rc.RequestID, &rc.CollectionID, &rc.CreatedAt, &rc.RuleID, &rc.Slot); err != nil {
 			return nil, logerror(err)
 		}
 		res = append(res, &rc)
@@ -150,7 +181,7 @@ func RequestCollectionsByCollectionIDs(ctx context.Context, db DB, collectionID
 func RequestCollectionsByRequestID(ctx context.Context, db DB, requestID int64) ([]*RequestCollection, error) {
 	// query
 	const sqlstr = `SELECT ` +
-		`request_id, collection_id ` +
+		`request_id, collection_id, created_at, rule_id, slot ` +
 		`FROM public.request_collections ` +
 		`WHERE request_id = $1`
 	// run
@@ -167,7 +198,7 @@ func RequestCollectionsByRequestID(ctx context.Context, db DB, requestID int64)
 			_exists: true,
 		}
 		// scan
-		if err := rows.Scan(&rc.RequestID, &rc.CollectionID); err != nil {
+		if err := rows.Scan(&rc.RequestID, &rc.CollectionID, &rc.CreatedAt, &rc.RuleID, &rc.Slot); err != nil {
 			return nil, logerror(err)
 		}
 		res = append(res, &rc)
@@ -184,7 +215,7 @@ func RequestCollectionsByRequestID(ctx context.Context, db DB, requestID int64)
 func RequestCollectionsByRequestIDs(ctx context.Context, db DB, requestID []int64) ([]*RequestCollection, error) {
 	// query
 	const sqlstr = `SELECT ` +
-		`request_id, collection_id ` +
+		`request_id, collection_id, created_at, rule_id, slot ` +
 		`FROM public.request_collections ` +
 		`WHERE request_id = ANY($1) ` +
 		`ORDER BY request_id`
@@ -203,7 +234,7 @@ func RequestCollectionsByRequestIDs(ctx context.Context, db DB, requestID []int6
 			_exists: true,
 		}
 		// scan
-		if err := rows.Scan(&rc.RequestID, &rc.CollectionID); err != nil {
+		if err := rows.Scan(&rc.RequestID, &rc.CollectionID, &rc.CreatedAt, &rc.RuleID, &rc.Slot); err != nil {
 			return nil, logerror(err)
 		}
 		res = append(res, &rc)
@@ -214,21 +245,21 @@ func RequestCollectionsByRequestIDs(ctx context.Context, db DB, requestID []int6
 	return res, nil
 }
 
-// RequestCollectionByRequestIDCollectionID retrieves a row from 'public.request_collections' as a [RequestCollection].
+// RequestCollectionByRequestIDCollectionIDCreatedAt retrieves a row from 'public.request_collections' as a [RequestCollection].
 //
 // Generated from index 'request_collections_pkey'.
-func RequestCollectionByRequestIDCollectionID(ctx context.Context, db DB, requestID, collectionID int64) (*RequestCollection, error) {
+func RequestCollectionByRequestIDCollectionIDCreatedAt(ctx context.Context, db DB, requestID, collectionID int64, createdAt time.Time) (*RequestCollection, error) {
 	// query
 	const sqlstr = `SELECT ` +
-		`request_id, collection_id ` +
+		`request_id, collection_id, created_at, rule_id, slot ` +
 		`FROM public.request_collections ` +
-		`WHERE request_id = $1 AND collection_id = $2`
+		`WHERE request_id = $1 AND collection_id = $2 AND created_at = $3`
 	// run
-	logf(sqlstr, requestID, collectionID)
+	logf(sqlstr, requestID, collectionID, createdAt)
 	rc := RequestCollection{
 		_exists: true,
 	}
-	if err := db.QueryRow(ctx, sqlstr, requestID, collectionID).Scan(&rc.RequestID, &rc.CollectionID); err != nil {
+	if err := db.QueryRow(ctx, sqlstr, requestID, collectionID, createdAt).Scan(&rc.RequestID, &rc.CollectionID, &rc.CreatedAt, &rc.RuleID, &rc.Slot); err != nil {
 		return nil, logerror(err)
 	}
 	return &rc, nil
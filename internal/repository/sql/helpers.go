@@ -10,6 +10,8 @@ import (
 	"github.com/n-r-w/collector/internal/entity"
 	"github.com/n-r-w/collector/internal/repository/sql/dbmodel"
 	"github.com/n-r-w/ctxlog"
+	"github.com/n-r-w/pgh/v2"
+	"github.com/n-r-w/pgh/v2/px"
 	"github.com/n-r-w/pgh/v2/px/db"
 	"github.com/n-r-w/pgh/v2/px/db/conn"
 	"github.com/n-r-w/pgh/v2/txmgr"
@@ -32,18 +34,26 @@ func GetConn(
 }
 
 // SetupTest sets up a test environment for sql repository.
+// Queries are not logged in benchmarks.
 func SetupTest[T any](
-	t *testing.T,
+	t testing.TB,
 	f func(*config.Config, *db.PxDB, *txmgr.TransactionManager) (*T, error),
 ) (context.Context, *T) {
 	t.Helper()
 
 	const postgresVersion = "17.2"
 
+	_, benchmark := t.(*testing.B)
+
+	logLevel := slog.LevelDebug
+	if benchmark {
+		logLevel = slog.LevelWarn
+	}
+
 	// logger
 	logger := ctxlog.Must(
 		ctxlog.WithTesting(t),
-		ctxlog.WithLevel(slog.LevelDebug),
+		ctxlog.WithLevel(logLevel),
 	)
 	// put logger into context
 	ctx := ctxlog.ToContext(context.Background(), logger)
@@ -57,7 +67,11 @@ func SetupTest[T any](
 	)
 
 	// test database implementation
-	dbImpl := db.New(db.WithPool(pool), db.WithLogQueries(), db.WithLogger(logger))
+	dbOpts := []db.Option{db.WithPool(pool), db.WithLogger(logger)}
+	if !benchmark {
+		dbOpts = append(dbOpts, db.WithLogQueries())
+	}
+	dbImpl := db.New(dbOpts...)
 	// test transaction manager implementation
 	txmgrImpl := txmgr.New(dbImpl, dbImpl)
 
@@ -71,7 +85,7 @@ func SetupTest[T any](
 
 // CreateTestCollection creates a test collection with the given task.
 func CreateTestCollection(
-	t *testing.T,
+	t testing.TB,
 	ctx context.Context,
 	c func(context.Context) conn.IConnection,
 	task entity.Task,
@@ -81,14 +95,58 @@ func CreateTestCollection(
 	criteria, err := ConvertTaskToCriteriaDB(task)
 	require.NoError(t, err)
 
+	labels, err := ConvertLabelsToDB(task.Metadata.Labels)
+	require.NoError(t, err)
+
 	dbCollection := dbmodel.Collection{
-		Status:               int(entity.StatusPending),
-		RequestCountLimit:    task.Completion.RequestCountLimit,
-		RequestDurationLimit: task.Completion.TimeLimit,
-		Criteria:             criteria,
+		Status:                    int(entity.StatusPending),
+		RequestCountLimit:         task.Completion.RequestCountLimit,
+		RequestDurationLimit:      task.Completion.TimeLimit,
+		Criteria:                  criteria,
+		DistinctBodies:            task.MessageSelection.DistinctBodies,
+		Reservoir:                 task.Completion.Reservoir,
+		MaxPerValue:               task.Stratification.OrEmpty().MaxPerValue,
+		MinDistinctValues:         task.Stratification.OrEmpty().MinDistinctValues,
+		IdleTimeout:               task.Completion.IdleTimeout,
+		ByteSizeLimit:             task.Completion.ByteSizeLimit,
+		MinRequestCount:           task.Completion.MinRequestCount,
+		TimeLimitFromFirstRequest: task.Completion.TimeLimitFromFirstRequest,
+		MaxWait:                   task.Completion.MaxWait,
+		Name:                      task.Metadata.Name,
+		Description:               task.Metadata.Description,
+		Owner:                     task.Metadata.Owner,
+		Labels:                    labels,
 	}
 
 	require.NoError(t, dbCollection.Insert(ctx, c(ctx)))
 
+	for i, rule := range task.Rules {
+		dbRule := dbmodel.CollectionRule{
+			CollectionID:      dbCollection.ID,
+			RuleID:            i,
+			Name:              rule.Name,
+			RequestCountLimit: rule.RequestCountLimit,
+		}
+		require.NoError(t, dbRule.Insert(ctx, c(ctx)))
+	}
+
 	return entity.CollectionID(dbCollection.ID)
 }
+
+// InsertTestBody stores a request body and returns its hash to be referenced by requests.
+func InsertTestBody(
+	t testing.TB,
+	ctx context.Context,
+	c func(context.Context) conn.IConnection,
+	body []byte,
+) []byte {
+	t.Helper()
+
+	hash := entity.HashBody(body)
+	_, err := px.ExecPlain(ctx, c(ctx),
+		`INSERT INTO request_bodies (hash, body) VALUES ($1, $2) ON CONFLICT (hash) DO NOTHING`,
+		pgh.Args{hash, body})
+	require.NoError(t, err)
+
+	return hash
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/n-r-w/collector/internal/config"
 	"github.com/n-r-w/collector/internal/repository/sql"
 	"github.com/n-r-w/collector/internal/usecases/cleaner"
+	"github.com/n-r-w/collector/internal/usecases/scheduler"
 	"github.com/n-r-w/pgh/v2/px/db"
 	"github.com/n-r-w/pgh/v2/px/db/conn"
 	"github.com/n-r-w/pgh/v2/txmgr"
@@ -17,7 +18,10 @@ type Service struct {
 	conn      func(ctx context.Context) conn.IConnection
 }
 
-var _ cleaner.ILocker = (*Service)(nil)
+var (
+	_ cleaner.ILocker   = (*Service)(nil)
+	_ scheduler.ILocker = (*Service)(nil)
+)
 
 func New(
 	cfg *config.Config,
@@ -0,0 +1,271 @@
+package reqprocessor
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/n-r-w/collector/internal/entity"
+	sqlrepo "github.com/n-r-w/collector/internal/repository/sql"
+	"github.com/n-r-w/pgh/v2"
+	"github.com/n-r-w/pgh/v2/px"
+	"github.com/samber/lo"
+)
+
+// collectionBody is a body collected by a collection with distinct bodies.
+type collectionBody struct {
+	CollectionID entity.CollectionID `db:"collection_id"`
+	BodyHash     []byte              `db:"body_hash"`
+}
+
+// key returns a comparable key of the collection body.
+func (b collectionBody) key() collectionBodyKey {
+	return collectionBodyKey{collectionID: b.CollectionID, hash: string(b.BodyHash)}
+}
+
+type collectionBodyKey struct {
+	collectionID entity.CollectionID
+	hash         string
+}
+
+// removeDuplicates removes links of collections with distinct bodies to bodies they already have,
+// including bodies repeated in the batch. Only the first request with a new body is kept.
+// Returns the remaining matches and the number of removed links by collection.
+func (s *Service) removeDuplicates(
+	ctx context.Context, toStore []entity.MatchResult, hashes map[int][]byte,
+	collecting map[entity.CollectionID]lockedCollection,
+) ([]entity.MatchResult, map[entity.CollectionID]int, error) {
+	candidates := collectionBodies(toStore, hashes, collecting)
+	if len(candidates) == 0 {
+		return toStore, nil, nil
+	}
+
+	// collected bodies can't be added concurrently, because the collections are locked
+	var collected []collectionBody
+	if err := px.SelectPlain(ctx, s.conn(ctx),
+		`SELECT cb.collection_id, cb.body_hash
+		FROM collection_bodies cb
+		JOIN unnest($1::bigint[], $2::bytea[]) AS b(collection_id, body_hash)
+			ON cb.collection_id = b.collection_id AND cb.body_hash = b.body_hash`,
+		&collected, collectionBodiesArgs(candidates)); err != nil {
+		return nil, nil, fmt.Errorf("Store: failed to get collection bodies: %w", err)
+	}
+
+	seen := lo.Keyify(lo.Map(collected, func(b collectionBody, _ int) collectionBodyKey { return b.key() }))
+	duplicateByCol := make(map[entity.CollectionID]int)
+
+	result := make([]entity.MatchResult, 0, len(toStore))
+	for _, match := range toStore {
+		collectionIDs := lo.Filter(match.CollectionIDs, func(id entity.CollectionID, _ int) bool {
+			if !collecting[id].DistinctBodies {
+				return true
+			}
+
+			key := collectionBody{CollectionID: id, BodyHash: hashes[match.RequestPos]}.key()
+			if _, ok := seen[key]; !ok {
+				seen[key] = struct{}{}
+				return true
+			}
+
+			duplicateByCol[id]++
+			return false
+		})
+
+		if len(collectionIDs) > 0 {
+			result = append(result, entity.MatchResult{
+				RequestPos:    match.RequestPos,
+				CollectionIDs: collectionIDs,
+				RuleIDs:       match.RuleIDs,
+				Strata:        match.Strata,
+			})
+		}
+	}
+
+	return result, duplicateByCol, nil
+}
+
+// recordBodies records bodies of the stored links of collections with distinct bodies as collected.
+func (s *Service) recordBodies(
+	ctx context.Context, toStore []entity.MatchResult, hashes map[int][]byte,
+	collecting map[entity.CollectionID]lockedCollection,
+) error {
+	bodies := collectionBodies(toStore, hashes, collecting)
+	if len(bodies) == 0 {
+		return nil
+	}
+
+	if _, err := px.ExecPlain(ctx, s.conn(ctx),
+		`INSERT INTO collection_bodies (collection_id, body_hash)
+		SELECT * FROM unnest($1::bigint[], $2::bytea[])
+		ON CONFLICT DO NOTHING`,
+		collectionBodiesArgs(bodies)); err != nil {
+		return fmt.Errorf("Store: failed to insert collection bodies: %w", err)
+	}
+
+	return nil
+}
+
+// collectionBodies returns unique bodies of the links to collections with distinct bodies,
+// sorted to avoid deadlocks.
+func collectionBodies(
+	toStore []entity.MatchResult, hashes map[int][]byte, collecting map[entity.CollectionID]lockedCollection,
+) []collectionBody {
+	bodies := make(map[collectionBodyKey]collectionBody)
+	for _, match := range toStore {
+		for _, collectionID := range match.CollectionIDs {
+			if !collecting[collectionID].DistinctBodies {
+				continue
+			}
+
+			body := collectionBody{CollectionID: collectionID, BodyHash: hashes[match.RequestPos]}
+			bodies[body.key()] = body
+		}
+	}
+
+	sorted := lo.Values(bodies)
+	slices.SortFunc(sorted, func(a, b collectionBody) int {
+		return cmp.Or(cmp.Compare(a.CollectionID, b.CollectionID), bytes.Compare(a.BodyHash, b.BodyHash))
+	})
+
+	return sorted
+}
+
+// collectionBodiesArgs returns the collection bodies as array arguments.
+func collectionBodiesArgs(bodies []collectionBody) pgh.Args {
+	return pgh.Args{
+		lo.Map(bodies, func(b collectionBody, _ int) int64 { return int64(b.CollectionID) }),
+		lo.Map(bodies, func(b collectionBody, _ int) []byte { return b.BodyHash }),
+	}
+}
+
+// storeBodies stores bodies of the requests once per content hash.
+// Bodies stored in the object storage are saved as references.
+// last_seen_at of existing bodies is refreshed, so they are not removed by the cleaner while being referenced.
+func (s *Service) storeBodies(
+	ctx context.Context, requests []entity.RequestContent, toStore []entity.MatchResult, hashes map[int][]byte,
+) error {
+	if len(toStore) == 0 {
+		return nil
+	}
+
+	// the first request with the body is used
+	bodies := make(map[string]entity.RequestContent, len(toStore))
+	for _, match := range toStore {
+		hash := string(hashes[match.RequestPos])
+		if _, ok := bodies[hash]; !ok {
+			bodies[hash] = requests[match.RequestPos]
+		}
+	}
+
+	// sorted to avoid deadlocks
+	sortedHashes := lo.Keys(bodies)
+	slices.Sort(sortedHashes)
+
+	var (
+		hashArg      = make([][]byte, len(sortedHashes))
+		bodyArg      = make([]*string, len(sortedHashes))
+		objectKeyArg = make([]*string, len(sortedHashes))
+	)
+	for i, hash := range sortedHashes {
+		req := bodies[hash]
+		hashArg[i] = []byte(hash)
+		if ref, ok := req.BodyRef.Get(); ok {
+			objectKeyArg[i] = lo.ToPtr(string(ref))
+		} else {
+			bodyArg[i] = lo.ToPtr(string(req.Body))
+		}
+	}
+
+	if _, err := px.ExecPlain(ctx, s.conn(ctx),
+		`INSERT INTO request_bodies (hash, body, object_key)
+		SELECT b.hash, b.body::jsonb, b.object_key
+		FROM unnest($1::bytea[], $2::text[], $3::text[]) AS b(hash, body, object_key)
+		ON CONFLICT (hash) DO UPDATE SET last_seen_at = NOW()
+		WHERE request_bodies.last_seen_at < NOW() - $4::interval`,
+		pgh.Args{hashArg, bodyArg, objectKeyArg, sqlrepo.BodyRefreshInterval}); err != nil {
+		return fmt.Errorf("Store: failed to store request bodies: %w", err)
+	}
+
+	return nil
+}
+
+// releaseBodyRefs schedules deletion of bodies stored in the object storage that are not referenced
+// by stored bodies, because the body is stored already or the request is not linked to any collection.
+func (s *Service) releaseBodyRefs(
+	ctx context.Context, requests []entity.RequestContent, matched []entity.MatchResult,
+) error {
+	refs := lo.Uniq(lo.FilterMap(matched, func(match entity.MatchResult, _ int) (string, bool) {
+		ref, ok := requests[match.RequestPos].BodyRef.Get()
+		return string(ref), ok
+	}))
+	if len(refs) == 0 {
+		return nil
+	}
+
+	var used []string
+	if err := px.SelectPlain(ctx, s.conn(ctx),
+		`SELECT object_key FROM request_bodies WHERE object_key = ANY($1::text[])`,
+		&used, pgh.Args{refs}); err != nil {
+		return fmt.Errorf("Store: failed to get used body objects: %w", err)
+	}
+
+	unused, _ := lo.Difference(refs, used)
+	if err := sqlrepo.ReleaseBodyObjects(ctx, s.conn(ctx),
+		lo.Map(unused, func(key string, _ int) entity.BodyRef { return entity.BodyRef(key) })); err != nil {
+		return fmt.Errorf("Store: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimBodies returns hashes of the bodies that must be kept in the database: bodies that are already stored
+// and bodies whose objects are being deleted by the cleaner. Implements reqprocessor.IRequestStorer.
+// Objects of the other bodies are removed from the released objects, so they are not deleted after being uploaded.
+func (s *Service) ClaimBodies(ctx context.Context, hashes [][]byte) (map[string]struct{}, error) {
+	var stored [][]byte
+	if err := px.SelectPlain(ctx, s.conn(ctx),
+		`SELECT hash FROM request_bodies WHERE hash = ANY($1::bytea[])`,
+		&stored, pgh.Args{hashes}); err != nil {
+		return nil, fmt.Errorf("failed to get stored bodies: %w", err)
+	}
+
+	kept := lo.Keyify(lo.Map(stored, func(hash []byte, _ int) string { return string(hash) }))
+
+	hashByRef := make(map[string][]byte)
+	for _, hash := range hashes {
+		if _, ok := kept[string(hash)]; !ok {
+			hashByRef[string(entity.NewBodyRef(hash))] = hash
+		}
+	}
+	if len(hashByRef) == 0 {
+		return kept, nil
+	}
+
+	// objects being deleted are not claimed, the cleaner doesn't wait for the object storage holding row locks
+	if _, err := px.ExecPlain(ctx, s.conn(ctx),
+		`DELETE FROM released_body_objects WHERE object_key = ANY($1::text[]) AND deleting_at IS NULL`,
+		pgh.Args{lo.Keys(hashByRef)}); err != nil {
+		return nil, fmt.Errorf("failed to claim released bodies: %w", err)
+	}
+
+	var deleting []string
+	if err := px.SelectPlain(ctx, s.conn(ctx),
+		`SELECT object_key FROM released_body_objects WHERE object_key = ANY($1::text[])`,
+		&deleting, pgh.Args{lo.Keys(hashByRef)}); err != nil {
+		return nil, fmt.Errorf("failed to get deleting bodies: %w", err)
+	}
+
+	for _, ref := range deleting {
+		kept[string(hashByRef[ref])] = struct{}{}
+	}
+
+	return kept, nil
+}
+
+// ReleaseBodies schedules deletion of uploaded bodies that are not used by stored requests.
+// Implements reqprocessor.IRequestStorer.
+func (s *Service) ReleaseBodies(ctx context.Context, refs []entity.BodyRef) error {
+	return sqlrepo.ReleaseBodyObjects(ctx, s.conn(ctx), refs)
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand/v2"
 	"slices"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/n-r-w/collector/internal/entity"
 	"github.com/n-r-w/pgh/v2"
 	"github.com/n-r-w/pgh/v2/px"
@@ -25,6 +27,56 @@ func (s *Service) storeHelper(
 ) error {
 	conn := s.conn(ctx)
 
+	// bodies are stored by content hash
+	hashes := make(map[int][]byte, len(toStore))
+	for _, match := range toStore {
+		hashes[match.RequestPos] = entity.HashBody(requests[match.RequestPos].Body)
+	}
+
+	// matched requests before applying distinct bodies and limits
+	matched := toStore
+
+	// Sort collection IDs to avoid deadlocks
+	sortedCollectionIDs := lo.Uniq(lo.FlatMap(toStore, func(match entity.MatchResult, _ int) []entity.CollectionID {
+		return match.CollectionIDs
+	}))
+	slices.Sort(sortedCollectionIDs)
+
+	// collections are locked in advance, because body, rule, stratum and reservoir counters are updated
+	// before the collection counters
+	collecting, err := s.lockCollections(ctx, sortedCollectionIDs)
+	if err != nil {
+		return err
+	}
+
+	// collections with distinct bodies are not linked with bodies they already have
+	toStore, duplicateByCol, err := s.removeDuplicates(ctx, toStore, hashes, collecting)
+	if err != nil {
+		return err
+	}
+
+	// collections with a byte size limit don't take requests after reaching it
+	toStore = limitBytes(toStore, requests, collecting)
+
+	// requests of multi-rule collections are counted by the rule with remaining quota
+	if toStore, err = s.assignRules(ctx, toStore, collecting); err != nil {
+		return err
+	}
+
+	// stratified collections are limited per value of the stratification key
+	if toStore, err = s.stratify(ctx, toStore, collecting); err != nil {
+		return err
+	}
+
+	// reservoir collections replace collected requests instead of being limited
+	reservoirs := lo.PickBy(collecting, func(_ entity.CollectionID, c lockedCollection) bool { return c.Reservoir })
+	toStore, reservoirUpdate := offerToReservoirs(toStore, reservoirs, rand.IntN) //nolint:gosec // sampling
+	reservoirIDs := lo.Keys(reservoirs)
+	slices.Sort(reservoirIDs)
+	if err := s.updateReservoirs(ctx, reservoirIDs, reservoirUpdate, duplicateByCol); err != nil {
+		return err
+	}
+
 	// calculate collection updates counters
 	requestByCol := make(map[entity.CollectionID]int)
 	for _, match := range toStore {
@@ -33,70 +85,199 @@ func (s *Service) storeHelper(
 		}
 	}
 
-	// Sort collection IDs to avoid deadlocks
-	sortedCollectionIDs := lo.MapToSlice(requestByCol, func(k entity.CollectionID, _ int) entity.CollectionID { return k })
-	slices.Sort(sortedCollectionIDs)
-
-	// Build and execute collection updates
+	// Build collection updates. Each update returns the number of requests that can be linked to the collection
+	// without exceeding its limit. Collections that are not collecting anymore are not updated.
 	updateQueries := make([]sq.Sqlizer, 0, len(requestByCol))
 	for _, collectionID := range sortedCollectionIDs {
+		if _, ok := reservoirs[collectionID]; ok {
+			continue
+		}
+
 		count := requestByCol[collectionID]
+		duplicates := duplicateByCol[collectionID]
+		current := sq.Select("id", "request_count", "request_count_limit").
+			From("collections").
+			Where(sq.Eq{"id": collectionID}).
+			Where(sq.Eq{"status": entity.CollectingCollectionStatuses()}).
+			Suffix("FOR UPDATE")
+
 		updateQueries = append(updateQueries,
 			pgh.Builder().Update("collections").
-				Set("request_count", sq.Expr("LEAST(request_count + ?, request_count_limit)", count)).
-				Set("status", sq.Expr("CASE WHEN request_count + ? >= request_count_limit THEN ? ELSE status END",
+				Set("request_count", sq.Expr(
+					"LEAST(collections.request_count + ?, collections.request_count_limit)", count)).
+				Set("status", sq.Expr(
+					"CASE WHEN collections.request_count + ? >= collections.request_count_limit "+
+						"THEN ? ELSE collections.status END",
 					count, entity.StatusFinalizing)).
-				Set("started_at", sq.Expr("COALESCE(started_at, NOW())")).
+				Set("duplicate_count", sq.Expr("collections.duplicate_count + ?", duplicates)).
+				Set("started_at", sq.Expr("COALESCE(collections.started_at, NOW())")).
+				// pauses before the first request don't freeze the time limit counted from it
+				Set("frozen_duration", sq.Expr(
+					"CASE WHEN collections.started_at IS NULL AND collections.time_limit_from_first_request "+
+						"THEN '0'::interval ELSE collections.frozen_duration END")).
+				Set("last_request_at", sq.Expr("NOW()")).
 				Set("updated_at", sq.Expr("NOW()")).
-				Where(sq.And{
-					sq.Eq{"id": collectionID},
-					sq.Eq{"status": entity.CollectingCollectionStatuses()},
-				}))
+				FromSelect(current, "c").
+				Where("collections.id = c.id").
+				Suffix("RETURNING c.id, GREATEST(LEAST(?, c.request_count_limit - c.request_count), 0) AS granted", count))
 	}
 
 	// Execute batch update for collection counters
-	if _, err := px.ExecBatch(ctx, updateQueries, conn); err != nil {
-		return fmt.Errorf("Store: failed to batch update collection counters: %w", err)
+	var granted []grantedRequests
+	if len(updateQueries) > 0 {
+		if err := px.SelectBatch(ctx, updateQueries, conn, &granted); err != nil {
+			return fmt.Errorf("Store: failed to batch update collection counters: %w", err)
+		}
+	}
+
+	for _, id := range reservoirIDs {
+		granted = append(granted, grantedRequests{ID: id, Granted: requestByCol[id]})
+	}
+
+	toStore = limitMatches(toStore, granted)
+
+	if err := s.updateByteCounts(ctx, countBytes(toStore, requests, reservoirs)); err != nil {
+		return err
+	}
+
+	// only bodies of the stored links are recorded as collected
+	if err := s.recordBodies(ctx, toStore, hashes, collecting); err != nil {
+		return err
 	}
 
-	// Prepare batch insert for requests
-	requestQueries := make([]sq.Sqlizer, len(requests))
-	for i, req := range requests {
+	if err := s.storeBodies(ctx, requests, toStore, hashes); err != nil {
+		return err
+	}
+
+	// bodies stored in the object storage, but not referenced by the database, are deleted by the cleaner
+	if err := s.releaseBodyRefs(ctx, requests, matched); err != nil {
+		return err
+	}
+
+	if len(toStore) == 0 {
+		return nil
+	}
+
+	// Only matched requests are stored
+	rows := make([][]any, len(toStore))
+	for i, match := range toStore {
+		req := requests[match.RequestPos]
+
 		// Convert headers to JSONB
 		headersJSON, err := json.Marshal(req.Headers)
 		if err != nil {
 			return fmt.Errorf("Store: failed to marshal headers: %w", err)
 		}
 
-		// Prepare request insert query
-		requestQueries[i] = pgh.Builder().Insert("requests").
-			Columns("handler", "headers", "body", "created_at").
-			Values(req.Handler, headersJSON, req.Body, req.CreatedAt).
-			Suffix("RETURNING id")
+		rows[i] = []any{nil, req.Handler, headersJSON, hashes[match.RequestPos], req.CreatedAt}
 	}
-	// Execute batch insert and get request IDs
-	var requestIDs []string
-	if err := px.SelectBatch(ctx, requestQueries, conn, &requestIDs); err != nil {
-		return fmt.Errorf("Store: failed to batch insert requests: %w", err)
+
+	// Request IDs are reserved in advance, so requests and links can be written with COPY
+	var requestIDs []int64
+	if err := px.SelectPlain(ctx, conn,
+		`SELECT nextval('requests_id_seq') FROM generate_series(1, $1)`,
+		&requestIDs, pgh.Args{len(rows)}); err != nil {
+		return fmt.Errorf("Store: failed to reserve request IDs: %w", err)
 	}
 
-	// Prepare batch inserts for request-collection links and collection updates
-	var linkQueries []sq.Sqlizer
-	for _, match := range toStore {
-		requestID := requestIDs[match.RequestPos]
+	var links [][]any
+	for i, match := range toStore {
+		rows[i][0] = requestIDs[i]
+
+		// links are partitioned by the request creation time
+		createdAt := requests[match.RequestPos].CreatedAt
 		for _, collectionID := range match.CollectionIDs {
-			// Prepare request-collection link query
-			linkQueries = append(linkQueries, pgh.Builder().Insert("request_collections").
-				Columns("request_id", "collection_id").
-				Values(requestID, collectionID))
+			var ruleID, slot *int
+			if ruleIDs, ok := match.RuleIDs[collectionID]; ok {
+				ruleID = &ruleIDs[0]
+			}
+			link := reservoirLink{requestPos: match.RequestPos, collectionID: collectionID}
+			if pos, ok := reservoirUpdate.slots[link]; ok {
+				slot = &pos
+			}
+			links = append(links, []any{requestIDs[i], collectionID, createdAt, ruleID, slot})
 		}
 	}
-	// Execute batch insert for request-collection links
-	if len(linkQueries) > 0 {
-		if _, err := px.ExecBatch(ctx, linkQueries, conn); err != nil {
-			return fmt.Errorf("Store: failed to batch insert request-collection links: %w", err)
-		}
+
+	if _, err := conn.CopyFrom(ctx, pgx.Identifier{"requests"},
+		[]string{"id", "handler", "headers", "body_hash", "created_at"}, pgx.CopyFromRows(rows)); err != nil {
+		return fmt.Errorf("Store: failed to copy requests: %w", err)
+	}
+
+	if _, err := conn.CopyFrom(ctx, pgx.Identifier{"request_collections"},
+		[]string{"request_id", "collection_id", "created_at", "rule_id", "slot"}, pgx.CopyFromRows(links)); err != nil {
+		return fmt.Errorf("Store: failed to copy request-collection links: %w", err)
 	}
 
 	return nil
 }
+
+// lockedCollection is a collecting collection locked by the batch.
+type lockedCollection struct {
+	ID                entity.CollectionID `db:"id"`
+	Reservoir         bool                `db:"reservoir"`
+	DistinctBodies    bool                `db:"distinct_bodies"`
+	SeenCount         int                 `db:"seen_count"`
+	RequestCount      int                 `db:"request_count"`
+	RequestCountLimit int                 `db:"request_count_limit"`
+	MaxPerValue       int                 `db:"max_per_value"`
+	MinDistinctValues int                 `db:"min_distinct_values"`
+	ByteSizeLimit     int64               `db:"byte_size_limit"`
+	ByteCount         int64               `db:"byte_count"`
+}
+
+// lockCollections locks collections of the batch in the order of IDs and returns the collecting ones.
+func (s *Service) lockCollections(
+	ctx context.Context, sortedCollectionIDs []entity.CollectionID,
+) (map[entity.CollectionID]lockedCollection, error) {
+	var locked []lockedCollection
+	sql := pgh.Builder().Select("id", "reservoir", "distinct_bodies", "seen_count", "request_count", "request_count_limit",
+		"max_per_value", "min_distinct_values", "byte_size_limit", "byte_count").From("collections").
+		Where(sq.Eq{"id": sortedCollectionIDs}).
+		Where(sq.Eq{"status": entity.CollectingCollectionStatuses()}).
+		OrderBy("id").
+		Suffix("FOR UPDATE")
+	if err := px.Select(ctx, s.conn(ctx), sql, &locked); err != nil {
+		return nil, fmt.Errorf("Store: failed to lock collections: %w", err)
+	}
+
+	return lo.KeyBy(locked, func(c lockedCollection) entity.CollectionID { return c.ID }), nil
+}
+
+// grantedRequests is the number of requests that can be linked to the collection.
+type grantedRequests struct {
+	ID      entity.CollectionID `db:"id"`
+	Granted int                 `db:"granted"`
+}
+
+// limitMatches removes links to collections that exceed the granted number of requests.
+// Requests are linked in the order of arrival. Requests without links are removed.
+func limitMatches(toStore []entity.MatchResult, granted []grantedRequests) []entity.MatchResult {
+	remaining := make(map[entity.CollectionID]int, len(granted))
+	for _, g := range granted {
+		remaining[g.ID] = g.Granted
+	}
+
+	limited := make([]entity.MatchResult, 0, len(toStore))
+	for _, match := range toStore {
+		collectionIDs := lo.Filter(match.CollectionIDs, func(id entity.CollectionID, _ int) bool {
+			if remaining[id] <= 0 {
+				return false
+			}
+			remaining[id]--
+
+			return true
+		})
+
+		if len(collectionIDs) > 0 {
+			limited = append(limited, entity.MatchResult{
+				RequestPos:    match.RequestPos,
+				CollectionIDs: collectionIDs,
+				RuleIDs:       match.RuleIDs,
+				Strata:        match.Strata,
+			})
+		}
+	}
+
+	return limited
+}
@@ -2,6 +2,9 @@ package reqprocessor
 
 import (
 	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
 	"testing"
 	"time"
 
@@ -14,6 +17,9 @@ import (
 	"github.com/n-r-w/pgh/v2/px"
 	"github.com/n-r-w/pgh/v2/px/db"
 	"github.com/n-r-w/pgh/v2/txmgr"
+	sq "github.com/n-r-w/squirrel"
+	"github.com/samber/lo"
+	"github.com/samber/mo"
 	"github.com/stretchr/testify/require"
 )
 
@@ -81,25 +87,31 @@ func TestStore(t *testing.T) {
 	// Verify stored requests
 	var storedRequests []dbmodel.Request
 	err = px.Select(ctx, s.conn(ctx),
-		pgh.Builder().Select("id", "handler", "headers", "body", "created_at").
+		pgh.Builder().Select("id", "handler", "headers", "body_hash", "created_at").
 			From("requests").
 			OrderBy("id"), &storedRequests)
 	require.NoError(t, err)
 	require.Len(t, storedRequests, 2)
 
+	getBody := func(hash []byte) string {
+		body, err := dbmodel.RequestBodyByHash(ctx, s.conn(ctx), hash)
+		require.NoError(t, err)
+		return string(body.Body)
+	}
+
 	// Verify first request
 	headersJSON, err := json.Marshal(headers)
 	require.NoError(t, err)
 
 	require.Equal(t, "test-handler-1", storedRequests[0].Handler)
 	require.JSONEq(t, string(headersJSON), string(storedRequests[0].Headers))
-	require.JSONEq(t, `{"test": "data1"}`, string(storedRequests[0].Body))
+	require.JSONEq(t, `{"test": "data1"}`, getBody(storedRequests[0].BodyHash))
 	require.WithinDuration(t, now, storedRequests[0].CreatedAt, time.Second)
 
 	// Verify second request
 	require.Equal(t, "test-handler-2", storedRequests[1].Handler)
 	require.JSONEq(t, string(headersJSON), string(storedRequests[1].Headers))
-	require.JSONEq(t, `{"test": "data2"}`, string(storedRequests[1].Body))
+	require.JSONEq(t, `{"test": "data2"}`, getBody(storedRequests[1].BodyHash))
 	require.WithinDuration(t, now, storedRequests[1].CreatedAt, time.Second)
 
 	// Verify request-collection links
@@ -131,3 +143,720 @@ func TestStore(t *testing.T) {
 	require.True(t, collection2.StartedAt.Valid)
 	require.True(t, collection2.UpdatedAt.Valid)
 }
+
+func TestStoreLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, txmgr *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db, txmgr)
+		},
+	)
+
+	task := entity.Task{
+		MessageSelection: entity.MessageSelectionCriteria{
+			Handler: "test-handler",
+		},
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 3,
+		},
+	}
+
+	limitedID := sql.CreateTestCollection(t, ctx, s.conn, task)
+	task.Completion.RequestCountLimit = 100
+	otherID := sql.CreateTestCollection(t, ctx, s.conn, task)
+
+	newBatch := func(size int, collectionIDs ...entity.CollectionID) ([]entity.RequestContent, []entity.MatchResult) {
+		requests := make([]entity.RequestContent, size)
+		toStore := make([]entity.MatchResult, size)
+		for i := range size {
+			requests[i] = entity.RequestContent{
+				Handler:   "test-handler",
+				Headers:   map[string][]string{},
+				Body:      []byte(`{}`),
+				CreatedAt: time.Now(),
+			}
+			toStore[i] = entity.MatchResult{RequestPos: i, CollectionIDs: collectionIDs}
+		}
+		return requests, toStore
+	}
+
+	countLinks := func(collectionID entity.CollectionID) int {
+		var count int
+		require.NoError(t, px.SelectOne(ctx, s.conn(ctx),
+			pgh.Builder().Select("COUNT(*)").From("request_collections").
+				Where(sq.Eq{"collection_id": collectionID}),
+			&count))
+		return count
+	}
+
+	countRequests := func() int {
+		var count int
+		require.NoError(t, px.SelectOne(ctx, s.conn(ctx), pgh.Builder().Select("COUNT(*)").From("requests"), &count))
+		return count
+	}
+
+	// 2 of 3 requests fit into the limit
+	requests, toStore := newBatch(2, limitedID)
+	require.NoError(t, s.Store(ctx, requests, toStore))
+	require.Equal(t, 2, countLinks(limitedID))
+
+	// only the first request is linked to the limited collection
+	requests, toStore = newBatch(3, limitedID, otherID)
+	require.NoError(t, s.Store(ctx, requests, toStore))
+	require.Equal(t, 3, countLinks(limitedID))
+	require.Equal(t, 3, countLinks(otherID))
+	require.Equal(t, 5, countRequests())
+
+	collection, err := dbmodel.CollectionByID(ctx, s.conn(ctx), int64(limitedID))
+	require.NoError(t, err)
+	require.EqualValues(t, 3, collection.RequestCount)
+	require.EqualValues(t, entity.StatusFinalizing, collection.Status)
+
+	// collection is not collecting anymore, requests are not stored
+	requests, toStore = newBatch(2, limitedID)
+	require.NoError(t, s.Store(ctx, requests, toStore))
+	require.Equal(t, 3, countLinks(limitedID))
+	require.Equal(t, 5, countRequests())
+}
+
+func TestStoreRules(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, txmgr *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db, txmgr)
+		},
+	)
+
+	task := entity.Task{
+		Rules: []entity.SelectionRule{
+			{Name: "search", Selection: entity.MessageSelectionCriteria{Handler: "search"}, RequestCountLimit: 2},
+			{Name: "other", Selection: entity.MessageSelectionCriteria{Handler: "other"}, RequestCountLimit: 1},
+		},
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 3,
+		},
+	}
+	collectionID := sql.CreateTestCollection(t, ctx, s.conn, task)
+
+	newBatch := func(ruleIDs ...[]int) ([]entity.RequestContent, []entity.MatchResult) {
+		requests := make([]entity.RequestContent, len(ruleIDs))
+		toStore := make([]entity.MatchResult, len(ruleIDs))
+		for i, ids := range ruleIDs {
+			requests[i] = entity.RequestContent{
+				Handler:   "test-handler",
+				Headers:   map[string][]string{},
+				Body:      []byte(`{}`),
+				CreatedAt: time.Now(),
+			}
+			toStore[i] = entity.MatchResult{
+				RequestPos:    i,
+				CollectionIDs: []entity.CollectionID{collectionID},
+				RuleIDs:       map[entity.CollectionID][]int{collectionID: ids},
+			}
+		}
+		return requests, toStore
+	}
+
+	countLinks := func(ruleID int) int {
+		var count int
+		require.NoError(t, px.SelectOne(ctx, s.conn(ctx),
+			pgh.Builder().Select("COUNT(*)").From("request_collections").
+				Where(sq.Eq{"collection_id": collectionID, "rule_id": ruleID}),
+			&count))
+		return count
+	}
+
+	// the third request matches both rules, but the first one has no quota left
+	requests, toStore := newBatch([]int{0}, []int{0}, []int{0, 1}, []int{0})
+	require.NoError(t, s.Store(ctx, requests, toStore))
+	require.Equal(t, 2, countLinks(0))
+	require.Equal(t, 1, countLinks(1))
+
+	rule, err := dbmodel.CollectionRuleByCollectionIDRuleID(ctx, s.conn(ctx), int64(collectionID), 1)
+	require.NoError(t, err)
+	require.Equal(t, 1, rule.RequestCount)
+
+	// all quotas are met
+	collection, err := dbmodel.CollectionByID(ctx, s.conn(ctx), int64(collectionID))
+	require.NoError(t, err)
+	require.EqualValues(t, 3, collection.RequestCount)
+	require.EqualValues(t, entity.StatusFinalizing, collection.Status)
+}
+
+func TestStoreDistinctBodies(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, txmgr *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db, txmgr)
+		},
+	)
+
+	task := entity.Task{
+		MessageSelection: entity.MessageSelectionCriteria{
+			Handler: "test-handler",
+		},
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 100,
+		},
+	}
+
+	allID := sql.CreateTestCollection(t, ctx, s.conn, task)
+	task.MessageSelection.DistinctBodies = true
+	distinctID := sql.CreateTestCollection(t, ctx, s.conn, task)
+
+	newBatch := func(bodies ...string) ([]entity.RequestContent, []entity.MatchResult) {
+		requests := make([]entity.RequestContent, len(bodies))
+		toStore := make([]entity.MatchResult, len(bodies))
+		for i, body := range bodies {
+			requests[i] = entity.RequestContent{
+				Handler:   "test-handler",
+				Headers:   map[string][]string{},
+				Body:      []byte(body),
+				CreatedAt: time.Now(),
+			}
+			toStore[i] = entity.MatchResult{RequestPos: i, CollectionIDs: []entity.CollectionID{allID, distinctID}}
+		}
+		return requests, toStore
+	}
+
+	countLinks := func(collectionID entity.CollectionID) int {
+		var count int
+		require.NoError(t, px.SelectOne(ctx, s.conn(ctx),
+			pgh.Builder().Select("COUNT(*)").From("request_collections").
+				Where(sq.Eq{"collection_id": collectionID}),
+			&count))
+		return count
+	}
+
+	countBodies := func() int {
+		var count int
+		require.NoError(t, px.SelectOne(ctx, s.conn(ctx),
+			pgh.Builder().Select("COUNT(*)").From("request_bodies"), &count))
+		return count
+	}
+
+	// duplicates in the batch
+	requests, toStore := newBatch(`{"a": 1}`, `{"b": 2}`, `{"a": 1}`)
+	require.NoError(t, s.Store(ctx, requests, toStore))
+	require.Equal(t, 3, countLinks(allID))
+	require.Equal(t, 2, countLinks(distinctID))
+	require.Equal(t, 2, countBodies())
+
+	// duplicates of previous batches
+	requests, toStore = newBatch(`{"b": 2}`, `{"c": 3}`)
+	require.NoError(t, s.Store(ctx, requests, toStore))
+	require.Equal(t, 5, countLinks(allID))
+	require.Equal(t, 3, countLinks(distinctID))
+	require.Equal(t, 3, countBodies())
+
+	// only duplicates
+	requests, toStore = newBatch(`{"c": 3}`)
+	require.NoError(t, s.Store(ctx, requests, toStore))
+	require.Equal(t, 6, countLinks(allID))
+	require.Equal(t, 3, countLinks(distinctID))
+
+	all, err := dbmodel.CollectionByID(ctx, s.conn(ctx), int64(allID))
+	require.NoError(t, err)
+	require.Equal(t, 6, all.RequestCount)
+	require.Zero(t, all.DuplicateCount)
+
+	distinct, err := dbmodel.CollectionByID(ctx, s.conn(ctx), int64(distinctID))
+	require.NoError(t, err)
+	require.Equal(t, 3, distinct.RequestCount)
+	require.Equal(t, 3, distinct.DuplicateCount)
+}
+
+func TestStoreDistinctBodiesNotLinked(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, txmgr *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db, txmgr)
+		},
+	)
+
+	task := entity.Task{
+		MessageSelection: entity.MessageSelectionCriteria{
+			Handler:        "test-handler",
+			DistinctBodies: true,
+		},
+		Stratification: mo.Some(entity.Stratification{HeaderName: "X-User", MaxPerValue: 1}),
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 10,
+		},
+	}
+	collectionID := sql.CreateTestCollection(t, ctx, s.conn, task)
+
+	store := func(body, user string) {
+		requests := []entity.RequestContent{{
+			Handler:   "test-handler",
+			Headers:   map[string][]string{"X-User": {user}},
+			Body:      []byte(body),
+			CreatedAt: time.Now(),
+		}}
+		toStore := []entity.MatchResult{{
+			RequestPos:    0,
+			CollectionIDs: []entity.CollectionID{collectionID},
+			Strata:        map[entity.CollectionID]string{collectionID: user},
+		}}
+		require.NoError(t, s.Store(ctx, requests, toStore))
+	}
+
+	// the second body is not linked, because the value is exhausted, so it is not collected
+	store(`{"a": 1}`, "a")
+	store(`{"b": 2}`, "a")
+	store(`{"b": 2}`, "b")
+
+	var hashes [][]byte
+	require.NoError(t, px.Select(ctx, s.conn(ctx),
+		pgh.Builder().Select("body_hash").From("collection_bodies").
+			Where(sq.Eq{"collection_id": collectionID}),
+		&hashes))
+	require.ElementsMatch(t, [][]byte{
+		entity.HashBody([]byte(`{"a": 1}`)), entity.HashBody([]byte(`{"b": 2}`)),
+	}, hashes)
+
+	collection, err := dbmodel.CollectionByID(ctx, s.conn(ctx), int64(collectionID))
+	require.NoError(t, err)
+	require.Equal(t, 2, collection.RequestCount)
+	require.Zero(t, collection.DuplicateCount)
+}
+
+func TestStoreBodyRefs(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, txmgr *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db, txmgr)
+		},
+	)
+
+	collectionID := sql.CreateTestCollection(t, ctx, s.conn, entity.Task{
+		MessageSelection: entity.MessageSelectionCriteria{
+			Handler: "test-handler",
+		},
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 100,
+		},
+	})
+
+	newRequest := func(body string, ref entity.BodyRef) entity.RequestContent {
+		return entity.RequestContent{
+			Handler:   "test-handler",
+			Headers:   map[string][]string{},
+			Body:      []byte(body),
+			BodyRef:   mo.Some(ref),
+			CreatedAt: time.Now(),
+		}
+	}
+
+	getReleased := func() []string {
+		var keys []string
+		require.NoError(t, px.Select(ctx, s.conn(ctx),
+			pgh.Builder().Select("object_key").From("released_body_objects").OrderBy("object_key"),
+			&keys))
+		return keys
+	}
+
+	refA := entity.NewBodyRef(entity.HashBody([]byte(`{"a": 1}`)))
+	refB := entity.NewBodyRef(entity.HashBody([]byte(`{"b": 2}`)))
+
+	// the same body is uploaded once for the batch
+	requests := []entity.RequestContent{
+		newRequest(`{"a": 1}`, refA),
+		newRequest(`{"a": 1}`, refA),
+	}
+	toStore := []entity.MatchResult{
+		{RequestPos: 0, CollectionIDs: []entity.CollectionID{collectionID}},
+		{RequestPos: 1, CollectionIDs: []entity.CollectionID{collectionID}},
+	}
+	require.NoError(t, s.Store(ctx, requests, toStore))
+
+	body, err := dbmodel.RequestBodyByHash(ctx, s.conn(ctx), entity.HashBody([]byte(`{"a": 1}`)))
+	require.NoError(t, err)
+	require.Equal(t, string(refA), body.ObjectKey.String)
+	require.Nil(t, body.Body)
+	require.Empty(t, getReleased())
+
+	// the body is already stored with the same object, which is still used
+	requests = []entity.RequestContent{newRequest(`{"a": 1}`, refA)}
+	toStore = []entity.MatchResult{{RequestPos: 0, CollectionIDs: []entity.CollectionID{collectionID}}}
+	require.NoError(t, s.Store(ctx, requests, toStore))
+	require.Empty(t, getReleased())
+
+	// the request is not linked to any collection
+	requests = []entity.RequestContent{newRequest(`{"b": 2}`, refB)}
+	toStore = []entity.MatchResult{{RequestPos: 0, CollectionIDs: []entity.CollectionID{collectionID + 1000}}}
+	require.NoError(t, s.Store(ctx, requests, toStore))
+	require.Equal(t, []string{string(refB)}, getReleased())
+
+	// claimed bodies are not released anymore, stored bodies are not uploaded again
+	stored, err := s.ClaimBodies(ctx, [][]byte{entity.HashBody([]byte(`{"a": 1}`)), entity.HashBody([]byte(`{"b": 2}`))})
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{string(entity.HashBody([]byte(`{"a": 1}`))): {}}, stored)
+	require.Empty(t, getReleased())
+
+	// objects being deleted by the cleaner are not claimed, their bodies are kept in the database
+	hashC := entity.HashBody([]byte(`{"c": 3}`))
+	_, err = px.Exec(ctx, s.conn(ctx),
+		pgh.Builder().Insert("released_body_objects").Columns("object_key", "deleting_at").
+			Values(string(entity.NewBodyRef(hashC)), time.Now()))
+	require.NoError(t, err)
+
+	stored, err = s.ClaimBodies(ctx, [][]byte{hashC})
+	require.NoError(t, err)
+	require.Equal(t, map[string]struct{}{string(hashC): {}}, stored)
+	require.Equal(t, []string{string(entity.NewBodyRef(hashC))}, getReleased())
+
+	_, err = px.Exec(ctx, s.conn(ctx), pgh.Builder().Delete("released_body_objects"))
+	require.NoError(t, err)
+
+	// bodies are released if storing failed
+	require.NoError(t, s.ReleaseBodies(ctx, []entity.BodyRef{refB}))
+	require.Equal(t, []string{string(refB)}, getReleased())
+}
+
+func TestLimitMatches(t *testing.T) {
+	t.Parallel()
+
+	toStore := []entity.MatchResult{
+		{RequestPos: 0, CollectionIDs: []entity.CollectionID{1, 2}},
+		{RequestPos: 2, CollectionIDs: []entity.CollectionID{1, 3}},
+		{RequestPos: 3, CollectionIDs: []entity.CollectionID{1}},
+	}
+	granted := []grantedRequests{
+		{ID: 1, Granted: 2},
+		{ID: 2, Granted: 5},
+	}
+
+	require.Equal(t, []entity.MatchResult{
+		{RequestPos: 0, CollectionIDs: []entity.CollectionID{1, 2}},
+		{RequestPos: 2, CollectionIDs: []entity.CollectionID{1}},
+	}, limitMatches(toStore, granted))
+}
+
+func TestOfferToReservoirs(t *testing.T) {
+	t.Parallel()
+
+	toStore := []entity.MatchResult{
+		{RequestPos: 0, CollectionIDs: []entity.CollectionID{1, 2}},
+		{RequestPos: 1, CollectionIDs: []entity.CollectionID{1}},
+		{RequestPos: 2, CollectionIDs: []entity.CollectionID{1}},
+		{RequestPos: 3, CollectionIDs: []entity.CollectionID{1}},
+	}
+	reservoirs := map[entity.CollectionID]lockedCollection{
+		1: {ID: 1, Reservoir: true, SeenCount: 1, RequestCountLimit: 2},
+	}
+
+	// the first request fills the reservoir, the second one replaces the stored request,
+	// the third one replaces the first one and the last one is not sampled
+	randoms := []int{0, 1, 3}
+	randN := func(int) int {
+		r := randoms[0]
+		randoms = randoms[1:]
+		return r
+	}
+
+	result, update := offerToReservoirs(toStore, reservoirs, randN)
+	require.Equal(t, []entity.MatchResult{
+		{RequestPos: 0, CollectionIDs: []entity.CollectionID{2}},
+		{RequestPos: 1, CollectionIDs: []entity.CollectionID{1}},
+		{RequestPos: 2, CollectionIDs: []entity.CollectionID{1}},
+	}, result)
+	require.Equal(t, map[reservoirLink]int{
+		{requestPos: 1, collectionID: 1}: 0,
+		{requestPos: 2, collectionID: 1}: 1,
+	}, update.slots)
+	require.Equal(t, map[entity.CollectionID][]int{1: {0}}, update.replaced)
+	require.Equal(t, map[entity.CollectionID]int{1: 4}, update.offered)
+}
+
+func TestStoreReservoir(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, txmgr *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db, txmgr)
+		},
+	)
+
+	task := entity.Task{
+		MessageSelection: entity.MessageSelectionCriteria{
+			Handler: "test-handler",
+		},
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 5,
+			Reservoir:         true,
+		},
+	}
+	collectionID := sql.CreateTestCollection(t, ctx, s.conn, task)
+
+	for batch := range 4 {
+		requests := make([]entity.RequestContent, 5)
+		toStore := make([]entity.MatchResult, 5)
+		for i := range requests {
+			requests[i] = entity.RequestContent{
+				Handler:   "test-handler",
+				Headers:   map[string][]string{},
+				Body:      []byte(fmt.Sprintf(`{"batch": %d, "request": %d}`, batch, i)),
+				CreatedAt: time.Now(),
+			}
+			toStore[i] = entity.MatchResult{RequestPos: i, CollectionIDs: []entity.CollectionID{collectionID}}
+		}
+		require.NoError(t, s.Store(ctx, requests, toStore))
+	}
+
+	// the reservoir keeps the limit, replaced requests are removed
+	var slots []int
+	require.NoError(t, px.Select(ctx, s.conn(ctx),
+		pgh.Builder().Select("slot").From("request_collections").
+			Where(sq.Eq{"collection_id": collectionID}).OrderBy("slot"),
+		&slots))
+	require.Equal(t, []int{0, 1, 2, 3, 4}, slots)
+
+	var requestCount int
+	require.NoError(t, px.SelectOne(ctx, s.conn(ctx), pgh.Builder().Select("COUNT(*)").From("requests"), &requestCount))
+	require.Equal(t, 5, requestCount)
+
+	collection, err := dbmodel.CollectionByID(ctx, s.conn(ctx), int64(collectionID))
+	require.NoError(t, err)
+	require.Equal(t, 5, collection.RequestCount)
+	require.Equal(t, 20, collection.SeenCount)
+	require.EqualValues(t, entity.StatusPending, collection.Status)
+}
+
+func TestApplyStrata(t *testing.T) {
+	t.Parallel()
+
+	stratified := map[entity.CollectionID]lockedCollection{
+		1: {ID: 1, RequestCountLimit: 5, MaxPerValue: 2, MinDistinctValues: 4},
+	}
+	counts := map[stratumKey]int{{collectionID: 1, value: "a"}: 1}
+	distinct := map[entity.CollectionID]int{1: 1}
+
+	values := []string{"a", "a", "b", "b", "c", "c", "d", "e"}
+	toStore := make([]entity.MatchResult, len(values))
+	for i, value := range values {
+		toStore[i] = entity.MatchResult{
+			RequestPos:    i,
+			CollectionIDs: []entity.CollectionID{1},
+			Strata:        map[entity.CollectionID]string{1: value},
+		}
+	}
+	// collections without stratification are not limited
+	toStore[1].CollectionIDs = []entity.CollectionID{1, 2}
+
+	result, assigned := applyStrata(toStore, stratified, counts, distinct)
+
+	// the second "a" exceeds the limit per value, the second "c" takes the space reserved for new values,
+	// "e" exceeds the request count limit
+	require.Equal(t, []int{0, 1, 2, 3, 4, 6}, lo.Map(result, func(m entity.MatchResult, _ int) int { return m.RequestPos }))
+	require.Equal(t, []entity.CollectionID{2}, result[1].CollectionIDs)
+	require.Equal(t, map[stratumKey]int{
+		{collectionID: 1, value: "a"}: 1,
+		{collectionID: 1, value: "b"}: 2,
+		{collectionID: 1, value: "c"}: 1,
+		{collectionID: 1, value: "d"}: 1,
+	}, assigned)
+}
+
+func TestStoreStratified(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, txmgr *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db, txmgr)
+		},
+	)
+
+	task := entity.Task{
+		MessageSelection: entity.MessageSelectionCriteria{
+			Handler: "test-handler",
+		},
+		Stratification: mo.Some(entity.Stratification{HeaderName: "X-User", MaxPerValue: 2}),
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 10,
+		},
+	}
+	collectionID := sql.CreateTestCollection(t, ctx, s.conn, task)
+
+	// values longer than the btree limit are stored by hash and truncated for display
+	long := strings.Repeat("x", 10000)
+
+	for _, users := range [][]string{{"a", "a", "a", "b", "b", "c"}, {"a", "c", "c", long, long}} {
+		requests := make([]entity.RequestContent, len(users))
+		toStore := make([]entity.MatchResult, len(users))
+		for i, user := range users {
+			requests[i] = entity.RequestContent{
+				Handler:   "test-handler",
+				Headers:   map[string][]string{"X-User": {user}},
+				Body:      []byte(fmt.Sprintf(`{"user": %q, "request": %d}`, user, i)),
+				CreatedAt: time.Now(),
+			}
+			toStore[i] = entity.MatchResult{
+				RequestPos:    i,
+				CollectionIDs: []entity.CollectionID{collectionID},
+				Strata:        map[entity.CollectionID]string{collectionID: user},
+			}
+		}
+		require.NoError(t, s.Store(ctx, requests, toStore))
+	}
+
+	var strata []stratumCount
+	require.NoError(t, px.Select(ctx, s.conn(ctx),
+		pgh.Builder().Select("collection_id", "value", "request_count AS count").From("collection_strata").
+			Where(sq.Eq{"collection_id": collectionID}).OrderBy("value"),
+		&strata))
+	require.Equal(t, []stratumCount{
+		{CollectionID: collectionID, Value: "a", Count: 2},
+		{CollectionID: collectionID, Value: "b", Count: 2},
+		{CollectionID: collectionID, Value: "c", Count: 2},
+		{CollectionID: collectionID, Value: long[:maxStratumValueLength], Count: 2},
+	}, strata)
+
+	stratum, err := dbmodel.CollectionStratumByCollectionIDValueHash(ctx, s.conn(ctx), int64(collectionID),
+		stratumKey{collectionID: collectionID, value: long}.hash())
+	require.NoError(t, err)
+	require.Equal(t, 2, stratum.RequestCount)
+
+	collection, err := dbmodel.CollectionByID(ctx, s.conn(ctx), int64(collectionID))
+	require.NoError(t, err)
+	require.Equal(t, 8, collection.RequestCount)
+}
+
+func TestLimitBytes(t *testing.T) {
+	t.Parallel()
+
+	collecting := map[entity.CollectionID]lockedCollection{
+		1: {ID: 1, ByteSizeLimit: 10, ByteCount: 2},
+		2: {ID: 2},
+	}
+
+	requests := make([]entity.RequestContent, 4)
+	toStore := make([]entity.MatchResult, len(requests))
+	for i := range requests {
+		requests[i] = entity.RequestContent{Body: []byte("12345")}
+		toStore[i] = entity.MatchResult{RequestPos: i, CollectionIDs: []entity.CollectionID{1}}
+	}
+	// collections without a byte size limit are not limited
+	toStore[3].CollectionIDs = []entity.CollectionID{1, 2}
+
+	result := limitBytes(toStore, requests, collecting)
+
+	// the second request reaches the limit and is still accepted
+	require.Equal(t, []int{0, 1, 3}, lo.Map(result, func(m entity.MatchResult, _ int) int { return m.RequestPos }))
+	require.Equal(t, []entity.CollectionID{2}, result[2].CollectionIDs)
+
+	require.Equal(t, map[entity.CollectionID]int64{1: 10, 2: 5},
+		countBytes(result, requests, map[entity.CollectionID]lockedCollection{}))
+	require.Equal(t, map[entity.CollectionID]int64{1: 10},
+		countBytes(result, requests, map[entity.CollectionID]lockedCollection{2: {ID: 2, Reservoir: true}}))
+}
+
+func TestStoreByteSizeLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx, s := sql.SetupTest(t,
+		func(cfg *config.Config, db *db.PxDB, txmgr *txmgr.TransactionManager) (*Service, error) {
+			cfg.App.EnvType = ctxlog.EnvDevelopment
+			return New(cfg, db, txmgr)
+		},
+	)
+
+	task := entity.Task{
+		MessageSelection: entity.MessageSelectionCriteria{
+			Handler: "test-handler",
+		},
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 10,
+			ByteSizeLimit:     25,
+		},
+	}
+	collectionID := sql.CreateTestCollection(t, ctx, s.conn, task)
+
+	requests := make([]entity.RequestContent, 4)
+	toStore := make([]entity.MatchResult, len(requests))
+	for i := range requests {
+		requests[i] = entity.RequestContent{
+			Handler:   "test-handler",
+			Body:      []byte(fmt.Sprintf(`{"request": %d}`, i)), // 14 bytes
+			CreatedAt: time.Now(),
+		}
+		toStore[i] = entity.MatchResult{RequestPos: i, CollectionIDs: []entity.CollectionID{collectionID}}
+	}
+	require.NoError(t, s.Store(ctx, requests, toStore))
+
+	collection, err := dbmodel.CollectionByID(ctx, s.conn(ctx), int64(collectionID))
+	require.NoError(t, err)
+	require.Equal(t, 2, collection.RequestCount)
+	require.Equal(t, int64(28), collection.ByteCount)
+	require.Equal(t, int(entity.StatusFinalizing), collection.Status)
+	require.True(t, collection.LastRequestAt.Valid)
+}
+
+func BenchmarkStore(b *testing.B) {
+	ctx, s := sql.SetupTest(b,
+		func(cfg *config.Config, db *db.PxDB, txmgr *txmgr.TransactionManager) (*Service, error) {
+			return New(cfg, db, txmgr)
+		},
+	)
+
+	task := entity.Task{
+		MessageSelection: entity.MessageSelectionCriteria{
+			Handler: "test-handler",
+		},
+		Completion: entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: math.MaxInt32,
+		},
+	}
+
+	collectionIDs := []entity.CollectionID{
+		sql.CreateTestCollection(b, ctx, s.conn, task),
+		sql.CreateTestCollection(b, ctx, s.conn, task),
+	}
+
+	for _, batchSize := range []int{1, 100, 1000} {
+		b.Run(fmt.Sprintf("batch_%d", batchSize), func(b *testing.B) {
+			requests := make([]entity.RequestContent, batchSize)
+			toStore := make([]entity.MatchResult, batchSize)
+			for i := range batchSize {
+				requests[i] = entity.RequestContent{
+					Handler:   "test-handler",
+					Headers:   map[string][]string{"Content-Type": {"application/json"}},
+					Body:      []byte(fmt.Sprintf(`{"test": "data%d", "items": [1, 2, 3]}`, i)),
+					CreatedAt: time.Now(),
+				}
+				toStore[i] = entity.MatchResult{
+					RequestPos:    i,
+					CollectionIDs: collectionIDs[:1+i%len(collectionIDs)],
+				}
+			}
+
+			b.ResetTimer()
+			for range b.N {
+				require.NoError(b, s.Store(ctx, requests, toStore))
+			}
+
+			b.ReportMetric(float64(b.N*batchSize)/b.Elapsed().Seconds(), "requests/s")
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package reqprocessor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/pgh/v2"
+	"github.com/n-r-w/pgh/v2/px"
+	sq "github.com/n-r-w/squirrel"
+	"github.com/samber/lo"
+)
+
+// reservoirLink is a link of a request of the batch to a reservoir collection.
+type reservoirLink struct {
+	requestPos   int
+	collectionID entity.CollectionID
+}
+
+// reservoirUpdate is the result of offering the batch to reservoir collections.
+type reservoirUpdate struct {
+	// slots are positions of the new links in the reservoirs
+	slots map[reservoirLink]int
+	// replaced are slots of the stored links replaced by the new ones
+	replaced map[entity.CollectionID][]int
+	// offered is the number of requests offered to the reservoirs
+	offered map[entity.CollectionID]int
+}
+
+// offerToReservoirs applies reservoir sampling (algorithm R) to links of reservoir collections.
+// The first request_count_limit requests fill the reservoir, the n-th request after that replaces
+// a random slot with probability request_count_limit/n. Requests are offered in the order of arrival.
+// Links of requests that are not sampled, or replaced by later requests of the batch, are removed.
+// randN returns a random number in [0, n).
+func offerToReservoirs(
+	toStore []entity.MatchResult, reservoirs map[entity.CollectionID]lockedCollection, randN func(n int) int,
+) ([]entity.MatchResult, reservoirUpdate) {
+	update := reservoirUpdate{
+		slots:    make(map[reservoirLink]int),
+		replaced: make(map[entity.CollectionID][]int),
+		offered:  make(map[entity.CollectionID]int),
+	}
+
+	if len(reservoirs) == 0 {
+		return toStore, update
+	}
+
+	// links of the batch by reservoir slots
+	owners := make(map[entity.CollectionID]map[int]reservoirLink, len(reservoirs))
+	for _, match := range toStore {
+		for _, collectionID := range match.CollectionIDs {
+			reservoir, ok := reservoirs[collectionID]
+			if !ok {
+				continue
+			}
+
+			update.offered[collectionID]++
+			seen := reservoir.SeenCount + update.offered[collectionID]
+
+			slot := seen - 1
+			if seen > reservoir.RequestCountLimit {
+				if slot = randN(seen); slot >= reservoir.RequestCountLimit {
+					continue
+				}
+			}
+
+			if owners[collectionID] == nil {
+				owners[collectionID] = make(map[int]reservoirLink)
+			}
+
+			if owner, ok := owners[collectionID][slot]; ok {
+				// the slot was taken by an earlier request of the batch
+				delete(update.slots, owner)
+			} else if slot < min(reservoir.SeenCount, reservoir.RequestCountLimit) {
+				update.replaced[collectionID] = append(update.replaced[collectionID], slot)
+			}
+
+			link := reservoirLink{requestPos: match.RequestPos, collectionID: collectionID}
+			owners[collectionID][slot] = link
+			update.slots[link] = slot
+		}
+	}
+
+	result := make([]entity.MatchResult, 0, len(toStore))
+	for _, match := range toStore {
+		collectionIDs := lo.Filter(match.CollectionIDs, func(id entity.CollectionID, _ int) bool {
+			if _, ok := reservoirs[id]; !ok {
+				return true
+			}
+
+			_, ok := update.slots[reservoirLink{requestPos: match.RequestPos, collectionID: id}]
+			return ok
+		})
+
+		if len(collectionIDs) > 0 {
+			result = append(result, entity.MatchResult{
+				RequestPos:    match.RequestPos,
+				CollectionIDs: collectionIDs,
+				RuleIDs:       match.RuleIDs,
+				Strata:        match.Strata,
+			})
+		}
+	}
+
+	return result, update
+}
+
+// updateReservoirs updates counters of reservoir collections and removes replaced links.
+// Requests that are not linked to any collection anymore are removed.
+func (s *Service) updateReservoirs(
+	ctx context.Context, reservoirIDs []entity.CollectionID, update reservoirUpdate,
+	duplicateByCol map[entity.CollectionID]int,
+) error {
+	if len(reservoirIDs) == 0 {
+		return nil
+	}
+
+	var (
+		offeredArg    = make([]int, len(reservoirIDs))
+		duplicatesArg = make([]int, len(reservoirIDs))
+	)
+	for i, id := range reservoirIDs {
+		offeredArg[i] = update.offered[id]
+		duplicatesArg[i] = duplicateByCol[id]
+	}
+
+	// the reservoir keeps at most request_count_limit requests, the collection is completed by the time limit
+	if _, err := px.ExecPlain(ctx, s.conn(ctx),
+		`UPDATE collections SET
+			seen_count = collections.seen_count + c.offered,
+			request_count = LEAST(collections.seen_count + c.offered, collections.request_count_limit),
+			duplicate_count = collections.duplicate_count + c.duplicates,
+			started_at = COALESCE(collections.started_at, NOW()),
+			frozen_duration = CASE WHEN collections.started_at IS NULL AND collections.time_limit_from_first_request
+				THEN '0'::interval ELSE collections.frozen_duration END,
+			last_request_at = NOW(),
+			updated_at = NOW()
+		FROM unnest($1::bigint[], $2::integer[], $3::integer[]) AS c(id, offered, duplicates)
+		WHERE collections.id = c.id`,
+		pgh.Args{toInt64(reservoirIDs), offeredArg, duplicatesArg}); err != nil {
+		return fmt.Errorf("Store: failed to update reservoir counters: %w", err)
+	}
+
+	if len(update.replaced) == 0 {
+		return nil
+	}
+
+	var (
+		collectionArg []int64
+		slotArg       []int
+	)
+	for collectionID, slots := range update.replaced {
+		for _, slot := range slots {
+			collectionArg = append(collectionArg, int64(collectionID))
+			slotArg = append(slotArg, slot)
+		}
+	}
+
+	var requestIDs []int64
+	if err := px.SelectPlain(ctx, s.conn(ctx),
+		`DELETE FROM request_collections rc
+		USING unnest($1::bigint[], $2::integer[]) AS r(collection_id, slot)
+		WHERE rc.collection_id = r.collection_id AND rc.slot = r.slot
+		RETURNING rc.request_id`,
+		&requestIDs, pgh.Args{collectionArg, slotArg}); err != nil {
+		return fmt.Errorf("Store: failed to delete replaced links: %w", err)
+	}
+
+	if len(requestIDs) == 0 {
+		return nil
+	}
+
+	// bodies of the deleted requests are removed by the cleaner, when no requests reference them
+	deleteRequestsSQL := pgh.Builder().Delete("requests").
+		Where(sq.Eq{"id": lo.Uniq(requestIDs)}).
+		Where(
+			sq.NotExists(
+				sq.Select("1").From("request_collections").
+					Where(sq.Expr("request_collections.request_id = requests.id")).
+					Where(sq.Expr("request_collections.created_at = requests.created_at")),
+			),
+		)
+	if _, err := px.Exec(ctx, s.conn(ctx), deleteRequestsSQL); err != nil {
+		return fmt.Errorf("Store: failed to delete replaced requests: %w", err)
+	}
+
+	return nil
+}
+
+func toInt64(ids []entity.CollectionID) []int64 {
+	return lo.Map(ids, func(id entity.CollectionID, _ int) int64 { return int64(id) })
+}
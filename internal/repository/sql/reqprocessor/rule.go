@@ -0,0 +1,117 @@
+package reqprocessor
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/pgh/v2"
+	"github.com/n-r-w/pgh/v2/px"
+	sq "github.com/n-r-w/squirrel"
+	"github.com/samber/lo"
+)
+
+// ruleQuota is the remaining quota of a rule of a multi-rule collection.
+type ruleQuota struct {
+	CollectionID entity.CollectionID `db:"collection_id"`
+	RuleID       int                 `db:"rule_id"`
+	Remaining    int                 `db:"remaining"`
+}
+
+type ruleKey struct {
+	collectionID entity.CollectionID
+	ruleID       int
+}
+
+// assignRules chooses rules that collect the requests in multi-rule collections.
+// The first matching rule that has not reached its quota is chosen, requests are assigned in the order of arrival.
+// Links to collections without remaining quota of the matching rules are removed. Requests without links are removed.
+// Rule counters are protected by the lock of the collections.
+func (s *Service) assignRules(
+	ctx context.Context, toStore []entity.MatchResult, collecting map[entity.CollectionID]lockedCollection,
+) ([]entity.MatchResult, error) {
+	ruleCollectionIDs := lo.Uniq(lo.FlatMap(toStore, func(match entity.MatchResult, _ int) []entity.CollectionID {
+		return lo.Keys(match.RuleIDs)
+	}))
+	if len(ruleCollectionIDs) == 0 {
+		return toStore, nil
+	}
+
+	var quotas []ruleQuota
+	quotaSQL := pgh.Builder().
+		Select("collection_id", "rule_id", "request_count_limit - request_count AS remaining").
+		From("collection_rules").
+		Where(sq.Eq{"collection_id": ruleCollectionIDs})
+	if err := px.Select(ctx, s.conn(ctx), quotaSQL, &quotas); err != nil {
+		return nil, fmt.Errorf("Store: failed to get rule quotas: %w", err)
+	}
+
+	remaining := make(map[ruleKey]int, len(quotas))
+	for _, q := range quotas {
+		remaining[ruleKey{collectionID: q.CollectionID, ruleID: q.RuleID}] = q.Remaining
+	}
+
+	assigned := make(map[ruleKey]int)
+	result := make([]entity.MatchResult, 0, len(toStore))
+	for _, match := range toStore {
+		ruleIDs := make(map[entity.CollectionID][]int, len(match.RuleIDs))
+		collectionIDs := lo.Filter(match.CollectionIDs, func(id entity.CollectionID, _ int) bool {
+			candidates, ok := match.RuleIDs[id]
+			if !ok {
+				return true
+			}
+
+			if _, ok := collecting[id]; !ok {
+				return false
+			}
+
+			for _, ruleID := range candidates {
+				key := ruleKey{collectionID: id, ruleID: ruleID}
+				if remaining[key] <= 0 {
+					continue
+				}
+
+				remaining[key]--
+				assigned[key]++
+				ruleIDs[id] = []int{ruleID}
+				return true
+			}
+
+			return false
+		})
+
+		if len(collectionIDs) > 0 {
+			result = append(result, entity.MatchResult{
+				RequestPos:    match.RequestPos,
+				CollectionIDs: collectionIDs,
+				RuleIDs:       ruleIDs,
+				Strata:        match.Strata,
+			})
+		}
+	}
+
+	if len(assigned) == 0 {
+		return result, nil
+	}
+
+	var (
+		collectionArg = make([]int64, 0, len(assigned))
+		ruleArg       = make([]int, 0, len(assigned))
+		countArg      = make([]int, 0, len(assigned))
+	)
+	for key, count := range assigned {
+		collectionArg = append(collectionArg, int64(key.collectionID))
+		ruleArg = append(ruleArg, key.ruleID)
+		countArg = append(countArg, count)
+	}
+
+	if _, err := px.ExecPlain(ctx, s.conn(ctx),
+		`UPDATE collection_rules SET request_count = collection_rules.request_count + c.count
+		FROM unnest($1::bigint[], $2::integer[], $3::integer[]) AS c(collection_id, rule_id, count)
+		WHERE collection_rules.collection_id = c.collection_id AND collection_rules.rule_id = c.rule_id`,
+		pgh.Args{collectionArg, ruleArg, countArg}); err != nil {
+		return nil, fmt.Errorf("Store: failed to update rule counters: %w", err)
+	}
+
+	return result, nil
+}
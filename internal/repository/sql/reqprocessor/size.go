@@ -0,0 +1,95 @@
+package reqprocessor
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/pgh/v2"
+	"github.com/n-r-w/pgh/v2/px"
+	"github.com/samber/lo"
+)
+
+// limitBytes removes links to collections that have reached the byte size limit.
+// Requests are accepted in the order of arrival, the request that reaches the limit is still accepted.
+// Links are removed before rules, strata and reservoirs are applied, so they don't take their counters.
+// Requests without links are removed.
+func limitBytes(
+	toStore []entity.MatchResult, requests []entity.RequestContent, collecting map[entity.CollectionID]lockedCollection,
+) []entity.MatchResult {
+	byteCount := make(map[entity.CollectionID]int64)
+	result := make([]entity.MatchResult, 0, len(toStore))
+	for _, match := range toStore {
+		size := int64(len(requests[match.RequestPos].Body))
+		collectionIDs := lo.Filter(match.CollectionIDs, func(id entity.CollectionID, _ int) bool {
+			c, ok := collecting[id]
+			if !ok || c.ByteSizeLimit <= 0 {
+				return true
+			}
+
+			if c.ByteCount+byteCount[id] >= c.ByteSizeLimit {
+				return false
+			}
+			byteCount[id] += size
+
+			return true
+		})
+
+		if len(collectionIDs) > 0 {
+			result = append(result, entity.MatchResult{
+				RequestPos:    match.RequestPos,
+				CollectionIDs: collectionIDs,
+				RuleIDs:       match.RuleIDs,
+				Strata:        match.Strata,
+			})
+		}
+	}
+
+	return result
+}
+
+// countBytes returns the body bytes of the stored requests by collection.
+// Reservoir collections are not counted, because their requests are replaced.
+func countBytes(
+	toStore []entity.MatchResult, requests []entity.RequestContent,
+	reservoirs map[entity.CollectionID]lockedCollection,
+) map[entity.CollectionID]int64 {
+	byteCount := make(map[entity.CollectionID]int64)
+	for _, match := range toStore {
+		for _, id := range match.CollectionIDs {
+			if _, ok := reservoirs[id]; ok {
+				continue
+			}
+			byteCount[id] += int64(len(requests[match.RequestPos].Body))
+		}
+	}
+
+	return byteCount
+}
+
+// updateByteCounts adds body bytes of the stored requests to the collection counters.
+// Collections that reach the byte size limit are moved to finalizing.
+func (s *Service) updateByteCounts(ctx context.Context, byteCount map[entity.CollectionID]int64) error {
+	collectionIDs := lo.Keys(lo.PickBy(byteCount, func(_ entity.CollectionID, count int64) bool { return count > 0 }))
+	if len(collectionIDs) == 0 {
+		return nil
+	}
+	slices.Sort(collectionIDs)
+
+	byteArg := lo.Map(collectionIDs, func(id entity.CollectionID, _ int) int64 { return byteCount[id] })
+
+	if _, err := px.ExecPlain(ctx, s.conn(ctx),
+		`UPDATE collections SET
+			byte_count = collections.byte_count + c.bytes,
+			status = CASE WHEN collections.byte_size_limit > 0
+				AND collections.byte_count + c.bytes >= collections.byte_size_limit
+				THEN $3 ELSE collections.status END
+		FROM unnest($1::bigint[], $2::bigint[]) AS c(id, bytes)
+		WHERE collections.id = c.id`,
+		pgh.Args{toInt64(collectionIDs), byteArg, entity.StatusFinalizing}); err != nil {
+		return fmt.Errorf("Store: failed to update byte counters: %w", err)
+	}
+
+	return nil
+}
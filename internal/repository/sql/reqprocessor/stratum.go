@@ -0,0 +1,212 @@
+package reqprocessor
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/pgh/v2"
+	"github.com/n-r-w/pgh/v2/px"
+	sq "github.com/n-r-w/squirrel"
+	"github.com/samber/lo"
+)
+
+// maxStratumValueLength is the number of characters of the stratification key value stored for display.
+// Strata are identified by the hash of the whole value.
+const maxStratumValueLength = 256
+
+// stratumKey is a value of the stratification key of a collection.
+type stratumKey struct {
+	collectionID entity.CollectionID
+	value        string
+}
+
+// hash returns the hash of the value, that identifies the stratum in collection_strata.
+func (k stratumKey) hash() []byte {
+	hash := sha256.Sum256([]byte(k.value))
+	return hash[:]
+}
+
+// stratumHashKey identifies a stratum by the hash of the value.
+type stratumHashKey struct {
+	collectionID entity.CollectionID
+	hash         string
+}
+
+// displayValue returns the value truncated for display.
+func (k stratumKey) displayValue() string {
+	if runes := []rune(k.value); len(runes) > maxStratumValueLength {
+		return string(runes[:maxStratumValueLength])
+	}
+
+	return k.value
+}
+
+// stratumCount is the number of requests collected with a value of the stratification key.
+type stratumCount struct {
+	CollectionID entity.CollectionID `db:"collection_id"`
+	Value        string              `db:"value"`
+	ValueHash    []byte              `db:"value_hash"`
+	Count        int                 `db:"count"`
+}
+
+// stratify limits the number of requests per value of the stratification key in stratified collections.
+// Links to collections whose stratum is full are removed. Requests without links are removed.
+// Stratum counters are protected by the lock of the collections.
+func (s *Service) stratify(
+	ctx context.Context, toStore []entity.MatchResult, collecting map[entity.CollectionID]lockedCollection,
+) ([]entity.MatchResult, error) {
+	stratified := lo.PickBy(collecting, func(_ entity.CollectionID, c lockedCollection) bool {
+		return c.MaxPerValue > 0
+	})
+	if len(stratified) == 0 {
+		return toStore, nil
+	}
+
+	keys := lo.Uniq(lo.FlatMap(toStore, func(match entity.MatchResult, _ int) []stratumKey {
+		return lo.FilterMap(match.CollectionIDs, func(id entity.CollectionID, _ int) (stratumKey, bool) {
+			_, ok := stratified[id]
+			return stratumKey{collectionID: id, value: match.Strata[id]}, ok
+		})
+	}))
+	if len(keys) == 0 {
+		return toStore, nil
+	}
+
+	// counters of the values of the batch
+	var (
+		collectionArg = make([]int64, len(keys))
+		hashArg       = make([][]byte, len(keys))
+		byHash        = make(map[stratumHashKey]stratumKey, len(keys))
+	)
+	for i, key := range keys {
+		collectionArg[i] = int64(key.collectionID)
+		hashArg[i] = key.hash()
+		byHash[stratumHashKey{collectionID: key.collectionID, hash: string(hashArg[i])}] = key
+	}
+
+	var stored []stratumCount
+	if err := px.SelectPlain(ctx, s.conn(ctx),
+		`SELECT s.collection_id, s.value_hash, s.request_count AS count
+		FROM collection_strata s
+		JOIN unnest($1::bigint[], $2::bytea[]) AS k(collection_id, value_hash)
+		ON s.collection_id = k.collection_id AND s.value_hash = k.value_hash`,
+		&stored, pgh.Args{collectionArg, hashArg}); err != nil {
+		return nil, fmt.Errorf("Store: failed to get stratum counters: %w", err)
+	}
+
+	counts := make(map[stratumKey]int, len(stored))
+	for _, c := range stored {
+		counts[byHash[stratumHashKey{collectionID: c.CollectionID, hash: string(c.ValueHash)}]] = c.Count
+	}
+
+	// the number of distinct values is needed only for collections with a minimum
+	withMinimum := lo.Keys(lo.PickBy(stratified, func(_ entity.CollectionID, c lockedCollection) bool {
+		return c.MinDistinctValues > 0
+	}))
+	distinct := make(map[entity.CollectionID]int, len(withMinimum))
+	if len(withMinimum) > 0 {
+		var distinctCounts []stratumCount
+		distinctSQL := pgh.Builder().Select("collection_id", "COUNT(*) AS count").
+			From("collection_strata").
+			Where(sq.Eq{"collection_id": withMinimum}).
+			GroupBy("collection_id")
+		if err := px.Select(ctx, s.conn(ctx), distinctSQL, &distinctCounts); err != nil {
+			return nil, fmt.Errorf("Store: failed to count strata: %w", err)
+		}
+
+		for _, c := range distinctCounts {
+			distinct[c.CollectionID] = c.Count
+		}
+	}
+
+	toStore, assigned := applyStrata(toStore, stratified, counts, distinct)
+	if len(assigned) == 0 {
+		return toStore, nil
+	}
+
+	var (
+		countArg []int
+		valueArg []string
+	)
+	collectionArg, hashArg = collectionArg[:0], hashArg[:0]
+	for key, count := range assigned {
+		collectionArg = append(collectionArg, int64(key.collectionID))
+		hashArg = append(hashArg, key.hash())
+		valueArg = append(valueArg, key.displayValue())
+		countArg = append(countArg, count)
+	}
+
+	if _, err := px.ExecPlain(ctx, s.conn(ctx),
+		`INSERT INTO collection_strata (collection_id, value_hash, value, request_count)
+		SELECT * FROM unnest($1::bigint[], $2::bytea[], $3::text[], $4::integer[])
+		ON CONFLICT (collection_id, value_hash) DO UPDATE
+		SET request_count = collection_strata.request_count + EXCLUDED.request_count`,
+		pgh.Args{collectionArg, hashArg, valueArg, countArg}); err != nil {
+		return nil, fmt.Errorf("Store: failed to update stratum counters: %w", err)
+	}
+
+	return toStore, nil
+}
+
+// applyStrata removes links that exceed the limit per value of the stratification key.
+// Requests are accepted in the order of arrival. While the collection has fewer distinct values than required,
+// the space left to the request count limit is reserved for new values, so the collection can't be completed
+// without them. Links beyond the request count limit are removed, so they don't take the stratum counters.
+// Returns the number of accepted requests by stratum.
+func applyStrata(
+	toStore []entity.MatchResult,
+	stratified map[entity.CollectionID]lockedCollection,
+	counts map[stratumKey]int,
+	distinct map[entity.CollectionID]int,
+) ([]entity.MatchResult, map[stratumKey]int) {
+	var (
+		assigned = make(map[stratumKey]int)
+		accepted = make(map[entity.CollectionID]int)
+		result   = make([]entity.MatchResult, 0, len(toStore))
+	)
+	for _, match := range toStore {
+		collectionIDs := lo.Filter(match.CollectionIDs, func(id entity.CollectionID, _ int) bool {
+			c, ok := stratified[id]
+			if !ok {
+				return true
+			}
+
+			remaining := c.RequestCountLimit - c.RequestCount - accepted[id]
+			if remaining <= 0 {
+				return false
+			}
+
+			key := stratumKey{collectionID: id, value: match.Strata[id]}
+			if counts[key] >= c.MaxPerValue {
+				return false
+			}
+
+			isNew := counts[key] == 0
+			if !isNew && c.MinDistinctValues-distinct[id] >= remaining {
+				return false
+			}
+
+			if isNew {
+				distinct[id]++
+			}
+			counts[key]++
+			assigned[key]++
+			accepted[id]++
+
+			return true
+		})
+
+		if len(collectionIDs) > 0 {
+			result = append(result, entity.MatchResult{
+				RequestPos:    match.RequestPos,
+				CollectionIDs: collectionIDs,
+				RuleIDs:       match.RuleIDs,
+				Strata:        match.Strata,
+			})
+		}
+	}
+
+	return result, assigned
+}
@@ -4,10 +4,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/n-r-w/collector/internal/entity"
 	"github.com/n-r-w/pgh/v2"
 	"github.com/n-r-w/pgh/v2/px"
 	sq "github.com/n-r-w/squirrel"
+	"github.com/samber/mo"
 )
 
 // GetResultChan returns a channel that yields collection results. Implements IResultChanGetter.GetResultChan.
@@ -53,9 +55,11 @@ func (s *Service) processBatch( //nolint:gocritic // named return values not con
 	resultChan chan<- entity.RequestChunk,
 ) (int64, int, bool, error) {
 	rows, err := s.conn(ctx).Query(ctx,
-		`SELECT r.id, r.body 
+		`SELECT r.id, b.body, b.object_key, cr.name 
 		FROM request_collections rc 
-		JOIN requests r ON rc.request_id = r.id 
+		JOIN requests r ON rc.request_id = r.id AND rc.created_at = r.created_at
+		JOIN request_bodies b ON b.hash = r.body_hash
+		LEFT JOIN collection_rules cr ON cr.collection_id = rc.collection_id AND cr.rule_id = rc.rule_id
 		WHERE rc.collection_id = $1 AND r.id > $2
 		ORDER BY r.id 
 		LIMIT $3`,
@@ -72,10 +76,12 @@ func (s *Service) processBatch( //nolint:gocritic // named return values not con
 		}
 
 		var (
-			id   int64
-			data []byte
+			id        int64
+			data      []byte
+			objectKey pgtype.Text
+			tag       pgtype.Text
 		)
-		if err := rows.Scan(&id, &data); err != nil {
+		if err := rows.Scan(&id, &data, &objectKey, &tag); err != nil {
 			return lastID, processed, false, fmt.Errorf("GetResultChan: failed to scan row: %w", err)
 		}
 
@@ -85,7 +91,7 @@ func (s *Service) processBatch( //nolint:gocritic // named return values not con
 		select {
 		case <-ctx.Done():
 			return 0, 0, false, ctx.Err()
-		case resultChan <- entity.RequestChunk{Data: data}:
+		case resultChan <- newRequestChunk(data, objectKey, tag):
 			processed++
 		}
 	}
@@ -97,6 +103,21 @@ func (s *Service) processBatch( //nolint:gocritic // named return values not con
 	return lastID, processed, hasRows, nil
 }
 
+// newRequestChunk creates a chunk with the body or with the reference to the body stored in the object storage.
+// The chunk is tagged with the name of the rule that collected the request, if any.
+func newRequestChunk(data []byte, objectKey, tag pgtype.Text) entity.RequestChunk {
+	chunk := entity.RequestChunk{Data: data}
+	if objectKey.Valid {
+		chunk = entity.RequestChunk{BodyRef: mo.Some(entity.BodyRef(objectKey.String))}
+	}
+
+	if tag.Valid {
+		chunk.Tag = mo.Some(tag.String)
+	}
+
+	return chunk
+}
+
 func (s *Service) UpdateResultID(
 	ctx context.Context, collectionID entity.CollectionID, resultID entity.ResultID,
 ) error {
@@ -52,19 +52,20 @@ func TestGetResultChan(t *testing.T) {
 
 	// Insert test requests and link them to the collection
 	for _, data := range testData {
+		createdAt := time.Now()
 		var requestID int64
 		require.NoError(t, px.SelectOne(ctx, s.conn(ctx),
 			pgh.Builder().
 				Insert("requests").
-				Columns("handler", "headers", "body", "created_at").
-				Values("test-handler", []byte(`{}`), data, time.Now()).
+				Columns("handler", "headers", "body_hash", "created_at").
+				Values("test-handler", []byte(`{}`), sql.InsertTestBody(t, ctx, s.conn, data), createdAt).
 				Suffix("RETURNING id"), &requestID))
 
 		_, err := px.Exec(ctx, s.conn(ctx),
 			pgh.Builder().
 				Insert("request_collections").
-				Columns("collection_id", "request_id").
-				Values(collectionID, requestID))
+				Columns("collection_id", "request_id", "created_at").
+				Values(collectionID, requestID, createdAt))
 		require.NoError(t, err)
 	}
 
@@ -6,4 +6,6 @@ import "context"
 type IMetrics interface {
 	// ObserveKafkaErrors observe kafka errors.
 	ObserveKafkaErrors(ctx context.Context, err error)
+	// ObserveObjectStorageDeleteErrors observe objects that failed to be deleted from object storage.
+	ObserveObjectStorageDeleteErrors(ctx context.Context, count int)
 }
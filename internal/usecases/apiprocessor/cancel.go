@@ -25,8 +25,8 @@ func (s *Service) cancelCollectionHelper(ctx context.Context, collectionID entit
 		return fmt.Errorf("get collection: %w", err)
 	}
 
-	// Only allow stopping in-progress collections
-	if collection.Status != entity.StatusInProgress && collection.Status != entity.StatusPending {
+	// Only allow stopping in-progress and paused collections
+	if !collection.Status.IsCollecting() && collection.Status != entity.StatusPaused {
 		return errors.New("collection is not in active state")
 	}
 
@@ -0,0 +1,39 @@
+package apiprocessor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/ctxlog"
+	"github.com/n-r-w/pgh/v2/txmgr"
+)
+
+// CompleteCollection stops collecting and moves the collection to finalizing.
+// The finalizer saves the result from the requests collected so far.
+func (s *Service) CompleteCollection(ctx context.Context, collectionID entity.CollectionID) error {
+	return s.trManager.Begin(ctx, func(ctx context.Context) error {
+		return s.completeCollectionHelper(ctx, collectionID)
+	}, txmgr.WithLock())
+}
+
+func (s *Service) completeCollectionHelper(ctx context.Context, collectionID entity.CollectionID) error {
+	// Get current status (with lock record)
+	collection, err := s.collectionReader.GetCollection(ctx, collectionID)
+	if err != nil {
+		return fmt.Errorf("get collection: %w", err)
+	}
+
+	if !collection.Status.IsCollecting() && collection.Status != entity.StatusPaused {
+		return fmt.Errorf("%w: collection in status %s can't be completed", entity.ErrInvalidStatus, collection.Status)
+	}
+
+	if err := s.collectionUpdater.UpdateStatus(ctx, collectionID, entity.StatusFinalizing); err != nil {
+		return fmt.Errorf("update status: %w", err)
+	}
+
+	ctxlog.Debug(ctx, "collection moved to finalizing",
+		slog.String("collection_id", collectionID.String()))
+	return nil
+}
@@ -13,7 +13,16 @@ import (
 func (s *Service) CreateCollection(
 	ctx context.Context, task entity.Task,
 ) (entity.CollectionID, error) {
-	collectionID, err := s.collectionCreator.CreateCollection(ctx, task)
+	if err := task.ValidateRetention(); err != nil {
+		return 0, err
+	}
+
+	var collectionID entity.CollectionID
+	err := s.trManager.Begin(ctx, func(ctx context.Context) error {
+		var err error
+		collectionID, err = s.collectionCreator.CreateCollection(ctx, task)
+		return err
+	})
 	if err != nil {
 		ctxlog.Error(ctx, "failed to create collection",
 			slog.Any("criteria", task),
@@ -26,6 +26,26 @@ type ICollectionReader interface {
 type ICollectionUpdater interface {
 	// UpdateStatus updates collection status.
 	UpdateStatus(ctx context.Context, collectionID entity.CollectionID, status entity.CollectionStatus) error
+	// UpdateRetention updates collection retention policy.
+	UpdateRetention(ctx context.Context, collectionID entity.CollectionID, retention entity.RetentionPolicy) error
+	// UpdateCollection stores the changeable parameters of a collecting collection.
+	UpdateCollection(ctx context.Context, collectionID entity.CollectionID, task entity.Task) error
+	// AddCollectionChange records who changed the collection and the values of the changed fields.
+	AddCollectionChange(ctx context.Context, change entity.CollectionChange) error
+	// PauseCollection pauses a collecting collection.
+	PauseCollection(ctx context.Context, collectionID entity.CollectionID, freezeTimeLimit bool) error
+	// ResumeCollection resumes a paused collection.
+	ResumeCollection(ctx context.Context, collectionID entity.CollectionID) error
+}
+
+// IScheduleStorer is responsible for storing schedules of collections.
+type IScheduleStorer interface {
+	// CreateSchedule creates a new schedule and returns its ID.
+	CreateSchedule(ctx context.Context, schedule entity.Schedule) (entity.ScheduleID, error)
+	// GetSchedules returns all schedules.
+	GetSchedules(ctx context.Context) ([]entity.Schedule, error)
+	// DeleteSchedule deletes a schedule.
+	DeleteSchedule(ctx context.Context, id entity.ScheduleID) error
 }
 
 // IResultGetter is responsible for retrieving collection results.
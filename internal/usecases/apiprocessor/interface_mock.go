@@ -131,6 +131,76 @@ func (m *MockICollectionUpdater) EXPECT() *MockICollectionUpdaterMockRecorder {
 	return m.recorder
 }
 
+// AddCollectionChange mocks base method.
+func (m *MockICollectionUpdater) AddCollectionChange(ctx context.Context, change entity.CollectionChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCollectionChange", ctx, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCollectionChange indicates an expected call of AddCollectionChange.
+func (mr *MockICollectionUpdaterMockRecorder) AddCollectionChange(ctx, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCollectionChange", reflect.TypeOf((*MockICollectionUpdater)(nil).AddCollectionChange), ctx, change)
+}
+
+// PauseCollection mocks base method.
+func (m *MockICollectionUpdater) PauseCollection(ctx context.Context, collectionID entity.CollectionID, freezeTimeLimit bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseCollection", ctx, collectionID, freezeTimeLimit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PauseCollection indicates an expected call of PauseCollection.
+func (mr *MockICollectionUpdaterMockRecorder) PauseCollection(ctx, collectionID, freezeTimeLimit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseCollection", reflect.TypeOf((*MockICollectionUpdater)(nil).PauseCollection), ctx, collectionID, freezeTimeLimit)
+}
+
+// ResumeCollection mocks base method.
+func (m *MockICollectionUpdater) ResumeCollection(ctx context.Context, collectionID entity.CollectionID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeCollection", ctx, collectionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResumeCollection indicates an expected call of ResumeCollection.
+func (mr *MockICollectionUpdaterMockRecorder) ResumeCollection(ctx, collectionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeCollection", reflect.TypeOf((*MockICollectionUpdater)(nil).ResumeCollection), ctx, collectionID)
+}
+
+// UpdateCollection mocks base method.
+func (m *MockICollectionUpdater) UpdateCollection(ctx context.Context, collectionID entity.CollectionID, task entity.Task) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCollection", ctx, collectionID, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateCollection indicates an expected call of UpdateCollection.
+func (mr *MockICollectionUpdaterMockRecorder) UpdateCollection(ctx, collectionID, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCollection", reflect.TypeOf((*MockICollectionUpdater)(nil).UpdateCollection), ctx, collectionID, task)
+}
+
+// UpdateRetention mocks base method.
+func (m *MockICollectionUpdater) UpdateRetention(ctx context.Context, collectionID entity.CollectionID, retention entity.RetentionPolicy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRetention", ctx, collectionID, retention)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateRetention indicates an expected call of UpdateRetention.
+func (mr *MockICollectionUpdaterMockRecorder) UpdateRetention(ctx, collectionID, retention any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRetention", reflect.TypeOf((*MockICollectionUpdater)(nil).UpdateRetention), ctx, collectionID, retention)
+}
+
 // UpdateStatus mocks base method.
 func (m *MockICollectionUpdater) UpdateStatus(ctx context.Context, collectionID entity.CollectionID, status entity.CollectionStatus) error {
 	m.ctrl.T.Helper()
@@ -145,6 +215,73 @@ func (mr *MockICollectionUpdaterMockRecorder) UpdateStatus(ctx, collectionID, st
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockICollectionUpdater)(nil).UpdateStatus), ctx, collectionID, status)
 }
 
+// MockIScheduleStorer is a mock of IScheduleStorer interface.
+type MockIScheduleStorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockIScheduleStorerMockRecorder
+}
+
+// MockIScheduleStorerMockRecorder is the mock recorder for MockIScheduleStorer.
+type MockIScheduleStorerMockRecorder struct {
+	mock *MockIScheduleStorer
+}
+
+// NewMockIScheduleStorer creates a new mock instance.
+func NewMockIScheduleStorer(ctrl *gomock.Controller) *MockIScheduleStorer {
+	mock := &MockIScheduleStorer{ctrl: ctrl}
+	mock.recorder = &MockIScheduleStorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIScheduleStorer) EXPECT() *MockIScheduleStorerMockRecorder {
+	return m.recorder
+}
+
+// CreateSchedule mocks base method.
+func (m *MockIScheduleStorer) CreateSchedule(ctx context.Context, schedule entity.Schedule) (entity.ScheduleID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSchedule", ctx, schedule)
+	ret0, _ := ret[0].(entity.ScheduleID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSchedule indicates an expected call of CreateSchedule.
+func (mr *MockIScheduleStorerMockRecorder) CreateSchedule(ctx, schedule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSchedule", reflect.TypeOf((*MockIScheduleStorer)(nil).CreateSchedule), ctx, schedule)
+}
+
+// DeleteSchedule mocks base method.
+func (m *MockIScheduleStorer) DeleteSchedule(ctx context.Context, id entity.ScheduleID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSchedule", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSchedule indicates an expected call of DeleteSchedule.
+func (mr *MockIScheduleStorerMockRecorder) DeleteSchedule(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSchedule", reflect.TypeOf((*MockIScheduleStorer)(nil).DeleteSchedule), ctx, id)
+}
+
+// GetSchedules mocks base method.
+func (m *MockIScheduleStorer) GetSchedules(ctx context.Context) ([]entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedules", ctx)
+	ret0, _ := ret[0].([]entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSchedules indicates an expected call of GetSchedules.
+func (mr *MockIScheduleStorerMockRecorder) GetSchedules(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedules", reflect.TypeOf((*MockIScheduleStorer)(nil).GetSchedules), ctx)
+}
+
 // MockIResultGetter is a mock of IResultGetter interface.
 type MockIResultGetter struct {
 	ctrl     *gomock.Controller
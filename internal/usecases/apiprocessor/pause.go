@@ -0,0 +1,67 @@
+package apiprocessor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/ctxlog"
+	"github.com/n-r-w/pgh/v2/txmgr"
+)
+
+// PauseCollection stops collecting until the collection is resumed.
+func (s *Service) PauseCollection(ctx context.Context, collectionID entity.CollectionID, freezeTimeLimit bool) error {
+	return s.trManager.Begin(ctx, func(ctx context.Context) error {
+		return s.pauseCollectionHelper(ctx, collectionID, freezeTimeLimit)
+	}, txmgr.WithLock())
+}
+
+func (s *Service) pauseCollectionHelper(
+	ctx context.Context, collectionID entity.CollectionID, freezeTimeLimit bool,
+) error {
+	// Get current status (with lock record)
+	collection, err := s.collectionReader.GetCollection(ctx, collectionID)
+	if err != nil {
+		return fmt.Errorf("get collection: %w", err)
+	}
+
+	if !collection.Status.IsCollecting() {
+		return fmt.Errorf("%w: collection in status %s can't be paused", entity.ErrInvalidStatus, collection.Status)
+	}
+
+	if err := s.collectionUpdater.PauseCollection(ctx, collectionID, freezeTimeLimit); err != nil {
+		return fmt.Errorf("pause collection: %w", err)
+	}
+
+	ctxlog.Debug(ctx, "collection paused",
+		slog.String("collection_id", collectionID.String()),
+		slog.Bool("freeze_time_limit", freezeTimeLimit))
+	return nil
+}
+
+// ResumeCollection continues collecting of a paused collection.
+func (s *Service) ResumeCollection(ctx context.Context, collectionID entity.CollectionID) error {
+	return s.trManager.Begin(ctx, func(ctx context.Context) error {
+		return s.resumeCollectionHelper(ctx, collectionID)
+	}, txmgr.WithLock())
+}
+
+func (s *Service) resumeCollectionHelper(ctx context.Context, collectionID entity.CollectionID) error {
+	// Get current status (with lock record)
+	collection, err := s.collectionReader.GetCollection(ctx, collectionID)
+	if err != nil {
+		return fmt.Errorf("get collection: %w", err)
+	}
+
+	if collection.Status != entity.StatusPaused {
+		return fmt.Errorf("%w: collection in status %s is not paused", entity.ErrInvalidStatus, collection.Status)
+	}
+
+	if err := s.collectionUpdater.ResumeCollection(ctx, collectionID); err != nil {
+		return fmt.Errorf("resume collection: %w", err)
+	}
+
+	ctxlog.Debug(ctx, "collection resumed", slog.String("collection_id", collectionID.String()))
+	return nil
+}
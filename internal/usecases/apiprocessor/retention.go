@@ -0,0 +1,44 @@
+package apiprocessor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/ctxlog"
+	"github.com/n-r-w/pgh/v2/txmgr"
+)
+
+// UpdateRetention changes how long a collection is kept before cleanup.
+func (s *Service) UpdateRetention(
+	ctx context.Context, collectionID entity.CollectionID, retention entity.RetentionPolicy,
+) error {
+	return s.trManager.Begin(ctx, func(ctx context.Context) error {
+		return s.updateRetentionHelper(ctx, collectionID, retention)
+	}, txmgr.WithLock())
+}
+
+func (s *Service) updateRetentionHelper(
+	ctx context.Context, collectionID entity.CollectionID, retention entity.RetentionPolicy,
+) error {
+	// Get current collection (with lock record)
+	collection, err := s.collectionReader.GetCollection(ctx, collectionID)
+	if err != nil {
+		return fmt.Errorf("get collection: %w", err)
+	}
+
+	collection.Task.Retention = retention
+	if err := collection.Task.ValidateRetention(); err != nil {
+		return err
+	}
+
+	if err := s.collectionUpdater.UpdateRetention(ctx, collectionID, retention); err != nil {
+		return fmt.Errorf("update retention: %w", err)
+	}
+
+	ctxlog.Debug(ctx, "collection retention updated",
+		slog.String("collection_id", collectionID.String()),
+		slog.Bool("pinned", retention.Pinned))
+	return nil
+}
@@ -0,0 +1,62 @@
+package apiprocessor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/ctxlog"
+)
+
+// CreateSchedule creates a schedule of collections and returns its ID.
+func (s *Service) CreateSchedule(ctx context.Context, schedule entity.Schedule) (entity.ScheduleID, error) {
+	if err := schedule.Validate(); err != nil {
+		return 0, err
+	}
+
+	if err := schedule.Task.ValidateRetention(); err != nil {
+		return 0, err
+	}
+
+	schedule.NextRunAt = schedule.Cron.Next(time.Now())
+	if schedule.NextRunAt.IsZero() {
+		return 0, fmt.Errorf("%w: cron expression %q is never activated", entity.ErrInvalidSchedule, schedule.Cron)
+	}
+
+	scheduleID, err := s.scheduleStorer.CreateSchedule(ctx, schedule)
+	if err != nil {
+		ctxlog.Error(ctx, "failed to create schedule",
+			slog.Any("criteria", schedule.Task),
+			slog.Any("error", err))
+		return 0, fmt.Errorf("create schedule: %w", err)
+	}
+
+	ctxlog.Debug(ctx, "created new schedule",
+		slog.String("schedule_id", scheduleID.String()),
+		slog.Time("next_run_at", schedule.NextRunAt))
+	return scheduleID, nil
+}
+
+// GetSchedules returns all schedules.
+func (s *Service) GetSchedules(ctx context.Context) ([]entity.Schedule, error) {
+	schedules, err := s.scheduleStorer.GetSchedules(ctx)
+	if err != nil {
+		ctxlog.Error(ctx, "failed to get schedules", slog.Any("error", err))
+		return nil, fmt.Errorf("get schedules: %w", err)
+	}
+
+	ctxlog.Debug(ctx, "retrieved schedules", slog.Int("count", len(schedules)))
+	return schedules, nil
+}
+
+// DeleteSchedule deletes a schedule. Collections created by it are kept.
+func (s *Service) DeleteSchedule(ctx context.Context, scheduleID entity.ScheduleID) error {
+	if err := s.scheduleStorer.DeleteSchedule(ctx, scheduleID); err != nil {
+		return fmt.Errorf("delete schedule: %w", err)
+	}
+
+	ctxlog.Debug(ctx, "schedule deleted", slog.String("schedule_id", scheduleID.String()))
+	return nil
+}
@@ -15,6 +15,7 @@ type Service struct {
 	collectionCreator ICollectionCreator
 	collectionReader  ICollectionReader
 	collectionUpdater ICollectionUpdater
+	scheduleStorer    IScheduleStorer
 	resultGetter      IResultGetter
 }
 
@@ -22,6 +23,7 @@ var (
 	_ handlers.ICollectionManager = (*Service)(nil)
 	_ handlers.IResultGetter      = (*Service)(nil)
 	_ handlers.ICollectionManager = (*Service)(nil)
+	_ handlers.IScheduleManager   = (*Service)(nil)
 )
 
 // New creates new Service instance.
@@ -29,6 +31,7 @@ func New(
 	collectionCreator ICollectionCreator,
 	collectionReader ICollectionReader,
 	collectionUpdater ICollectionUpdater,
+	scheduleStorer IScheduleStorer,
 	resultGetter IResultGetter,
 	trManager txmgr.ITransactionManager,
 ) *Service {
@@ -36,6 +39,7 @@ func New(
 		collectionCreator: collectionCreator,
 		collectionReader:  collectionReader,
 		collectionUpdater: collectionUpdater,
+		scheduleStorer:    scheduleStorer,
 		resultGetter:      resultGetter,
 		trManager:         trManager,
 	}
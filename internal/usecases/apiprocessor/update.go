@@ -0,0 +1,60 @@
+package apiprocessor
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/ctxlog"
+	"github.com/n-r-w/pgh/v2/txmgr"
+)
+
+// UpdateCollection changes limits and selection criteria of a collecting or paused collection.
+// The change is recorded with the author reported by the caller. The collection cache picks the change up on the next refresh.
+func (s *Service) UpdateCollection(
+	ctx context.Context, collectionID entity.CollectionID, update entity.CollectionUpdate,
+) error {
+	return s.trManager.Begin(ctx, func(ctx context.Context) error {
+		return s.updateCollectionHelper(ctx, collectionID, update)
+	}, txmgr.WithLock())
+}
+
+func (s *Service) updateCollectionHelper(
+	ctx context.Context, collectionID entity.CollectionID, update entity.CollectionUpdate,
+) error {
+	// Get current collection (with lock record)
+	collection, err := s.collectionReader.GetCollection(ctx, collectionID)
+	if err != nil {
+		return fmt.Errorf("get collection: %w", err)
+	}
+
+	if !collection.Status.IsCollecting() && collection.Status != entity.StatusPaused {
+		return fmt.Errorf("%w: collection in status %s can't be changed", entity.ErrInvalidStatus, collection.Status)
+	}
+
+	task, err := update.Apply(collection.Task)
+	if err != nil {
+		return err
+	}
+
+	if err := s.collectionUpdater.UpdateCollection(ctx, collectionID, task); err != nil {
+		return fmt.Errorf("update collection: %w", err)
+	}
+
+	if err := s.collectionUpdater.AddCollectionChange(ctx, entity.CollectionChange{
+		CollectionID: collectionID,
+		Author:       update.Author,
+		Fields:       update.Fields(),
+		Previous:     collection.Task,
+		Current:      task,
+	}); err != nil {
+		return fmt.Errorf("add collection change: %w", err)
+	}
+
+	ctxlog.Debug(ctx, "collection updated",
+		slog.String("collection_id", collectionID.String()),
+		slog.String("author", update.Author),
+		slog.Any("fields", update.Fields()))
+	return nil
+}
@@ -21,6 +21,8 @@ type Service struct {
 	mu               sync.RWMutex
 	collectionReader ICollectionReader
 	executor         *executor.Service
+
+	expressionCostLimit uint64
 }
 
 var (
@@ -36,6 +38,8 @@ func New(
 	c := &Service{
 		collections:      make(map[entity.CollectionID]entity.Collection),
 		collectionReader: collectionGetter,
+
+		expressionCostLimit: cfg.Collection.ExpressionCostLimit,
 	}
 
 	var err error
@@ -117,11 +121,44 @@ func (s *Service) worker(ctx context.Context) error {
 		return fmt.Errorf("get active collections: %w", err)
 	}
 
+	s.compileExpressions(ctx, collections)
 	s.update(collections)
 
 	return nil
 }
 
+// compileExpressions compiles selection expressions of the collections.
+// Compiled expressions of cached collections are reused, so every expression is compiled once.
+func (s *Service) compileExpressions(ctx context.Context, collections []entity.Collection) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for i := range collections {
+		selection := &collections[i].Task.MessageSelection
+		if !selection.Expression.IsSet() {
+			continue
+		}
+
+		if cached, ok := s.collections[collections[i].ID]; ok {
+			cachedExpression := cached.Task.MessageSelection.Expression
+			if cachedExpression.IsCompiled() && cachedExpression.Source == selection.Expression.Source {
+				selection.Expression = cachedExpression
+				continue
+			}
+		}
+
+		expression, err := entity.CompileExpression(selection.Expression.Source, s.expressionCostLimit)
+		if err != nil {
+			// the collection doesn't match any requests, expressions are validated on creation anyway
+			ctxlog.Error(ctx, "failed to compile selection expression",
+				slog.String("collection_id", collections[i].ID.String()), slog.Any("error", err))
+			continue
+		}
+
+		selection.Expression = expression
+	}
+}
+
 // worker is a wrapper for executor.Executor.
 type worker struct {
 	service *Service
@@ -8,6 +8,7 @@ import (
 
 	"github.com/n-r-w/collector/internal/config"
 	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/ctxlog"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -197,6 +198,58 @@ func TestService_worker(t *testing.T) {
 	}
 }
 
+func TestService_compileExpressions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	newCollections := func() []entity.Collection {
+		return []entity.Collection{
+			{
+				ID:     entity.CollectionID(1),
+				Status: entity.StatusInProgress,
+				Task: entity.Task{MessageSelection: entity.MessageSelectionCriteria{
+					Expression: entity.Expression{Source: `handler == "test"`},
+				}},
+			},
+			{
+				ID:     entity.CollectionID(2),
+				Status: entity.StatusInProgress,
+				Task: entity.Task{MessageSelection: entity.MessageSelectionCriteria{
+					Expression: entity.Expression{Source: `handler +`},
+				}},
+			},
+		}
+	}
+
+	reader := NewMockICollectionReader(ctrl)
+	reader.EXPECT().GetCollections(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(context.Context, entity.CollectionFilter) ([]entity.Collection, error) {
+			return newCollections(), nil
+		}).Times(2)
+
+	cfg := testConfig()
+	cfg.Collection.ExpressionCostLimit = 100
+
+	svc, err := New(cfg, reader)
+	require.NoError(t, err)
+
+	ctx := ctxlog.MustContext(context.Background(), ctxlog.WithTesting(t))
+
+	// the second update reuses compiled expressions
+	for range 2 {
+		require.NoError(t, svc.worker(ctx))
+
+		expressions := make(map[entity.CollectionID]entity.Expression)
+		for _, collection := range svc.Get() {
+			expressions[collection.ID] = collection.Task.MessageSelection.Expression
+		}
+
+		assert.True(t, expressions[1].IsCompiled())
+		// invalid expressions stay not compiled
+		assert.True(t, expressions[2].IsSet())
+		assert.False(t, expressions[2].IsCompiled())
+	}
+}
+
 func TestService_StartStop(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
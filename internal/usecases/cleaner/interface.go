@@ -2,6 +2,7 @@ package cleaner
 
 import (
 	"context"
+	"time"
 
 	"github.com/n-r-w/collector/internal/entity"
 )
@@ -18,16 +19,56 @@ type ILocker interface {
 type IDatabaseCleaner interface {
 	// Clean cleans up database.
 	CleanDatabase(ctx context.Context, collectionIDs []entity.CollectionID) error
+	// PurgeCompletedRequests removes collected requests of collections completed before the given time.
+	// The collections themselves are kept.
+	PurgeCompletedRequests(ctx context.Context, completedBefore time.Time) error
+	// DeleteUnusedBodies removes request bodies that are not referenced by requests.
+	DeleteUnusedBodies(ctx context.Context) error
+}
+
+// IPartitionManager maintains partitions of collected requests.
+type IPartitionManager interface {
+	// CreatePartitions creates partitions covering the given time range.
+	CreatePartitions(ctx context.Context, from, until time.Time) error
+	// DropPartitions drops partitions that ended before the given time and are not used by collections.
+	DropPartitions(ctx context.Context, before time.Time) error
 }
 
 // IObjectStorageCleaner cleans up object storage.
 type IObjectStorageCleaner interface {
-	// Clean cleans up object storage.
-	CleanObjectStorage(ctx context.Context, resultIDs []entity.ResultID) error
+	// Clean cleans up object storage. Returns objects that failed to be deleted.
+	CleanObjectStorage(ctx context.Context, resultIDs []entity.ResultID) ([]entity.DeletionFailure, error)
+}
+
+// IPendingDeletionStorer stores object storage deletions that must be retried.
+type IPendingDeletionStorer interface {
+	// GetPendingDeletions returns objects that failed to be deleted earlier.
+	GetPendingDeletions(ctx context.Context, limit int) ([]entity.ResultID, error)
+	// SavePendingDeletions saves objects that failed to be deleted.
+	SavePendingDeletions(ctx context.Context, failures []entity.DeletionFailure) error
+	// RemovePendingDeletions removes objects that have been deleted.
+	RemovePendingDeletions(ctx context.Context, resultIDs []entity.ResultID) error
+}
+
+// IReleasedBodyDeleter deletes objects of request bodies that are not used anymore.
+type IReleasedBodyDeleter interface {
+	// DeleteReleasedBodies claims a batch of released objects, deletes them with deleteFn
+	// and returns the number of processed objects. deleteFn returns objects that failed to be deleted,
+	// they are returned to the queue.
+	DeleteReleasedBodies(ctx context.Context, limit int,
+		deleteFn func(ctx context.Context, resultIDs []entity.ResultID) ([]entity.DeletionFailure, error),
+	) (int, error)
 }
 
 // ICollectionReader is responsible for reading collection data.
 type ICollectionReader interface {
-	// GetCollections returns all active collections.
-	GetCollections(ctx context.Context, filter entity.CollectionFilter) ([]entity.Collection, error)
+	// GetExpiredCollections returns collections whose retention period has expired.
+	GetExpiredCollections(
+		ctx context.Context, now time.Time, settings entity.RetentionSettings) ([]entity.Collection, error)
+}
+
+// IMetrics is responsible for observing cleanup metrics.
+type IMetrics interface {
+	// ObserveObjectStorageDeleteErrors observe objects that failed to be deleted from object storage.
+	ObserveObjectStorageDeleteErrors(ctx context.Context, count int)
 }
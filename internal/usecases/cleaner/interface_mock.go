@@ -12,6 +12,7 @@ package cleaner
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/n-r-w/collector/internal/entity"
 	gomock "go.uber.org/mock/gomock"
@@ -92,6 +93,85 @@ func (mr *MockIDatabaseCleanerMockRecorder) CleanDatabase(ctx, collectionIDs any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanDatabase", reflect.TypeOf((*MockIDatabaseCleaner)(nil).CleanDatabase), ctx, collectionIDs)
 }
 
+// DeleteUnusedBodies mocks base method.
+func (m *MockIDatabaseCleaner) DeleteUnusedBodies(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUnusedBodies", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUnusedBodies indicates an expected call of DeleteUnusedBodies.
+func (mr *MockIDatabaseCleanerMockRecorder) DeleteUnusedBodies(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUnusedBodies", reflect.TypeOf((*MockIDatabaseCleaner)(nil).DeleteUnusedBodies), ctx)
+}
+
+// PurgeCompletedRequests mocks base method.
+func (m *MockIDatabaseCleaner) PurgeCompletedRequests(ctx context.Context, completedBefore time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeCompletedRequests", ctx, completedBefore)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeCompletedRequests indicates an expected call of PurgeCompletedRequests.
+func (mr *MockIDatabaseCleanerMockRecorder) PurgeCompletedRequests(ctx, completedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeCompletedRequests", reflect.TypeOf((*MockIDatabaseCleaner)(nil).PurgeCompletedRequests), ctx, completedBefore)
+}
+
+// MockIPartitionManager is a mock of IPartitionManager interface.
+type MockIPartitionManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPartitionManagerMockRecorder
+}
+
+// MockIPartitionManagerMockRecorder is the mock recorder for MockIPartitionManager.
+type MockIPartitionManagerMockRecorder struct {
+	mock *MockIPartitionManager
+}
+
+// NewMockIPartitionManager creates a new mock instance.
+func NewMockIPartitionManager(ctrl *gomock.Controller) *MockIPartitionManager {
+	mock := &MockIPartitionManager{ctrl: ctrl}
+	mock.recorder = &MockIPartitionManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPartitionManager) EXPECT() *MockIPartitionManagerMockRecorder {
+	return m.recorder
+}
+
+// CreatePartitions mocks base method.
+func (m *MockIPartitionManager) CreatePartitions(ctx context.Context, from, until time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePartitions", ctx, from, until)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePartitions indicates an expected call of CreatePartitions.
+func (mr *MockIPartitionManagerMockRecorder) CreatePartitions(ctx, from, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePartitions", reflect.TypeOf((*MockIPartitionManager)(nil).CreatePartitions), ctx, from, until)
+}
+
+// DropPartitions mocks base method.
+func (m *MockIPartitionManager) DropPartitions(ctx context.Context, before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DropPartitions", ctx, before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DropPartitions indicates an expected call of DropPartitions.
+func (mr *MockIPartitionManagerMockRecorder) DropPartitions(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DropPartitions", reflect.TypeOf((*MockIPartitionManager)(nil).DropPartitions), ctx, before)
+}
+
 // MockIObjectStorageCleaner is a mock of IObjectStorageCleaner interface.
 type MockIObjectStorageCleaner struct {
 	ctrl     *gomock.Controller
@@ -116,11 +196,12 @@ func (m *MockIObjectStorageCleaner) EXPECT() *MockIObjectStorageCleanerMockRecor
 }
 
 // CleanObjectStorage mocks base method.
-func (m *MockIObjectStorageCleaner) CleanObjectStorage(ctx context.Context, resultIDs []entity.ResultID) error {
+func (m *MockIObjectStorageCleaner) CleanObjectStorage(ctx context.Context, resultIDs []entity.ResultID) ([]entity.DeletionFailure, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CleanObjectStorage", ctx, resultIDs)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].([]entity.DeletionFailure)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CleanObjectStorage indicates an expected call of CleanObjectStorage.
@@ -129,6 +210,110 @@ func (mr *MockIObjectStorageCleanerMockRecorder) CleanObjectStorage(ctx, resultI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanObjectStorage", reflect.TypeOf((*MockIObjectStorageCleaner)(nil).CleanObjectStorage), ctx, resultIDs)
 }
 
+// MockIPendingDeletionStorer is a mock of IPendingDeletionStorer interface.
+type MockIPendingDeletionStorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPendingDeletionStorerMockRecorder
+}
+
+// MockIPendingDeletionStorerMockRecorder is the mock recorder for MockIPendingDeletionStorer.
+type MockIPendingDeletionStorerMockRecorder struct {
+	mock *MockIPendingDeletionStorer
+}
+
+// NewMockIPendingDeletionStorer creates a new mock instance.
+func NewMockIPendingDeletionStorer(ctrl *gomock.Controller) *MockIPendingDeletionStorer {
+	mock := &MockIPendingDeletionStorer{ctrl: ctrl}
+	mock.recorder = &MockIPendingDeletionStorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIPendingDeletionStorer) EXPECT() *MockIPendingDeletionStorerMockRecorder {
+	return m.recorder
+}
+
+// GetPendingDeletions mocks base method.
+func (m *MockIPendingDeletionStorer) GetPendingDeletions(ctx context.Context, limit int) ([]entity.ResultID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingDeletions", ctx, limit)
+	ret0, _ := ret[0].([]entity.ResultID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingDeletions indicates an expected call of GetPendingDeletions.
+func (mr *MockIPendingDeletionStorerMockRecorder) GetPendingDeletions(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingDeletions", reflect.TypeOf((*MockIPendingDeletionStorer)(nil).GetPendingDeletions), ctx, limit)
+}
+
+// RemovePendingDeletions mocks base method.
+func (m *MockIPendingDeletionStorer) RemovePendingDeletions(ctx context.Context, resultIDs []entity.ResultID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemovePendingDeletions", ctx, resultIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemovePendingDeletions indicates an expected call of RemovePendingDeletions.
+func (mr *MockIPendingDeletionStorerMockRecorder) RemovePendingDeletions(ctx, resultIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemovePendingDeletions", reflect.TypeOf((*MockIPendingDeletionStorer)(nil).RemovePendingDeletions), ctx, resultIDs)
+}
+
+// SavePendingDeletions mocks base method.
+func (m *MockIPendingDeletionStorer) SavePendingDeletions(ctx context.Context, failures []entity.DeletionFailure) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePendingDeletions", ctx, failures)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePendingDeletions indicates an expected call of SavePendingDeletions.
+func (mr *MockIPendingDeletionStorerMockRecorder) SavePendingDeletions(ctx, failures any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePendingDeletions", reflect.TypeOf((*MockIPendingDeletionStorer)(nil).SavePendingDeletions), ctx, failures)
+}
+
+// MockIReleasedBodyDeleter is a mock of IReleasedBodyDeleter interface.
+type MockIReleasedBodyDeleter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIReleasedBodyDeleterMockRecorder
+}
+
+// MockIReleasedBodyDeleterMockRecorder is the mock recorder for MockIReleasedBodyDeleter.
+type MockIReleasedBodyDeleterMockRecorder struct {
+	mock *MockIReleasedBodyDeleter
+}
+
+// NewMockIReleasedBodyDeleter creates a new mock instance.
+func NewMockIReleasedBodyDeleter(ctrl *gomock.Controller) *MockIReleasedBodyDeleter {
+	mock := &MockIReleasedBodyDeleter{ctrl: ctrl}
+	mock.recorder = &MockIReleasedBodyDeleterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIReleasedBodyDeleter) EXPECT() *MockIReleasedBodyDeleterMockRecorder {
+	return m.recorder
+}
+
+// DeleteReleasedBodies mocks base method.
+func (m *MockIReleasedBodyDeleter) DeleteReleasedBodies(ctx context.Context, limit int, deleteFn func(context.Context, []entity.ResultID) ([]entity.DeletionFailure, error)) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteReleasedBodies", ctx, limit, deleteFn)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteReleasedBodies indicates an expected call of DeleteReleasedBodies.
+func (mr *MockIReleasedBodyDeleterMockRecorder) DeleteReleasedBodies(ctx, limit, deleteFn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteReleasedBodies", reflect.TypeOf((*MockIReleasedBodyDeleter)(nil).DeleteReleasedBodies), ctx, limit, deleteFn)
+}
+
 // MockICollectionReader is a mock of ICollectionReader interface.
 type MockICollectionReader struct {
 	ctrl     *gomock.Controller
@@ -152,17 +337,52 @@ func (m *MockICollectionReader) EXPECT() *MockICollectionReaderMockRecorder {
 	return m.recorder
 }
 
-// GetCollections mocks base method.
-func (m *MockICollectionReader) GetCollections(ctx context.Context, filter entity.CollectionFilter) ([]entity.Collection, error) {
+// GetExpiredCollections mocks base method.
+func (m *MockICollectionReader) GetExpiredCollections(ctx context.Context, now time.Time, settings entity.RetentionSettings) ([]entity.Collection, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetCollections", ctx, filter)
+	ret := m.ctrl.Call(m, "GetExpiredCollections", ctx, now, settings)
 	ret0, _ := ret[0].([]entity.Collection)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetCollections indicates an expected call of GetCollections.
-func (mr *MockICollectionReaderMockRecorder) GetCollections(ctx, filter any) *gomock.Call {
+// GetExpiredCollections indicates an expected call of GetExpiredCollections.
+func (mr *MockICollectionReaderMockRecorder) GetExpiredCollections(ctx, now, settings any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredCollections", reflect.TypeOf((*MockICollectionReader)(nil).GetExpiredCollections), ctx, now, settings)
+}
+
+// MockIMetrics is a mock of IMetrics interface.
+type MockIMetrics struct {
+	ctrl     *gomock.Controller
+	recorder *MockIMetricsMockRecorder
+}
+
+// MockIMetricsMockRecorder is the mock recorder for MockIMetrics.
+type MockIMetricsMockRecorder struct {
+	mock *MockIMetrics
+}
+
+// NewMockIMetrics creates a new mock instance.
+func NewMockIMetrics(ctrl *gomock.Controller) *MockIMetrics {
+	mock := &MockIMetrics{ctrl: ctrl}
+	mock.recorder = &MockIMetricsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIMetrics) EXPECT() *MockIMetricsMockRecorder {
+	return m.recorder
+}
+
+// ObserveObjectStorageDeleteErrors mocks base method.
+func (m *MockIMetrics) ObserveObjectStorageDeleteErrors(ctx context.Context, count int) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ObserveObjectStorageDeleteErrors", ctx, count)
+}
+
+// ObserveObjectStorageDeleteErrors indicates an expected call of ObserveObjectStorageDeleteErrors.
+func (mr *MockIMetricsMockRecorder) ObserveObjectStorageDeleteErrors(ctx, count any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCollections", reflect.TypeOf((*MockICollectionReader)(nil).GetCollections), ctx, filter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ObserveObjectStorageDeleteErrors", reflect.TypeOf((*MockIMetrics)(nil).ObserveObjectStorageDeleteErrors), ctx, count)
 }
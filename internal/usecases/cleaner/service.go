@@ -9,32 +9,44 @@ import (
 	"github.com/n-r-w/bootstrap"
 	"github.com/n-r-w/bootstrap/executor"
 	"github.com/n-r-w/collector/internal/config"
+	"github.com/n-r-w/collector/internal/entity"
 	"github.com/n-r-w/ctxlog"
 )
 
 // Service is responsible for cleaning up database.
 type Service struct {
 	cfg                  *config.Config
+	retentionSettings    entity.RetentionSettings
 	now                  func() time.Time // for testing
 	executor             *executor.Service
 	collectionReader     ICollectionReader
 	locker               ILocker
 	databaseCleaner      IDatabaseCleaner
+	partitionManager     IPartitionManager
 	objectStorageCleaner IObjectStorageCleaner
+	pendingDeletions     IPendingDeletionStorer
+	releasedBodies       IReleasedBodyDeleter
+	metrics              IMetrics
 }
 
 // New creates new cleanup service.
 func New(
 	cfg *config.Config, locker ILocker, collectionReader ICollectionReader,
-	databaseCleaner IDatabaseCleaner, objectStorageCleaner IObjectStorageCleaner,
+	databaseCleaner IDatabaseCleaner, partitionManager IPartitionManager, objectStorageCleaner IObjectStorageCleaner,
+	pendingDeletions IPendingDeletionStorer, releasedBodies IReleasedBodyDeleter, metrics IMetrics,
 ) (*Service, error) {
 	s := &Service{
 		cfg:                  cfg,
+		retentionSettings:    newRetentionSettings(cfg),
 		now:                  time.Now,
 		locker:               locker,
 		collectionReader:     collectionReader,
 		databaseCleaner:      databaseCleaner,
+		partitionManager:     partitionManager,
 		objectStorageCleaner: objectStorageCleaner,
+		pendingDeletions:     pendingDeletions,
+		releasedBodies:       releasedBodies,
+		metrics:              metrics,
 	}
 
 	var err error
@@ -53,6 +65,26 @@ func New(
 	return s, nil
 }
 
+// newRetentionSettings creates global retention settings from the configuration.
+func newRetentionSettings(cfg *config.Config) entity.RetentionSettings {
+	settings := entity.RetentionSettings{
+		Default:  cfg.Collection.RetentionPeriod,
+		ByStatus: make(map[entity.CollectionStatus]time.Duration),
+	}
+
+	for status, period := range map[entity.CollectionStatus]time.Duration{
+		entity.StatusCompleted: cfg.Collection.CompletedRetentionPeriod,
+		entity.StatusFailed:    cfg.Collection.FailedRetentionPeriod,
+		entity.StatusCancelled: cfg.Collection.CancelledRetentionPeriod,
+	} {
+		if period > 0 {
+			settings.ByStatus[status] = period
+		}
+	}
+
+	return settings
+}
+
 var _ bootstrap.IService = (*Service)(nil)
 
 // Info returns service info. Implements bootstrap.IService Info method.
@@ -5,61 +5,77 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/n-r-w/collector/internal/entity"
 	"github.com/n-r-w/ctxlog"
 	"github.com/samber/lo"
-	"github.com/samber/mo"
 )
 
 // worker cleans up database.
 func (s *Service) worker(ctx context.Context) error {
 	ctxlog.Debug(ctx, "starting to clean up collections")
 
-	// get all collections
-	collections, err := s.collectionReader.GetCollections(ctx, entity.CollectionFilter{
-		ToTime: mo.Some(s.now().Add(-s.cfg.Collection.RetentionPeriod)),
-	})
+	now := s.now()
+
+	// get expired collections
+	collections, err := s.collectionReader.GetExpiredCollections(ctx, now, s.retentionSettings)
 	if err != nil {
 		return fmt.Errorf("get collections: %w", err)
 	}
 
-	if len(collections) == 0 {
-		ctxlog.Debug(ctx, "no collections to clean up")
-		return nil
+	// get objects that failed to be deleted during previous runs
+	pending, err := s.pendingDeletions.GetPendingDeletions(ctx, s.cfg.Collection.CleanupRetryLimit)
+	if err != nil {
+		return fmt.Errorf("get pending deletions: %w", err)
 	}
 
-	// try to get a lock
+	// try to get a lock, it only excludes other cleaners: the cleanup is committed by batches
+	// independently of the transaction holding the lock
 	acquired, err := s.locker.TryLockFunc(ctx, entity.CleanUpLockKey,
 		func(ctxLock context.Context) error {
 			// cleanup database
-			toCleanupDB := lo.Map(collections, func(c entity.Collection, index int) entity.CollectionID {
-				return c.ID
-			})
-			if errCleanup := s.databaseCleaner.CleanDatabase(ctxLock, toCleanupDB); errCleanup != nil {
-				return fmt.Errorf("clean collections: %w", errCleanup)
+			if len(collections) > 0 {
+				toCleanupDB := lo.Map(collections, func(c entity.Collection, index int) entity.CollectionID {
+					return c.ID
+				})
+				if errCleanup := s.databaseCleaner.CleanDatabase(ctxLock, toCleanupDB); errCleanup != nil {
+					return fmt.Errorf("clean collections: %w", errCleanup)
+				}
+			}
+
+			// requests of completed collections are not needed anymore, the result is in the object storage
+			errPurge := s.databaseCleaner.PurgeCompletedRequests(ctxLock,
+				now.Add(-s.cfg.Collection.CompletedRequestsRetention))
+			if errPurge != nil {
+				return fmt.Errorf("purge completed requests: %w", errPurge)
+			}
+
+			// bodies are shared between requests, so they are removed separately
+			if errBodies := s.databaseCleaner.DeleteUnusedBodies(ctxLock); errBodies != nil {
+				return fmt.Errorf("delete unused bodies: %w", errBodies)
+			}
+
+			if errReleased := s.deleteReleasedBodies(ctxLock); errReleased != nil {
+				return fmt.Errorf("delete released bodies: %w", errReleased)
 			}
 
 			// cleanup object storage
-			var toCleanupObjectStorage []entity.ResultID
+			toCleanupObjectStorage := pending
 			for _, c := range collections {
 				if c.ResultID.IsAbsent() {
 					continue
 				}
 				toCleanupObjectStorage = append(toCleanupObjectStorage, c.ResultID.OrEmpty())
 			}
+			toCleanupObjectStorage = lo.Uniq(toCleanupObjectStorage)
 
 			if len(toCleanupObjectStorage) == 0 {
 				ctxlog.Debug(ctx, "no object storage to clean up")
 				return nil
 			}
 
-			// cleanup object storage
-			if errCleanup := s.objectStorageCleaner.CleanObjectStorage(ctxLock, toCleanupObjectStorage); errCleanup != nil {
-				return fmt.Errorf("clean object storage: %w", errCleanup)
-			}
-
-			return nil
+			return s.cleanObjectStorage(ctxLock, toCleanupObjectStorage, pending)
 		})
 	if err != nil {
 		if errors.Is(err, context.Canceled) {
@@ -76,5 +92,107 @@ func (s *Service) worker(ctx context.Context) error {
 		ctxlog.Debug(ctx, "cleanup lock is already acquired, skipping")
 	}
 
+	// partitions are maintained in a separate short transaction, because it locks the requests tables
+	acquired, err = s.locker.TryLockFunc(ctx, entity.PartitionLockKey,
+		func(ctxLock context.Context) error {
+			return s.maintainPartitions(ctxLock, now)
+		})
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return nil
+		}
+		ctxlog.Error(ctx, "failed to maintain partitions", slog.Any("error", err))
+
+		return err
+	}
+
+	if !acquired {
+		ctxlog.Debug(ctx, "partition lock is already acquired, skipping")
+	}
+
+	return nil
+}
+
+// maintainPartitions drops expired partitions of collected requests and creates new ones in advance.
+func (s *Service) maintainPartitions(ctx context.Context, now time.Time) error {
+	if err := s.partitionManager.DropPartitions(ctx, now); err != nil {
+		return fmt.Errorf("drop partitions: %w", err)
+	}
+
+	until := now.Add(time.Duration(s.cfg.Collection.PartitionPrecreate+1) * s.cfg.Collection.PartitionInterval)
+	if err := s.partitionManager.CreatePartitions(ctx, now, until); err != nil {
+		return fmt.Errorf("create partitions: %w", err)
+	}
+
 	return nil
 }
+
+// deleteReleasedBodies deletes all released objects of request bodies by batches.
+// Objects that failed to be deleted are returned to the queue and retried later.
+func (s *Service) deleteReleasedBodies(ctx context.Context) error {
+	batchSize := s.cfg.Collection.CleanupBatchSize
+
+	for {
+		processed, err := s.releasedBodies.DeleteReleasedBodies(ctx, batchSize, s.deleteObjects)
+		if err != nil {
+			return err
+		}
+
+		if processed < batchSize {
+			return nil
+		}
+	}
+}
+
+// cleanObjectStorage deletes objects from object storage and records failed deletions
+// in order to retry them during the next run.
+func (s *Service) cleanObjectStorage(ctx context.Context, resultIDs, pending []entity.ResultID) error {
+	failures, err := s.deleteObjects(ctx, resultIDs)
+	if err != nil {
+		return err
+	}
+
+	failed := lo.SliceToMap(failures, func(f entity.DeletionFailure) (entity.ResultID, struct{}) {
+		return f.ResultID, struct{}{}
+	})
+
+	// forget objects that were retried successfully
+	retried := lo.Filter(pending, func(id entity.ResultID, _ int) bool {
+		_, ok := failed[id]
+		return !ok
+	})
+	if len(retried) > 0 {
+		if err := s.pendingDeletions.RemovePendingDeletions(ctx, retried); err != nil {
+			return fmt.Errorf("remove pending deletions: %w", err)
+		}
+	}
+
+	if len(failures) == 0 {
+		return nil
+	}
+
+	if err := s.pendingDeletions.SavePendingDeletions(ctx, failures); err != nil {
+		return fmt.Errorf("save pending deletions: %w", err)
+	}
+
+	return nil
+}
+
+// deleteObjects deletes objects from object storage and returns the failed deletions.
+func (s *Service) deleteObjects(ctx context.Context, resultIDs []entity.ResultID) ([]entity.DeletionFailure, error) {
+	failures, err := s.objectStorageCleaner.CleanObjectStorage(ctx, resultIDs)
+	if err != nil {
+		return nil, fmt.Errorf("clean object storage: %w", err)
+	}
+
+	if len(failures) == 0 {
+		return nil, nil
+	}
+
+	s.metrics.ObserveObjectStorageDeleteErrors(ctx, len(failures))
+	ctxlog.Warn(ctx, "failed to delete objects from object storage, will retry later",
+		slog.Int("count", len(failures)),
+		slog.String("first_error", failures[0].Reason))
+
+	return failures, nil
+}
@@ -27,18 +27,31 @@ func TestWorker(t *testing.T) {
 		mockDB := NewMockIDatabaseCleaner(ctrl)
 		mockOS := NewMockIObjectStorageCleaner(ctrl)
 		mockReader := NewMockICollectionReader(ctrl)
+		mockPending := NewMockIPendingDeletionStorer(ctrl)
+		mockPartitions := NewMockIPartitionManager(ctrl)
+		mockReleased := NewMockIReleasedBodyDeleter(ctrl)
 
 		now := time.Now()
 
 		cfg := &config.Config{}
 		cfg.Collection.RetentionPeriod = time.Hour * 24 * 7
+		cfg.Collection.CompletedRetentionPeriod = time.Hour * 24
+		cfg.Collection.CompletedRequestsRetention = time.Hour
+		cfg.Collection.CleanupRetryLimit = 100
+		cfg.Collection.PartitionInterval = time.Hour * 24
+		cfg.Collection.PartitionPrecreate = 2
+		cfg.Collection.CleanupBatchSize = 10
 
 		svc := &Service{
 			locker:               mockLocker,
 			databaseCleaner:      mockDB,
+			partitionManager:     mockPartitions,
 			objectStorageCleaner: mockOS,
 			collectionReader:     mockReader,
+			pendingDeletions:     mockPending,
+			releasedBodies:       mockReleased,
 			cfg:                  cfg,
+			retentionSettings:    newRetentionSettings(cfg),
 			now:                  func() time.Time { return now },
 		}
 
@@ -48,11 +61,15 @@ func TestWorker(t *testing.T) {
 			ResultID:  mo.Some(entity.ResultID("result-id")),
 		}}
 
-		mockReader.EXPECT().GetCollections(gomock.Any(),
-			entity.CollectionFilter{
-				ToTime: mo.Some(now.Add(-time.Hour * 24 * 7)),
+		settings := entity.RetentionSettings{
+			Default: time.Hour * 24 * 7,
+			ByStatus: map[entity.CollectionStatus]time.Duration{
+				entity.StatusCompleted: time.Hour * 24,
 			},
-		).Return(collections, nil)
+		}
+
+		mockReader.EXPECT().GetExpiredCollections(gomock.Any(), now, settings).Return(collections, nil)
+		mockPending.EXPECT().GetPendingDeletions(gomock.Any(), 100).Return(nil, nil)
 
 		mockLocker.EXPECT().TryLockFunc(gomock.Any(), entity.CleanUpLockKey, gomock.Any()).
 			DoAndReturn(func(ctx context.Context, key entity.LockKey, fn func(context.Context) error) (bool, error) {
@@ -60,7 +77,17 @@ func TestWorker(t *testing.T) {
 			})
 
 		mockDB.EXPECT().CleanDatabase(gomock.Any(), []entity.CollectionID{entity.CollectionID(1)}).Return(nil)
-		mockOS.EXPECT().CleanObjectStorage(gomock.Any(), []entity.ResultID{entity.ResultID("result-id")}).Return(nil)
+		mockDB.EXPECT().PurgeCompletedRequests(gomock.Any(), now.Add(-time.Hour)).Return(nil)
+		mockDB.EXPECT().DeleteUnusedBodies(gomock.Any()).Return(nil)
+		mockReleased.EXPECT().DeleteReleasedBodies(gomock.Any(), 10, gomock.Any()).Return(0, nil)
+		mockOS.EXPECT().CleanObjectStorage(gomock.Any(), []entity.ResultID{entity.ResultID("result-id")}).Return(nil, nil)
+
+		mockLocker.EXPECT().TryLockFunc(gomock.Any(), entity.PartitionLockKey, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, key entity.LockKey, fn func(context.Context) error) (bool, error) {
+				return true, fn(ctx)
+			})
+		mockPartitions.EXPECT().DropPartitions(gomock.Any(), now).Return(nil)
+		mockPartitions.EXPECT().CreatePartitions(gomock.Any(), now, now.Add(time.Hour*24*3)).Return(nil)
 
 		err := svc.worker(ctx)
 		require.NoError(t, err)
@@ -73,6 +100,7 @@ func TestWorker(t *testing.T) {
 		mockReader := NewMockICollectionReader(ctrl)
 		mockDB := NewMockIDatabaseCleaner(ctrl)
 		mockOS := NewMockIObjectStorageCleaner(ctrl)
+		mockPending := NewMockIPendingDeletionStorer(ctrl)
 
 		now := time.Now()
 
@@ -84,6 +112,7 @@ func TestWorker(t *testing.T) {
 			collectionReader:     mockReader,
 			databaseCleaner:      mockDB,
 			objectStorageCleaner: mockOS,
+			pendingDeletions:     mockPending,
 			cfg:                  cfg,
 			now:                  func() time.Time { return now },
 		}
@@ -93,13 +122,155 @@ func TestWorker(t *testing.T) {
 			CreatedAt: now.Add(-time.Hour * 24 * 8), // 8 days old
 		}}
 
-		mockReader.EXPECT().GetCollections(gomock.Any(),
-			entity.CollectionFilter{
-				ToTime: mo.Some(now.Add(-time.Hour * 24 * 7)),
-			},
-		).Return(collections, nil)
+		mockReader.EXPECT().GetExpiredCollections(gomock.Any(), now, gomock.Any()).Return(collections, nil)
+		mockPending.EXPECT().GetPendingDeletions(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+		mockLocker.EXPECT().TryLockFunc(gomock.Any(), entity.CleanUpLockKey, gomock.Any()).
+			Return(false, nil)
+		mockLocker.EXPECT().TryLockFunc(gomock.Any(), entity.PartitionLockKey, gomock.Any()).
+			Return(false, nil)
+
+		err := svc.worker(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("failed deletions are retried", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockLocker := NewMockILocker(ctrl)
+		mockDB := NewMockIDatabaseCleaner(ctrl)
+		mockOS := NewMockIObjectStorageCleaner(ctrl)
+		mockReader := NewMockICollectionReader(ctrl)
+		mockPending := NewMockIPendingDeletionStorer(ctrl)
+		mockReleased := NewMockIReleasedBodyDeleter(ctrl)
+		mockMetrics := NewMockIMetrics(ctrl)
+
+		now := time.Now()
+
+		cfg := &config.Config{}
+		cfg.Collection.RetentionPeriod = time.Hour * 24 * 7
+		cfg.Collection.CleanupRetryLimit = 100
+		cfg.Collection.CleanupBatchSize = 10
+
+		svc := &Service{
+			locker:               mockLocker,
+			databaseCleaner:      mockDB,
+			objectStorageCleaner: mockOS,
+			collectionReader:     mockReader,
+			pendingDeletions:     mockPending,
+			releasedBodies:       mockReleased,
+			metrics:              mockMetrics,
+			cfg:                  cfg,
+			now:                  func() time.Time { return now },
+		}
+
+		collections := []entity.Collection{{
+			ID:        entity.CollectionID(1),
+			CreatedAt: now.Add(-time.Hour * 24 * 8), // 8 days old
+			ResultID:  mo.Some(entity.ResultID("result-id")),
+		}}
+
+		mockReader.EXPECT().GetExpiredCollections(gomock.Any(), gomock.Any(), gomock.Any()).Return(collections, nil)
+		mockPending.EXPECT().GetPendingDeletions(gomock.Any(), 100).
+			Return([]entity.ResultID{"pending-1", "pending-2"}, nil)
 
 		mockLocker.EXPECT().TryLockFunc(gomock.Any(), entity.CleanUpLockKey, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, key entity.LockKey, fn func(context.Context) error) (bool, error) {
+				return true, fn(ctx)
+			})
+
+		failures := []entity.DeletionFailure{
+			{ResultID: "pending-2", Reason: "AccessDenied"},
+			{ResultID: "result-id", Reason: "AccessDenied"},
+		}
+
+		mockDB.EXPECT().CleanDatabase(gomock.Any(), []entity.CollectionID{entity.CollectionID(1)}).Return(nil)
+		mockDB.EXPECT().PurgeCompletedRequests(gomock.Any(), now).Return(nil)
+		mockDB.EXPECT().DeleteUnusedBodies(gomock.Any()).Return(nil)
+		mockReleased.EXPECT().DeleteReleasedBodies(gomock.Any(), 10, gomock.Any()).Return(0, nil)
+		mockOS.EXPECT().CleanObjectStorage(gomock.Any(),
+			[]entity.ResultID{"pending-1", "pending-2", "result-id"}).Return(failures, nil)
+		mockPending.EXPECT().RemovePendingDeletions(gomock.Any(), []entity.ResultID{"pending-1"}).Return(nil)
+		mockMetrics.EXPECT().ObserveObjectStorageDeleteErrors(gomock.Any(), 2)
+		mockPending.EXPECT().SavePendingDeletions(gomock.Any(), failures).Return(nil)
+		mockLocker.EXPECT().TryLockFunc(gomock.Any(), entity.PartitionLockKey, gomock.Any()).
+			Return(false, nil)
+
+		err := svc.worker(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("only pending deletions", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockLocker := NewMockILocker(ctrl)
+		mockDB := NewMockIDatabaseCleaner(ctrl)
+		mockOS := NewMockIObjectStorageCleaner(ctrl)
+		mockReader := NewMockICollectionReader(ctrl)
+		mockPending := NewMockIPendingDeletionStorer(ctrl)
+		mockReleased := NewMockIReleasedBodyDeleter(ctrl)
+		mockMetrics := NewMockIMetrics(ctrl)
+
+		now := time.Now()
+
+		cfg := &config.Config{}
+		cfg.Collection.RetentionPeriod = time.Hour * 24 * 7
+		cfg.Collection.CleanupBatchSize = 1
+
+		svc := &Service{
+			locker:               mockLocker,
+			databaseCleaner:      mockDB,
+			objectStorageCleaner: mockOS,
+			collectionReader:     mockReader,
+			pendingDeletions:     mockPending,
+			releasedBodies:       mockReleased,
+			metrics:              mockMetrics,
+			cfg:                  cfg,
+			now:                  func() time.Time { return now },
+		}
+
+		mockReader.EXPECT().GetExpiredCollections(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil)
+		mockPending.EXPECT().GetPendingDeletions(gomock.Any(), gomock.Any()).
+			Return([]entity.ResultID{"pending-1"}, nil)
+
+		mockLocker.EXPECT().TryLockFunc(gomock.Any(), entity.CleanUpLockKey, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, key entity.LockKey, fn func(context.Context) error) (bool, error) {
+				return true, fn(ctx)
+			})
+
+		mockDB.EXPECT().PurgeCompletedRequests(gomock.Any(), now).Return(nil)
+		mockDB.EXPECT().DeleteUnusedBodies(gomock.Any()).Return(nil)
+
+		// released bodies are deleted by batches until the queue is drained,
+		// failed deletions are returned to the queue
+		released := []entity.ResultID{"bodies/1.json", "bodies/2.json"}
+		failure := entity.DeletionFailure{ResultID: "bodies/2.json", Reason: "AccessDenied"}
+		for i, resultID := range released {
+			mockReleased.EXPECT().DeleteReleasedBodies(gomock.Any(), 1, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, _ int,
+					deleteFn func(context.Context, []entity.ResultID) ([]entity.DeletionFailure, error),
+				) (int, error) {
+					failures, err := deleteFn(ctx, []entity.ResultID{resultID})
+					if i == 0 {
+						require.Empty(t, failures)
+					} else {
+						require.Equal(t, []entity.DeletionFailure{failure}, failures)
+					}
+					return 1, err
+				})
+			if i == 0 {
+				mockOS.EXPECT().CleanObjectStorage(gomock.Any(), []entity.ResultID{resultID}).Return(nil, nil)
+				continue
+			}
+			mockOS.EXPECT().CleanObjectStorage(gomock.Any(), []entity.ResultID{resultID}).
+				Return([]entity.DeletionFailure{failure}, nil)
+			mockMetrics.EXPECT().ObserveObjectStorageDeleteErrors(gomock.Any(), 1)
+		}
+		mockReleased.EXPECT().DeleteReleasedBodies(gomock.Any(), 1, gomock.Any()).Return(0, nil)
+
+		mockOS.EXPECT().CleanObjectStorage(gomock.Any(), []entity.ResultID{"pending-1"}).Return(nil, nil)
+		mockPending.EXPECT().RemovePendingDeletions(gomock.Any(), []entity.ResultID{"pending-1"}).Return(nil)
+		mockLocker.EXPECT().TryLockFunc(gomock.Any(), entity.PartitionLockKey, gomock.Any()).
 			Return(false, nil)
 
 		err := svc.worker(ctx)
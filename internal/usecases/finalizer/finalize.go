@@ -56,6 +56,12 @@ func (s *Service) finalizeCollections(ctxMain context.Context, collections []ent
 				return nil
 			}
 
+			if acquired && err != nil && !errors.Is(err, context.Canceled) {
+				// the transaction is rolled back and the lock is released, so the failure is saved separately.
+				// Only active collections are changed, so a collection finalized in the meantime is kept
+				s.recordFailure(ctxMain, collection.ID, err.Error())
+			}
+
 			if acquired {
 				ctxlog.Debug(ctxMain, "finalizer lock released",
 					slog.String("collection_id", collection.ID.String()))
@@ -95,6 +101,25 @@ func (s *Service) finalizeCollections(ctxMain context.Context, collections []ent
 func (s *Service) finalizeCollectionHelper(ctx context.Context, collection entity.Collection) error {
 	ctxlog.Debug(ctx, "finalizing collection", slog.String("collection_id", collection.ID.String()))
 
+	if !collection.HasEnoughRequests() {
+		message := fmt.Sprintf("collected %d requests of minimum %d",
+			collection.RequestCount, collection.Task.Completion.MinRequestCount)
+		if err := s.statusChanger.FailCollection(
+			ctx, collection.ID, entity.ErrorCodeNotEnoughRequests, message); err != nil {
+			if errors.Is(err, entity.ErrCollectionNotFound) {
+				// it's ok if someone else already finalized collection
+				return nil
+			}
+
+			return fmt.Errorf("failed to mark collection as failed: %w", err)
+		}
+
+		ctxlog.Debug(ctx, "collection failed", slog.String("collection_id", collection.ID.String()),
+			slog.String("error", message))
+
+		return nil
+	}
+
 	// if collection has no requests, skip result saving
 	if collection.RequestCount > 0 {
 		// Fetch requestsCh for this collection
@@ -103,6 +128,9 @@ func (s *Service) finalizeCollectionHelper(ctx context.Context, collection entit
 			return fmt.Errorf("failed to fetch collection requests for collection %d: %w", collection.ID, err)
 		}
 
+		// bodies stored in the object storage are fetched while the result is being saved
+		requestsCh = s.resolveBodies(ctx, requestsCh)
+
 		// save results
 		// We are actually saving results in S3 inside a PostgreSQL transaction. This is bad.
 		// But in this case, a long transaction is allowed, because:
@@ -136,3 +164,61 @@ func (s *Service) finalizeCollectionHelper(ctx context.Context, collection entit
 
 	return nil
 }
+
+// recordFailure records the error of a failed finalization attempt. The collection is finalized again,
+// until the number of attempts reaches the limit and the collection is failed. Errors are only logged.
+func (s *Service) recordFailure(ctx context.Context, collectionID entity.CollectionID, message string) {
+	failed, err := s.statusChanger.RecordFinalizationFailure(
+		ctx, collectionID, message, s.cfg.Collection.FinalizerMaxAttempts)
+	if err != nil {
+		if !errors.Is(err, entity.ErrCollectionNotFound) {
+			ctxlog.Error(ctx, "failed to record finalization failure",
+				slog.String("collection_id", collectionID.String()), slog.Any("error", err))
+		}
+		return
+	}
+
+	if failed {
+		ctxlog.Warn(ctx, "collection failed after finalization attempts",
+			slog.String("collection_id", collectionID.String()),
+			slog.Int("max_attempts", s.cfg.Collection.FinalizerMaxAttempts),
+			slog.String("error", message))
+	}
+}
+
+// resolveBodies replaces references to bodies stored in the object storage with the bodies themselves.
+// Reading stops after the first error.
+func (s *Service) resolveBodies(ctx context.Context, in <-chan entity.RequestChunk) <-chan entity.RequestChunk {
+	out := make(chan entity.RequestChunk, cap(in))
+	go func() {
+		defer close(out)
+		// drain the input channel to release its producer
+		defer func() {
+			for range in {
+			}
+		}()
+
+		for chunk := range in {
+			if ref, ok := chunk.BodyRef.Get(); ok && chunk.Err == nil {
+				body, err := s.bodyGetter.GetBody(ctx, ref)
+				if err != nil {
+					chunk = entity.RequestChunk{Err: fmt.Errorf("failed to get body: %w", err)}
+				} else {
+					chunk = entity.RequestChunk{Data: body, Tag: chunk.Tag}
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case out <- chunk:
+			}
+
+			if chunk.Err != nil {
+				return
+			}
+		}
+	}()
+
+	return out
+}
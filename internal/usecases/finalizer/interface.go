@@ -17,6 +17,12 @@ type ICollectionReader interface {
 // IStatusChanger is responsible for changing the status of collection.
 type IStatusChanger interface {
 	UpdateStatus(ctx context.Context, collectionID entity.CollectionID, status entity.CollectionStatus) error
+	// FailCollection marks an active collection as failed with the error code and message.
+	FailCollection(ctx context.Context, collectionID entity.CollectionID, code entity.ErrorCode, message string) error
+	// RecordFinalizationFailure records the error of a failed finalization attempt of an active collection.
+	// The collection is failed, when the number of failed attempts reaches maxAttempts.
+	RecordFinalizationFailure(
+		ctx context.Context, collectionID entity.CollectionID, message string, maxAttempts int) (failed bool, err error)
 }
 
 // IResultChanGetter is responsible for retrieving collection results.
@@ -30,6 +36,11 @@ type IResultChanSaver interface {
 		ctx context.Context, collectionID entity.CollectionID, requests <-chan entity.RequestChunk) (entity.ResultID, error)
 }
 
+// IBodyGetter is responsible for retrieving request bodies stored in the object storage.
+type IBodyGetter interface {
+	GetBody(ctx context.Context, ref entity.BodyRef) ([]byte, error)
+}
+
 // ICollectionResultUpdater is responsible for updating collection result ID.
 type ICollectionResultUpdater interface {
 	UpdateResultID(ctx context.Context, collectionID entity.CollectionID, resultID entity.ResultID) error
@@ -78,6 +78,35 @@ func (m *MockIStatusChanger) EXPECT() *MockIStatusChangerMockRecorder {
 	return m.recorder
 }
 
+// FailCollection mocks base method.
+func (m *MockIStatusChanger) FailCollection(ctx context.Context, collectionID entity.CollectionID, code entity.ErrorCode, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailCollection", ctx, collectionID, code, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailCollection indicates an expected call of FailCollection.
+func (mr *MockIStatusChangerMockRecorder) FailCollection(ctx, collectionID, code, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailCollection", reflect.TypeOf((*MockIStatusChanger)(nil).FailCollection), ctx, collectionID, code, message)
+}
+
+// RecordFinalizationFailure mocks base method.
+func (m *MockIStatusChanger) RecordFinalizationFailure(ctx context.Context, collectionID entity.CollectionID, message string, maxAttempts int) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordFinalizationFailure", ctx, collectionID, message, maxAttempts)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordFinalizationFailure indicates an expected call of RecordFinalizationFailure.
+func (mr *MockIStatusChangerMockRecorder) RecordFinalizationFailure(ctx, collectionID, message, maxAttempts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordFinalizationFailure", reflect.TypeOf((*MockIStatusChanger)(nil).RecordFinalizationFailure), ctx, collectionID, message, maxAttempts)
+}
+
 // UpdateStatus mocks base method.
 func (m *MockIStatusChanger) UpdateStatus(ctx context.Context, collectionID entity.CollectionID, status entity.CollectionStatus) error {
 	m.ctrl.T.Helper()
@@ -168,6 +197,44 @@ func (mr *MockIResultChanSaverMockRecorder) SaveResultChan(ctx, collectionID, re
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveResultChan", reflect.TypeOf((*MockIResultChanSaver)(nil).SaveResultChan), ctx, collectionID, requests)
 }
 
+// MockIBodyGetter is a mock of IBodyGetter interface.
+type MockIBodyGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBodyGetterMockRecorder
+}
+
+// MockIBodyGetterMockRecorder is the mock recorder for MockIBodyGetter.
+type MockIBodyGetterMockRecorder struct {
+	mock *MockIBodyGetter
+}
+
+// NewMockIBodyGetter creates a new mock instance.
+func NewMockIBodyGetter(ctrl *gomock.Controller) *MockIBodyGetter {
+	mock := &MockIBodyGetter{ctrl: ctrl}
+	mock.recorder = &MockIBodyGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBodyGetter) EXPECT() *MockIBodyGetterMockRecorder {
+	return m.recorder
+}
+
+// GetBody mocks base method.
+func (m *MockIBodyGetter) GetBody(ctx context.Context, ref entity.BodyRef) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBody", ctx, ref)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBody indicates an expected call of GetBody.
+func (mr *MockIBodyGetterMockRecorder) GetBody(ctx, ref any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBody", reflect.TypeOf((*MockIBodyGetter)(nil).GetBody), ctx, ref)
+}
+
 // MockICollectionResultUpdater is a mock of ICollectionResultUpdater interface.
 type MockICollectionResultUpdater struct {
 	ctrl     *gomock.Controller
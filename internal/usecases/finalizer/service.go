@@ -20,6 +20,7 @@ type Service struct {
 	resultGetter     IResultChanGetter
 	resultSaver      IResultChanSaver
 	resultUpdater    ICollectionResultUpdater
+	bodyGetter       IBodyGetter
 	executor         *executor.Service
 	locker           ILocker
 
@@ -35,6 +36,7 @@ func New(
 	resultGetter IResultChanGetter,
 	resultSaver IResultChanSaver,
 	resultUpdater ICollectionResultUpdater,
+	bodyGetter IBodyGetter,
 	locker ILocker,
 ) (*Service, error) {
 	s := &Service{
@@ -44,6 +46,7 @@ func New(
 		resultGetter:     resultGetter,
 		resultSaver:      resultSaver,
 		resultUpdater:    resultUpdater,
+		bodyGetter:       bodyGetter,
 		locker:           locker,
 		cfg:              cfg,
 	}
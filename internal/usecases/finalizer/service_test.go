@@ -10,6 +10,7 @@ import (
 	"github.com/n-r-w/collector/internal/config"
 	"github.com/n-r-w/collector/internal/entity"
 	"github.com/n-r-w/ctxlog"
+	"github.com/samber/mo"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
@@ -30,6 +31,7 @@ func TestNew(t *testing.T) {
 			NewMockIResultChanGetter(ctrl),
 			NewMockIResultChanSaver(ctrl),
 			NewMockICollectionResultUpdater(ctrl),
+			NewMockIBodyGetter(ctrl),
 			NewMockILocker(ctrl),
 		)
 
@@ -56,6 +58,7 @@ func TestService_finalizeCollections(t *testing.T) {
 		mockResultSaver := NewMockIResultChanSaver(ctrl)
 		mockResultUpdater := NewMockICollectionResultUpdater(ctrl)
 		mockStatusChanger := NewMockIStatusChanger(ctrl)
+		mockBodyGetter := NewMockIBodyGetter(ctrl)
 
 		svc := &Service{
 			cfg:           cfg,
@@ -64,6 +67,7 @@ func TestService_finalizeCollections(t *testing.T) {
 			resultSaver:   mockResultSaver,
 			resultUpdater: mockResultUpdater,
 			statusChanger: mockStatusChanger,
+			bodyGetter:    mockBodyGetter,
 		}
 
 		collections := []entity.Collection{
@@ -80,7 +84,9 @@ func TestService_finalizeCollections(t *testing.T) {
 
 		resultChan := make(chan entity.RequestChunk)
 		go func() {
-			close(resultChan)
+			defer close(resultChan)
+			resultChan <- entity.RequestChunk{Data: []byte(`{"a": 1}`)}
+			resultChan <- entity.RequestChunk{BodyRef: mo.Some(entity.BodyRef("bodies/1.json"))}
 		}()
 
 		mockLocker.EXPECT().
@@ -93,9 +99,25 @@ func TestService_finalizeCollections(t *testing.T) {
 			GetResultChan(gomock.Any(), entity.CollectionID(1), 1000).
 			Return(resultChan, nil)
 
+		mockBodyGetter.EXPECT().
+			GetBody(gomock.Any(), entity.BodyRef("bodies/1.json")).
+			Return([]byte(`{"b": 2}`), nil)
+
+		// bodies stored in the object storage are resolved
 		mockResultSaver.EXPECT().
-			SaveResultChan(gomock.Any(), entity.CollectionID(1), resultChan).
-			Return(entity.ResultID("result-1"), nil)
+			SaveResultChan(gomock.Any(), entity.CollectionID(1), gomock.Any()).
+			DoAndReturn(func(
+				_ context.Context, _ entity.CollectionID, requests <-chan entity.RequestChunk,
+			) (entity.ResultID, error) {
+				var data []string
+				for chunk := range requests {
+					require.NoError(t, chunk.Err)
+					data = append(data, string(chunk.Data))
+				}
+				require.Equal(t, []string{`{"a": 1}`, `{"b": 2}`}, data)
+
+				return entity.ResultID("result-1"), nil
+			})
 
 		mockResultUpdater.EXPECT().
 			UpdateResultID(gomock.Any(), entity.CollectionID(1), entity.ResultID("result-1")).
@@ -109,6 +131,103 @@ func TestService_finalizeCollections(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("not enough requests", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		cfg := &config.Config{}
+		cfg.Collection.FinalizerConcurrency = 2
+		cfg.Collection.FinalizerMaxCollections = 10
+
+		mockLocker := NewMockILocker(ctrl)
+		mockStatusChanger := NewMockIStatusChanger(ctrl)
+
+		svc := &Service{
+			cfg:           cfg,
+			locker:        mockLocker,
+			statusChanger: mockStatusChanger,
+		}
+
+		collections := []entity.Collection{
+			{
+				ID:           entity.CollectionID(1),
+				RequestCount: 3,
+				Task: entity.Task{
+					Completion: entity.CompletionCriteria{
+						RequestCountLimit: 10000,
+						MinRequestCount:   100,
+					},
+				},
+			},
+		}
+
+		mockLocker.EXPECT().
+			TryLockFunc(gomock.Any(), entity.LockKey(1), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ entity.LockKey, fn func(context.Context) error) (bool, error) {
+				return true, fn(ctx)
+			})
+
+		// the result is not saved
+		mockStatusChanger.EXPECT().
+			FailCollection(gomock.Any(), entity.CollectionID(1), entity.ErrorCodeNotEnoughRequests,
+				"collected 3 requests of minimum 100").
+			Return(nil)
+
+		err := svc.finalizeCollections(ctx, collections)
+		require.NoError(t, err)
+	})
+
+	t.Run("finalization error is saved", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		cfg := &config.Config{}
+		cfg.Collection.FinalizerConcurrency = 2
+		cfg.Collection.FinalizerMaxCollections = 10
+		cfg.Collection.FinalizerMaxAttempts = 3
+
+		mockLocker := NewMockILocker(ctrl)
+		mockResultGetter := NewMockIResultChanGetter(ctrl)
+		mockStatusChanger := NewMockIStatusChanger(ctrl)
+
+		svc := &Service{
+			cfg:           cfg,
+			locker:        mockLocker,
+			resultGetter:  mockResultGetter,
+			statusChanger: mockStatusChanger,
+		}
+
+		collections := []entity.Collection{
+			{
+				ID:           entity.CollectionID(1),
+				RequestCount: 100,
+				Task: entity.Task{
+					Completion: entity.CompletionCriteria{
+						RequestCountLimit: 1000,
+					},
+				},
+			},
+		}
+
+		mockLocker.EXPECT().
+			TryLockFunc(gomock.Any(), entity.LockKey(1), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ entity.LockKey, fn func(context.Context) error) (bool, error) {
+				return true, fn(ctx)
+			})
+
+		mockResultGetter.EXPECT().
+			GetResultChan(gomock.Any(), entity.CollectionID(1), 1000).
+			Return(nil, errors.New("db error"))
+
+		mockStatusChanger.EXPECT().
+			RecordFinalizationFailure(gomock.Any(), entity.CollectionID(1), gomock.Any(), 3).
+			DoAndReturn(func(_ context.Context, _ entity.CollectionID, message string, _ int) (bool, error) {
+				require.Contains(t, message, "db error")
+				return false, nil
+			})
+
+		err := svc.finalizeCollections(ctx, collections)
+		require.Error(t, err)
+	})
+
 	t.Run("lock already acquired", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 
@@ -228,6 +347,149 @@ func TestService_worker(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("full reservoir waits for time limit", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockReader := NewMockICollectionReader(ctrl)
+
+		svc := &Service{
+			collectionReader: mockReader,
+		}
+
+		collections := []entity.Collection{
+			{
+				ID:           entity.CollectionID(1),
+				RequestCount: 10,
+				CreatedAt:    time.Now(),
+				Task: entity.Task{
+					Completion: entity.CompletionCriteria{
+						TimeLimit:         time.Hour,
+						RequestCountLimit: 10,
+						Reservoir:         true,
+					},
+				},
+			},
+		}
+
+		mockReader.EXPECT().
+			GetCollections(gomock.Any(), entity.CollectionFilter{
+				Statuses: entity.ActiveCollectionStatuses(),
+			}).
+			Return(collections, nil)
+
+		// no lock is expected, the collection is not finalized
+		err := svc.worker(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("idle and byte size limits", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		cfg := &config.Config{}
+		cfg.Collection.FinalizerConcurrency = 2
+		cfg.Collection.FinalizerMaxCollections = 10
+
+		mockReader := NewMockICollectionReader(ctrl)
+		mockLocker := NewMockILocker(ctrl)
+
+		svc := &Service{
+			cfg:              cfg,
+			collectionReader: mockReader,
+			locker:           mockLocker,
+		}
+
+		now := time.Now()
+		completion := entity.CompletionCriteria{
+			TimeLimit:         time.Hour,
+			RequestCountLimit: 100,
+			IdleTimeout:       time.Minute,
+			ByteSizeLimit:     1000,
+		}
+		collections := []entity.Collection{
+			{ // idle
+				ID:            entity.CollectionID(1),
+				CreatedAt:     now.Add(-time.Minute * 10),
+				LastRequestAt: mo.Some(now.Add(-time.Minute * 2)),
+				Task:          entity.Task{Completion: completion},
+			},
+			{ // out of byte size limit
+				ID:            entity.CollectionID(2),
+				CreatedAt:     now.Add(-time.Minute * 10),
+				LastRequestAt: mo.Some(now),
+				ByteCount:     1000,
+				Task:          entity.Task{Completion: completion},
+			},
+			{ // active
+				ID:            entity.CollectionID(3),
+				CreatedAt:     now.Add(-time.Minute * 10),
+				LastRequestAt: mo.Some(now),
+				ByteCount:     999,
+				Task:          entity.Task{Completion: completion},
+			},
+		}
+
+		mockReader.EXPECT().
+			GetCollections(gomock.Any(), entity.CollectionFilter{
+				Statuses: entity.ActiveCollectionStatuses(),
+			}).
+			Return(collections, nil)
+
+		mockLocker.EXPECT().
+			TryLockFunc(gomock.Any(), entity.LockKey(1), gomock.Any()).
+			Return(false, nil)
+		mockLocker.EXPECT().
+			TryLockFunc(gomock.Any(), entity.LockKey(2), gomock.Any()).
+			Return(false, nil)
+
+		err := svc.worker(ctx)
+		require.NoError(t, err)
+	})
+
+	t.Run("completed ahead of time", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		cfg := &config.Config{}
+		cfg.Collection.FinalizerConcurrency = 2
+		cfg.Collection.FinalizerMaxCollections = 10
+
+		mockReader := NewMockICollectionReader(ctrl)
+		mockLocker := NewMockILocker(ctrl)
+
+		svc := &Service{
+			cfg:              cfg,
+			collectionReader: mockReader,
+			locker:           mockLocker,
+		}
+
+		collections := []entity.Collection{
+			{
+				ID:           entity.CollectionID(1),
+				Status:       entity.StatusFinalizing,
+				RequestCount: 5,
+				CreatedAt:    time.Now(),
+				Task: entity.Task{
+					Completion: entity.CompletionCriteria{
+						TimeLimit:         time.Hour,
+						RequestCountLimit: 100,
+					},
+				},
+			},
+		}
+
+		mockReader.EXPECT().
+			GetCollections(gomock.Any(), entity.CollectionFilter{
+				Statuses: entity.ActiveCollectionStatuses(),
+			}).
+			Return(collections, nil)
+
+		mockLocker.EXPECT().
+			TryLockFunc(gomock.Any(), entity.LockKey(1), gomock.Any()).
+			Return(false, nil)
+
+		err := svc.worker(ctx)
+		require.NoError(t, err)
+	})
+
 	t.Run("no collections", func(t *testing.T) {
 		ctrl := gomock.NewController(t)
 
@@ -26,7 +26,8 @@ func (s *Service) worker(ctx context.Context) error {
 
 	var toFinalize []entity.Collection
 	for _, collection := range collections {
-		if collection.IsOutOfTimeLimit() || collection.IsOutOfRequestLimit() {
+		// finalizing collections are completed ahead of time or were not finalized by the previous run
+		if collection.Status.IsFinalizing() || collection.IsOutOfTimeLimit() || collection.IsOutOfRequestLimit() {
 			toFinalize = append(toFinalize, collection)
 		}
 	}
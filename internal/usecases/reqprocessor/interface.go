@@ -11,9 +11,20 @@ import (
 // IRequestStorer is responsible for storing requests.
 type IRequestStorer interface {
 	Store(ctx context.Context, requests []entity.RequestContent, toStore []entity.MatchResult) error
+	// ClaimBodies returns the hashes of the bodies that must be kept in the database, because they are
+	// already stored or their objects are being deleted. Objects of the other bodies are removed from
+	// the released objects, so they are not deleted by the cleaner after being uploaded again.
+	ClaimBodies(ctx context.Context, hashes [][]byte) (map[string]struct{}, error)
+	// ReleaseBodies schedules deletion of uploaded bodies that are not used by stored requests.
+	ReleaseBodies(ctx context.Context, refs []entity.BodyRef) error
 }
 
 // ICollectionCacher is responsible for manage active collections cache.
 type ICollectionCacher interface {
 	Get() []entity.Collection
 }
+
+// IBodyStorer is responsible for storing large request bodies in the object storage.
+type IBodyStorer interface {
+	SaveBody(ctx context.Context, ref entity.BodyRef, body []byte) error
+}
@@ -40,6 +40,35 @@ func (m *MockIRequestStorer) EXPECT() *MockIRequestStorerMockRecorder {
 	return m.recorder
 }
 
+// ClaimBodies mocks base method.
+func (m *MockIRequestStorer) ClaimBodies(ctx context.Context, hashes [][]byte) (map[string]struct{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimBodies", ctx, hashes)
+	ret0, _ := ret[0].(map[string]struct{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimBodies indicates an expected call of ClaimBodies.
+func (mr *MockIRequestStorerMockRecorder) ClaimBodies(ctx, hashes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimBodies", reflect.TypeOf((*MockIRequestStorer)(nil).ClaimBodies), ctx, hashes)
+}
+
+// ReleaseBodies mocks base method.
+func (m *MockIRequestStorer) ReleaseBodies(ctx context.Context, refs []entity.BodyRef) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseBodies", ctx, refs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseBodies indicates an expected call of ReleaseBodies.
+func (mr *MockIRequestStorerMockRecorder) ReleaseBodies(ctx, refs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseBodies", reflect.TypeOf((*MockIRequestStorer)(nil).ReleaseBodies), ctx, refs)
+}
+
 // Store mocks base method.
 func (m *MockIRequestStorer) Store(ctx context.Context, requests []entity.RequestContent, toStore []entity.MatchResult) error {
 	m.ctrl.T.Helper()
@@ -90,3 +119,40 @@ func (mr *MockICollectionCacherMockRecorder) Get() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockICollectionCacher)(nil).Get))
 }
+
+// MockIBodyStorer is a mock of IBodyStorer interface.
+type MockIBodyStorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockIBodyStorerMockRecorder
+}
+
+// MockIBodyStorerMockRecorder is the mock recorder for MockIBodyStorer.
+type MockIBodyStorerMockRecorder struct {
+	mock *MockIBodyStorer
+}
+
+// NewMockIBodyStorer creates a new mock instance.
+func NewMockIBodyStorer(ctrl *gomock.Controller) *MockIBodyStorer {
+	mock := &MockIBodyStorer{ctrl: ctrl}
+	mock.recorder = &MockIBodyStorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIBodyStorer) EXPECT() *MockIBodyStorerMockRecorder {
+	return m.recorder
+}
+
+// SaveBody mocks base method.
+func (m *MockIBodyStorer) SaveBody(ctx context.Context, ref entity.BodyRef, body []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveBody", ctx, ref, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveBody indicates an expected call of SaveBody.
+func (mr *MockIBodyStorerMockRecorder) SaveBody(ctx, ref, body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveBody", reflect.TypeOf((*MockIBodyStorer)(nil).SaveBody), ctx, ref, body)
+}
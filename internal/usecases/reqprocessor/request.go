@@ -0,0 +1,45 @@
+package reqprocessor
+
+import (
+	"encoding/json"
+
+	"github.com/n-r-w/collector/internal/entity"
+)
+
+// lazyRequest decodes a JSON request body and prepares the expression input on first use,
+// so it is done only if some collection needs it.
+type lazyRequest struct {
+	entity.RequestContent
+
+	bodyDecoded bool
+	body        any
+	bodyValid   bool
+
+	input *entity.ExpressionInput
+}
+
+func newLazyRequest(request entity.RequestContent) *lazyRequest {
+	return &lazyRequest{RequestContent: request}
+}
+
+// decodedBody returns the decoded body. Returns false if the body is not a valid JSON.
+func (r *lazyRequest) decodedBody() (any, bool) {
+	if !r.bodyDecoded {
+		r.bodyDecoded = true
+		r.bodyValid = json.Unmarshal(r.Body, &r.body) == nil
+	}
+
+	return r.body, r.bodyValid
+}
+
+// expressionInput returns values of the selection expression variables.
+func (r *lazyRequest) expressionInput() entity.ExpressionInput {
+	if r.input == nil {
+		// body is nil if it is not a valid JSON, that is null in expressions
+		body, _ := r.decodedBody()
+		input := entity.NewExpressionInput(r.RequestContent, body)
+		r.input = &input
+	}
+
+	return *r.input
+}
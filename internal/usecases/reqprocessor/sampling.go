@@ -0,0 +1,81 @@
+package reqprocessor
+
+import (
+	"math/rand/v2"
+	"sync"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"golang.org/x/time/rate"
+)
+
+// noRule is the rule position of collections without rules.
+const noRule = -1
+
+// samplingKey identifies a selection criteria of a collection.
+type samplingKey struct {
+	collectionID entity.CollectionID
+	rule         int // position of the rule or noRule
+}
+
+// sampler thins out matching requests, so the collected requests are spread across the collection time window.
+type sampler struct {
+	mu       sync.Mutex
+	limiters map[samplingKey]*rate.Limiter
+	random   func() float64
+}
+
+func newSampler() *sampler {
+	return &sampler{
+		limiters: make(map[samplingKey]*rate.Limiter),
+		random:   rand.Float64, //nolint:gosec // sampling doesn't need a secure random
+	}
+}
+
+// allow checks if the matching request is collected according to the sample rate and the rate limit.
+func (s *sampler) allow(key samplingKey, criteria entity.MessageSelectionCriteria) bool {
+	if !criteria.IsSampled() {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if sampleRate, ok := criteria.SampleRate.Get(); ok && s.random() >= sampleRate {
+		return false
+	}
+
+	if criteria.MaxRequestsPerSecond <= 0 {
+		return true
+	}
+
+	limit := rate.Limit(criteria.MaxRequestsPerSecond)
+	limiter, ok := s.limiters[key]
+	if !ok || limiter.Limit() != limit {
+		// burst of one second allows collecting requests that arrive in batches
+		limiter = rate.NewLimiter(limit, criteria.MaxRequestsPerSecond)
+		s.limiters[key] = limiter
+	}
+
+	return limiter.Allow()
+}
+
+// retain removes limiters of collections that are not active anymore.
+func (s *sampler) retain(collections []entity.Collection) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.limiters) == 0 {
+		return
+	}
+
+	active := make(map[entity.CollectionID]struct{}, len(collections))
+	for _, collection := range collections {
+		active[collection.ID] = struct{}{}
+	}
+
+	for key := range s.limiters {
+		if _, ok := active[key.collectionID]; !ok {
+			delete(s.limiters, key)
+		}
+	}
+}
@@ -3,17 +3,24 @@ package reqprocessor
 import (
 	"context"
 	"fmt"
-	"strings"
+	"log/slog"
+	"slices"
 
 	"github.com/n-r-w/bootstrap"
+	"github.com/n-r-w/collector/internal/config"
 	"github.com/n-r-w/collector/internal/controller/consumer"
 	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/ctxlog"
+	"github.com/samber/mo"
 )
 
 // Service implements kafka.Handlers, bootstrap.IService interfaces.
 type Service struct {
+	cfg           *config.Config
 	requestStorer IRequestStorer
 	cacheGetter   ICollectionCacher
+	bodyStorer    IBodyStorer
+	sampler       *sampler
 }
 
 var (
@@ -23,12 +30,17 @@ var (
 
 // New creates a new RequestProcessor instance.
 func New(
+	cfg *config.Config,
 	requestStorer IRequestStorer,
 	cacheGetter ICollectionCacher,
+	bodyStorer IBodyStorer,
 ) *Service {
 	return &Service{
+		cfg:           cfg,
 		requestStorer: requestStorer,
 		cacheGetter:   cacheGetter,
+		bodyStorer:    bodyStorer,
+		sampler:       newSampler(),
 	}
 }
 
@@ -40,18 +52,50 @@ func (s *Service) HandleRequest(ctx context.Context, requests []entity.RequestCo
 		return nil
 	}
 
+	s.sampler.retain(collections)
+
 	// Find matching collections and store request
 	toStore := make([]entity.MatchResult, 0, len(requests))
 	for i, request := range requests {
 		match := entity.MatchResult{RequestPos: i}
+		lazy := newLazyRequest(request)
 		for _, collection := range collections {
 			if collection.Status != entity.StatusPending && collection.Status != entity.StatusInProgress {
 				continue
 			}
 
-			if s.matchesCriteria(request, collection.Task.MessageSelection) {
+			if collection.IsWaitingForStart(request.CreatedAt) {
+				continue
+			}
+
+			if len(collection.Task.Rules) > 0 {
+				ruleIDs := s.matchingRules(ctx, lazy, collection.ID, collection.Task.Rules)
+				if len(ruleIDs) == 0 {
+					continue
+				}
+
+				if match.RuleIDs == nil {
+					match.RuleIDs = make(map[entity.CollectionID][]int)
+				}
+				match.RuleIDs[collection.ID] = ruleIDs
 				match.CollectionIDs = append(match.CollectionIDs, collection.ID)
+
+				continue
 			}
+
+			if !s.matchesCriteria(ctx, lazy, collection.Task.MessageSelection) ||
+				!s.sampler.allow(samplingKey{collectionID: collection.ID, rule: noRule}, collection.Task.MessageSelection) {
+				continue
+			}
+
+			// the limit per value is applied when the request is stored
+			if stratification, ok := collection.Task.Stratification.Get(); ok {
+				if match.Strata == nil {
+					match.Strata = make(map[entity.CollectionID]string)
+				}
+				match.Strata[collection.ID] = stratification.Value(lazy.Headers, lazy.decodedBody)
+			}
+			match.CollectionIDs = append(match.CollectionIDs, collection.ID)
 		}
 
 		if len(match.CollectionIDs) > 0 {
@@ -71,10 +115,38 @@ func (s *Service) HandleRequest(ctx context.Context, requests []entity.RequestCo
 	return nil
 }
 
+// matchingRules returns positions of the rules matching the request.
+// The rule that collects the request is chosen when it is stored, depending on the remaining quotas.
+func (s *Service) matchingRules(
+	ctx context.Context, request *lazyRequest, collectionID entity.CollectionID, rules []entity.SelectionRule,
+) []int {
+	var ruleIDs []int
+	for i, rule := range rules {
+		if s.matchesCriteria(ctx, request, rule.Selection) &&
+			s.sampler.allow(samplingKey{collectionID: collectionID, rule: i}, rule.Selection) {
+			ruleIDs = append(ruleIDs, i)
+		}
+	}
+
+	return ruleIDs
+}
+
 // matchesCriteria checks if the request matches the collection criteria.
-func (s *Service) matchesCriteria(request entity.RequestContent, criteria entity.MessageSelectionCriteria,
+func (s *Service) matchesCriteria(
+	ctx context.Context, request *lazyRequest, criteria entity.MessageSelectionCriteria,
 ) bool {
-	if !strings.EqualFold(request.Handler, criteria.Handler) {
+	if !criteria.MatchHandler(request.Handler) {
+		return false
+	}
+
+	return s.matchesHeaders(request.RequestContent, criteria) &&
+		s.matchesBody(request, criteria) &&
+		s.matchesExpression(ctx, request, criteria)
+}
+
+// matchesHeaders checks if the request headers match the header criteria and the header group.
+func (s *Service) matchesHeaders(request entity.RequestContent, criteria entity.MessageSelectionCriteria) bool {
+	if group, ok := criteria.HeaderGroup.Get(); ok && !group.Match(request.Headers) {
 		return false
 	}
 
@@ -84,35 +156,156 @@ func (s *Service) matchesCriteria(request entity.RequestContent, criteria entity
 	}
 
 	// if at least one header matches, the request is considered matching
-	for header, values := range request.Headers {
-		for _, ch := range criteria.HeaderCriteria {
-			if !strings.EqualFold(header, ch.HeaderName) {
-				continue
-			}
-
-			for _, value := range values {
-				if ch.Pattern.MatchString(value) {
-					return true
-				}
-			}
+	for _, ch := range criteria.HeaderCriteria {
+		if ch.Match(request.Headers) {
+			return true
 		}
 	}
 
 	return false
 }
 
+// matchesBody checks if the request body matches all body criteria.
+func (s *Service) matchesBody(request *lazyRequest, criteria entity.MessageSelectionCriteria) bool {
+	if len(criteria.BodyCriteria) == 0 {
+		return true
+	}
+
+	// requests without a valid JSON body don't match any body criteria
+	doc, ok := request.decodedBody()
+	if !ok {
+		return false
+	}
+
+	for _, c := range criteria.BodyCriteria {
+		if !c.Match(doc) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchesExpression checks if the request matches the selection expression.
+func (s *Service) matchesExpression(
+	ctx context.Context, request *lazyRequest, criteria entity.MessageSelectionCriteria,
+) bool {
+	if !criteria.Expression.IsSet() {
+		return true
+	}
+
+	// expressions that failed to compile don't match any requests
+	if !criteria.Expression.IsCompiled() {
+		return false
+	}
+
+	matched, err := criteria.Expression.Eval(ctx, request.expressionInput())
+	if err != nil {
+		// errors are expected for requests without fields used by the expression
+		ctxlog.Debug(ctx, "failed to evaluate selection expression",
+			slog.String("expression", criteria.Expression.Source), slog.Any("error", err))
+		return false
+	}
+
+	return matched
+}
+
 // storeRequest stores the request in the collection and updates collection counters.
 func (s *Service) storeRequest(
 	ctx context.Context, requests []entity.RequestContent, toStore []entity.MatchResult,
 ) error {
+	requests, uploaded, err := s.spillBodies(ctx, requests, toStore)
+	if err != nil {
+		return err
+	}
+
 	// Store request
 	if err := s.requestStorer.Store(ctx, requests, toStore); err != nil {
+		// the uploaded bodies are not referenced, because the transaction is rolled back
+		s.releaseBodies(ctx, uploaded)
+
 		return fmt.Errorf("failed to store request: %w", err)
 	}
 
 	return nil
 }
 
+// spillBodies stores large bodies of the matched requests in the object storage.
+// Bodies are stored by content hash, bodies that are already stored are not uploaded again.
+// The bodies are kept in the requests, because they are still used to calculate body hashes.
+// Returns the requests with references to the stored bodies and the uploaded references.
+func (s *Service) spillBodies(
+	ctx context.Context, requests []entity.RequestContent, toStore []entity.MatchResult,
+) ([]entity.RequestContent, []entity.BodyRef, error) {
+	threshold := s.cfg.S3.BodySpillThreshold
+	if threshold <= 0 {
+		return requests, nil, nil
+	}
+
+	// positions of the large bodies by content hash
+	positions := make(map[string][]int)
+	var hashes [][]byte
+	for _, match := range toStore {
+		body := requests[match.RequestPos].Body
+		if len(body) <= threshold {
+			continue
+		}
+
+		hash := entity.HashBody(body)
+		if _, ok := positions[string(hash)]; !ok {
+			hashes = append(hashes, hash)
+		}
+		positions[string(hash)] = append(positions[string(hash)], match.RequestPos)
+	}
+
+	if len(hashes) == 0 {
+		return requests, nil, nil
+	}
+
+	// bodies already stored or being deleted from the object storage are kept in the database
+	kept, err := s.requestStorer.ClaimBodies(ctx, hashes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to claim bodies: %w", err)
+	}
+
+	// don't modify the caller's batch
+	spilled := slices.Clone(requests)
+
+	var uploaded []entity.BodyRef
+	for _, hash := range hashes {
+		if _, ok := kept[string(hash)]; ok {
+			continue
+		}
+
+		pos := positions[string(hash)]
+		ref := entity.NewBodyRef(hash)
+		if err := s.bodyStorer.SaveBody(ctx, ref, requests[pos[0]].Body); err != nil {
+			s.releaseBodies(ctx, uploaded)
+
+			return nil, nil, fmt.Errorf("failed to save body: %w", err)
+		}
+		uploaded = append(uploaded, ref)
+
+		for _, p := range pos {
+			spilled[p].BodyRef = mo.Some(ref)
+		}
+	}
+
+	return spilled, uploaded, nil
+}
+
+// releaseBodies schedules deletion of uploaded bodies that are not stored.
+// Bodies are released even if the context is canceled, otherwise they would be left in the object storage.
+func (s *Service) releaseBodies(ctx context.Context, refs []entity.BodyRef) {
+	if len(refs) == 0 {
+		return
+	}
+
+	if err := s.requestStorer.ReleaseBodies(context.WithoutCancel(ctx), refs); err != nil {
+		ctxlog.Error(ctx, "failed to release uploaded bodies", slog.Any("error", err))
+	}
+}
+
 // Info returns service info. Implements bootstrap.IService Info method.
 func (s *Service) Info() bootstrap.Info {
 	return bootstrap.Info{
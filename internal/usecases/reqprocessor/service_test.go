@@ -5,10 +5,14 @@ import (
 	"errors"
 	"log/slog"
 	"regexp"
+	"slices"
 	"testing"
+	"time"
 
+	"github.com/n-r-w/collector/internal/config"
 	"github.com/n-r-w/collector/internal/entity"
 	"github.com/n-r-w/ctxlog"
+	"github.com/samber/mo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -38,7 +42,7 @@ func TestService_HandleRequest(t *testing.T) {
 
 				cacheGetter.EXPECT().Get().Return(nil)
 
-				svc := New(requestStorer, cacheGetter)
+				svc := New(&config.Config{}, requestStorer, cacheGetter, NewMockIBodyStorer(ctrl))
 				return svc, []entity.RequestContent{{Handler: "test"}}
 			},
 			wantErr: false,
@@ -63,7 +67,7 @@ func TestService_HandleRequest(t *testing.T) {
 					},
 				})
 
-				svc := New(requestStorer, cacheGetter)
+				svc := New(&config.Config{}, requestStorer, cacheGetter, NewMockIBodyStorer(ctrl))
 				return svc, []entity.RequestContent{{Handler: "test"}}
 			},
 			wantErr: false,
@@ -116,7 +120,223 @@ func TestService_HandleRequest(t *testing.T) {
 					Store(gomock.Any(), requests, expectedMatches).
 					Return(nil)
 
-				svc := New(requestStorer, cacheGetter)
+				svc := New(&config.Config{}, requestStorer, cacheGetter, NewMockIBodyStorer(ctrl))
+				return svc, requests
+			},
+			wantErr: false,
+		},
+		{
+			name: "large body is stored in object storage",
+			setup: func(t *testing.T) (*Service, []entity.RequestContent) {
+				ctrl := gomock.NewController(t)
+
+				requestStorer := NewMockIRequestStorer(ctrl)
+				cacheGetter := NewMockICollectionCacher(ctrl)
+				bodyStorer := NewMockIBodyStorer(ctrl)
+
+				cfg := &config.Config{}
+				cfg.S3.BodySpillThreshold = 4
+
+				collections := []entity.Collection{
+					{
+						ID:     1,
+						Status: entity.StatusPending,
+						Task: entity.Task{
+							MessageSelection: entity.MessageSelectionCriteria{
+								Handler: "test",
+							},
+						},
+					},
+				}
+
+				requests := []entity.RequestContent{
+					{Handler: "test", Body: []byte(`{}`)},
+					{Handler: "test", Body: []byte(`{"a": 1}`)},
+					{Handler: "test", Body: []byte(`{"a":1}`)},
+					{Handler: "test", Body: []byte(`{"c": 3}`)},
+					{Handler: "other", Body: []byte(`{"b": 2}`)},
+				}
+				expectedMatches := []entity.MatchResult{
+					{RequestPos: 0, CollectionIDs: []entity.CollectionID{1}},
+					{RequestPos: 1, CollectionIDs: []entity.CollectionID{1}},
+					{RequestPos: 2, CollectionIDs: []entity.CollectionID{1}},
+					{RequestPos: 3, CollectionIDs: []entity.CollectionID{1}},
+				}
+
+				// bodies with the same content are uploaded once, already stored bodies are not uploaded
+				hashA := entity.HashBody([]byte(`{"a": 1}`))
+				hashC := entity.HashBody([]byte(`{"c": 3}`))
+				refA := entity.NewBodyRef(hashA)
+
+				expectedRequests := slices.Clone(requests)
+				expectedRequests[1].BodyRef = mo.Some(refA)
+				expectedRequests[2].BodyRef = mo.Some(refA)
+
+				cacheGetter.EXPECT().Get().Return(collections)
+				requestStorer.EXPECT().
+					ClaimBodies(gomock.Any(), [][]byte{hashA, hashC}).
+					Return(map[string]struct{}{string(hashC): {}}, nil)
+				bodyStorer.EXPECT().
+					SaveBody(gomock.Any(), refA, []byte(`{"a": 1}`)).
+					Return(nil)
+				requestStorer.EXPECT().
+					Store(gomock.Any(), expectedRequests, expectedMatches).
+					Return(nil)
+
+				svc := New(cfg, requestStorer, cacheGetter, bodyStorer)
+				return svc, requests
+			},
+			wantErr: false,
+		},
+		{
+			name: "uploaded bodies are released if storing fails",
+			setup: func(t *testing.T) (*Service, []entity.RequestContent) {
+				ctrl := gomock.NewController(t)
+
+				requestStorer := NewMockIRequestStorer(ctrl)
+				cacheGetter := NewMockICollectionCacher(ctrl)
+				bodyStorer := NewMockIBodyStorer(ctrl)
+
+				cfg := &config.Config{}
+				cfg.S3.BodySpillThreshold = 4
+
+				collections := []entity.Collection{
+					{
+						ID:     1,
+						Status: entity.StatusPending,
+						Task: entity.Task{
+							MessageSelection: entity.MessageSelectionCriteria{
+								Handler: "test",
+							},
+						},
+					},
+				}
+
+				requests := []entity.RequestContent{{Handler: "test", Body: []byte(`{"a": 1}`)}}
+				hash := entity.HashBody(requests[0].Body)
+				ref := entity.NewBodyRef(hash)
+
+				cacheGetter.EXPECT().Get().Return(collections)
+				requestStorer.EXPECT().ClaimBodies(gomock.Any(), [][]byte{hash}).Return(nil, nil)
+				bodyStorer.EXPECT().SaveBody(gomock.Any(), ref, requests[0].Body).Return(nil)
+				requestStorer.EXPECT().Store(gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("db error"))
+				requestStorer.EXPECT().ReleaseBodies(gomock.Any(), []entity.BodyRef{ref}).Return(nil)
+
+				svc := New(cfg, requestStorer, cacheGetter, bodyStorer)
+				return svc, requests
+			},
+			wantErr:   true,
+			errString: "db error",
+		},
+		{
+			name: "multi-rule collection",
+			setup: func(t *testing.T) (*Service, []entity.RequestContent) {
+				ctrl := gomock.NewController(t)
+
+				requestStorer := NewMockIRequestStorer(ctrl)
+				cacheGetter := NewMockICollectionCacher(ctrl)
+
+				collections := []entity.Collection{
+					{
+						ID:     1,
+						Status: entity.StatusInProgress,
+						Task: entity.Task{
+							Rules: []entity.SelectionRule{
+								{Name: "search", Selection: entity.MessageSelectionCriteria{Handler: "search"}},
+								{Name: "any", Selection: entity.MessageSelectionCriteria{
+									Handler:         "*",
+									HandlerCriteria: mo.Some(mustHandlerCriteria(t, entity.HandlerMatchGlob, "*")),
+								}},
+							},
+						},
+					},
+				}
+
+				requests := []entity.RequestContent{
+					{Handler: "search"},
+					{Handler: "checkout"},
+					{Handler: "api/other"},
+				}
+				expectedMatches := []entity.MatchResult{
+					{
+						RequestPos:    0,
+						CollectionIDs: []entity.CollectionID{1},
+						RuleIDs:       map[entity.CollectionID][]int{1: {0, 1}},
+					},
+					{
+						RequestPos:    1,
+						CollectionIDs: []entity.CollectionID{1},
+						RuleIDs:       map[entity.CollectionID][]int{1: {1}},
+					},
+				}
+
+				cacheGetter.EXPECT().Get().Return(collections)
+				requestStorer.EXPECT().
+					Store(gomock.Any(), requests, expectedMatches).
+					Return(nil)
+
+				svc := New(&config.Config{}, requestStorer, cacheGetter, NewMockIBodyStorer(ctrl))
+				return svc, requests
+			},
+			wantErr: false,
+		},
+		{
+			name: "stratified collection",
+			setup: func(t *testing.T) (*Service, []entity.RequestContent) {
+				ctrl := gomock.NewController(t)
+
+				requestStorer := NewMockIRequestStorer(ctrl)
+				cacheGetter := NewMockICollectionCacher(ctrl)
+
+				path, err := entity.ParseJSONPath("$.user.id")
+				require.NoError(t, err)
+
+				collections := []entity.Collection{
+					{
+						ID:     1,
+						Status: entity.StatusInProgress,
+						Task: entity.Task{
+							MessageSelection: entity.MessageSelectionCriteria{Handler: "search"},
+							Stratification:   mo.Some(entity.Stratification{BodyPath: mo.Some(path), MaxPerValue: 1}),
+						},
+					},
+					{
+						ID:     2,
+						Status: entity.StatusInProgress,
+						Task: entity.Task{
+							MessageSelection: entity.MessageSelectionCriteria{Handler: "search"},
+							Stratification:   mo.Some(entity.Stratification{HeaderName: "X-Tenant", MaxPerValue: 1}),
+						},
+					},
+				}
+
+				requests := []entity.RequestContent{
+					{
+						Handler: "search",
+						Headers: map[string][]string{"x-tenant": {"acme"}},
+						Body:    []byte(`{"user":{"id":42}}`),
+					},
+					{Handler: "search", Body: []byte(`not json`)},
+				}
+				expectedMatches := []entity.MatchResult{
+					{
+						RequestPos:    0,
+						CollectionIDs: []entity.CollectionID{1, 2},
+						Strata:        map[entity.CollectionID]string{1: "42", 2: "acme"},
+					},
+					{
+						RequestPos:    1,
+						CollectionIDs: []entity.CollectionID{1, 2},
+						Strata:        map[entity.CollectionID]string{1: "", 2: ""},
+					},
+				}
+
+				cacheGetter.EXPECT().Get().Return(collections)
+				requestStorer.EXPECT().
+					Store(gomock.Any(), requests, expectedMatches).
+					Return(nil)
+
+				svc := New(&config.Config{}, requestStorer, cacheGetter, NewMockIBodyStorer(ctrl))
 				return svc, requests
 			},
 			wantErr: false,
@@ -154,7 +374,7 @@ func TestService_HandleRequest(t *testing.T) {
 					Store(gomock.Any(), requests, expectedMatches).
 					Return(errors.New("store error"))
 
-				svc := New(requestStorer, cacheGetter)
+				svc := New(&config.Config{}, requestStorer, cacheGetter, NewMockIBodyStorer(ctrl))
 				return svc, requests
 			},
 			wantErr:   true,
@@ -179,3 +399,260 @@ func TestService_HandleRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestService_MatchesBody(t *testing.T) {
+	t.Parallel()
+
+	body := `{"tier": "premium", "items": [{"price": 10.5}], "flags": {"a.b": true}, "empty": null}`
+
+	tests := []struct {
+		name     string
+		path     string
+		operator entity.BodyOperator
+		value    string
+		body     string
+		want     bool
+	}{
+		{name: "equal string", path: "$.tier", operator: entity.BodyOperatorEqual, value: `"premium"`, want: true},
+		{name: "not equal string", path: "$.tier", operator: entity.BodyOperatorEqual, value: `"basic"`},
+		{name: "equal number", path: "$.items[0].price", operator: entity.BodyOperatorEqual, value: `10.5`, want: true},
+		{name: "equal quoted key", path: `$.flags["a.b"]`, operator: entity.BodyOperatorEqual, value: `true`, want: true},
+		{name: "regex", path: "$.tier", operator: entity.BodyOperatorRegex, value: `^prem`, want: true},
+		{name: "regex of not a string", path: "$.items", operator: entity.BodyOperatorRegex, value: `.*`},
+		{name: "exists", path: "$.items[0]", operator: entity.BodyOperatorExists, want: true},
+		{name: "exists null", path: "$.empty", operator: entity.BodyOperatorExists, want: true},
+		{name: "not exists", path: "$.items[1]", operator: entity.BodyOperatorExists},
+		{name: "greater", path: "$.items[0].price", operator: entity.BodyOperatorGreater, value: "10", want: true},
+		{name: "less or equal", path: "$.items[0].price", operator: entity.BodyOperatorLessOrEqual, value: "10"},
+		{name: "compare not a number", path: "$.tier", operator: entity.BodyOperatorLess, value: "10"},
+		{name: "not a JSON body", path: "$", operator: entity.BodyOperatorExists, body: "text"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			criteria, err := entity.NewBodyCriteria(tt.path, tt.operator, tt.value)
+			require.NoError(t, err)
+
+			requestBody := body
+			if tt.body != "" {
+				requestBody = tt.body
+			}
+
+			request := entity.RequestContent{Handler: "test", Body: []byte(requestBody)}
+			selection := entity.MessageSelectionCriteria{
+				Handler:      "test",
+				BodyCriteria: []entity.BodyCriteria{criteria},
+			}
+
+			svc := New(&config.Config{}, nil, nil, nil)
+			require.Equal(t, tt.want, svc.matchesCriteria(context.Background(), newLazyRequest(request), selection))
+		})
+	}
+}
+
+func TestService_MatchesExpression(t *testing.T) {
+	t.Parallel()
+
+	ctx := ctxlog.MustContext(context.Background(), ctxlog.WithTesting(t))
+
+	request := entity.RequestContent{
+		Handler:   "test",
+		Headers:   map[string][]string{"X-Tier": {"premium"}},
+		Body:      []byte(`{"items": [{"price": 1}, {"price": 2}, {"price": 3}]}`),
+		CreatedAt: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		name       string
+		expression string
+		costLimit  uint64
+		body       string
+		want       bool
+	}{
+		{name: "handler", expression: `handler == "test"`, want: true},
+		{name: "headers", expression: `headers["x-tier"][0] == "premium"`, want: true},
+		{name: "body", expression: `body.items.exists(i, i.price > 2)`, want: true},
+		{name: "timestamp", expression: `timestamp.getHours() == 12`, want: true},
+		{name: "not matching", expression: `size(body.items) > 3`},
+		{name: "missing field", expression: `body.tier == "premium"`},
+		{name: "not a JSON body", expression: `body == null`, body: "text", want: true},
+		{name: "cost limit exceeded", expression: `body.items.all(i, i.price > 0)`, costLimit: 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			costLimit := tt.costLimit
+			if costLimit == 0 {
+				costLimit = 1000
+			}
+
+			expression, err := entity.CompileExpression(tt.expression, costLimit)
+			require.NoError(t, err)
+
+			request := request
+			if tt.body != "" {
+				request.Body = []byte(tt.body)
+			}
+
+			selection := entity.MessageSelectionCriteria{
+				Handler:    "test",
+				Expression: expression,
+			}
+
+			svc := New(&config.Config{}, nil, nil, nil)
+			require.Equal(t, tt.want, svc.matchesCriteria(ctx, newLazyRequest(request), selection))
+		})
+	}
+}
+
+func TestService_MatchesHeaderGroup(t *testing.T) {
+	t.Parallel()
+
+	header := func(name, pattern string) entity.HeaderCriteria {
+		return entity.HeaderCriteria{HeaderName: name, Pattern: regexp.MustCompile(pattern)}
+	}
+
+	// region=eu AND client=mobile AND NOT canary
+	group := entity.HeaderGroup{
+		Operator: entity.HeaderGroupAll,
+		Criteria: []entity.HeaderCriteria{header("region", "^eu$"), header("client", "^mobile$")},
+		Groups: []entity.HeaderGroup{
+			{
+				Operator: entity.HeaderGroupNone,
+				Criteria: []entity.HeaderCriteria{header("x-canary", "^true$")},
+			},
+		},
+	}
+	require.NoError(t, group.Validate())
+
+	tests := []struct {
+		name    string
+		headers map[string][]string
+		want    bool
+	}{
+		{
+			name:    "all match",
+			headers: map[string][]string{"Region": {"eu"}, "Client": {"mobile"}},
+			want:    true,
+		},
+		{
+			name:    "one doesn't match",
+			headers: map[string][]string{"Region": {"us"}, "Client": {"mobile"}},
+		},
+		{
+			name:    "excluded",
+			headers: map[string][]string{"Region": {"eu"}, "Client": {"mobile"}, "X-Canary": {"true"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			request := entity.RequestContent{Handler: "test", Headers: tt.headers}
+			selection := entity.MessageSelectionCriteria{
+				Handler:     "test",
+				HeaderGroup: mo.Some(group),
+			}
+
+			svc := New(&config.Config{}, nil, nil, nil)
+			require.Equal(t, tt.want, svc.matchesCriteria(context.Background(), newLazyRequest(request), selection))
+		})
+	}
+}
+
+func TestService_MatchesHandler(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		mode     entity.HandlerMatchMode
+		patterns []string
+		handler  string
+		want     bool
+	}{
+		{name: "exact", patterns: []string{"/api/orders"}, handler: "/API/orders", want: true},
+		{name: "exact list", patterns: []string{"/api/orders", "/api/users"}, handler: "/api/users", want: true},
+		{name: "exact mismatch", patterns: []string{"/api/orders"}, handler: "/api/orders/1"},
+		{
+			name: "prefix", mode: entity.HandlerMatchPrefix,
+			patterns: []string{"/api/v2/orders/"}, handler: "/api/v2/orders/1/items", want: true,
+		},
+		{
+			name: "glob", mode: entity.HandlerMatchGlob,
+			patterns: []string{"shop.OrderService/*"}, handler: "shop.OrderService/Create", want: true,
+		},
+		{
+			name: "glob doesn't cross separators", mode: entity.HandlerMatchGlob,
+			patterns: []string{"/api/v2/orders/*"}, handler: "/api/v2/orders/1/items",
+		},
+		{
+			name: "glob double star", mode: entity.HandlerMatchGlob,
+			patterns: []string{"/api/**/items"}, handler: "/api/v2/orders/1/items", want: true,
+		},
+		{
+			name: "glob escapes regexp", mode: entity.HandlerMatchGlob,
+			patterns: []string{"shop.Order?ervice/*"}, handler: "shopXOrderService/Create",
+		},
+		{
+			name: "regex", mode: entity.HandlerMatchRegex,
+			patterns: []string{`^/api/v\d+/orders$`}, handler: "/api/v3/orders", want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			handlerCriteria, err := entity.NewHandlerCriteria(tt.mode, tt.patterns)
+			require.NoError(t, err)
+
+			request := entity.RequestContent{Handler: tt.handler}
+			selection := entity.MessageSelectionCriteria{
+				Handler:         tt.patterns[0],
+				HandlerCriteria: mo.Some(handlerCriteria),
+			}
+
+			svc := New(&config.Config{}, nil, nil, nil)
+			require.Equal(t, tt.want, svc.matchesCriteria(context.Background(), newLazyRequest(request), selection))
+		})
+	}
+}
+
+func mustHandlerCriteria(t *testing.T, mode entity.HandlerMatchMode, patterns ...string) entity.HandlerCriteria {
+	t.Helper()
+
+	criteria, err := entity.NewHandlerCriteria(mode, patterns)
+	require.NoError(t, err)
+
+	return criteria
+}
+
+func TestSampler_Allow(t *testing.T) {
+	t.Parallel()
+
+	s := newSampler()
+	s.random = func() float64 { return 0.5 }
+
+	key := samplingKey{collectionID: 1, rule: noRule}
+	require.True(t, s.allow(key, entity.MessageSelectionCriteria{}))
+	require.False(t, s.allow(key, entity.MessageSelectionCriteria{SampleRate: mo.Some(0.5)}))
+	require.True(t, s.allow(key, entity.MessageSelectionCriteria{SampleRate: mo.Some(0.6)}))
+
+	// the burst is one second of requests
+	limited := entity.MessageSelectionCriteria{MaxRequestsPerSecond: 2}
+	require.True(t, s.allow(key, limited))
+	require.True(t, s.allow(key, limited))
+	require.False(t, s.allow(key, limited))
+
+	// rules have own limits
+	require.True(t, s.allow(samplingKey{collectionID: 1, rule: 0}, limited))
+
+	// limiters of inactive collections are removed
+	s.retain([]entity.Collection{{ID: 2}})
+	require.Empty(t, s.limiters)
+}
@@ -0,0 +1,32 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/n-r-w/collector/internal/entity"
+)
+
+//go:generate mockgen -source interface.go -destination interface_mock.go -package scheduler
+
+// ILocker is a service for locking resources.
+type ILocker interface {
+	// TryLockFunc executes a function with a lock.
+	TryLockFunc(ctx context.Context, key entity.LockKey, fn func(context.Context) error) (acquired bool, err error)
+}
+
+// IScheduleStorer is responsible for reading and updating schedules.
+type IScheduleStorer interface {
+	// GetDueSchedules returns schedules that must create a collection at the given time.
+	GetDueSchedules(ctx context.Context, now time.Time, limit int) ([]entity.Schedule, error)
+	// SetScheduleRun saves the collection created by the schedule and the time of the next one.
+	SetScheduleRun(ctx context.Context, run entity.ScheduleRun) error
+	// SkipScheduleRun moves the schedule to the next run without creating a collection.
+	SkipScheduleRun(ctx context.Context, id entity.ScheduleID, nextRunAt time.Time) error
+}
+
+// ICollectionCreator is responsible for creating new collections.
+type ICollectionCreator interface {
+	// CreateCollection creates a new collection with the given parameters and returns its ID.
+	CreateCollection(ctx context.Context, task entity.Task) (entity.CollectionID, error)
+}
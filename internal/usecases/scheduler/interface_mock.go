@@ -0,0 +1,161 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: interface.go
+//
+// Generated by this command:
+//
+//	mockgen -source interface.go -destination interface_mock.go -package scheduler
+//
+
+// Package scheduler is a generated GoMock package.
+package scheduler
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/n-r-w/collector/internal/entity"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockILocker is a mock of ILocker interface.
+type MockILocker struct {
+	ctrl     *gomock.Controller
+	recorder *MockILockerMockRecorder
+}
+
+// MockILockerMockRecorder is the mock recorder for MockILocker.
+type MockILockerMockRecorder struct {
+	mock *MockILocker
+}
+
+// NewMockILocker creates a new mock instance.
+func NewMockILocker(ctrl *gomock.Controller) *MockILocker {
+	mock := &MockILocker{ctrl: ctrl}
+	mock.recorder = &MockILockerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockILocker) EXPECT() *MockILockerMockRecorder {
+	return m.recorder
+}
+
+// TryLockFunc mocks base method.
+func (m *MockILocker) TryLockFunc(ctx context.Context, key entity.LockKey, fn func(context.Context) error) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TryLockFunc", ctx, key, fn)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TryLockFunc indicates an expected call of TryLockFunc.
+func (mr *MockILockerMockRecorder) TryLockFunc(ctx, key, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryLockFunc", reflect.TypeOf((*MockILocker)(nil).TryLockFunc), ctx, key, fn)
+}
+
+// MockIScheduleStorer is a mock of IScheduleStorer interface.
+type MockIScheduleStorer struct {
+	ctrl     *gomock.Controller
+	recorder *MockIScheduleStorerMockRecorder
+}
+
+// MockIScheduleStorerMockRecorder is the mock recorder for MockIScheduleStorer.
+type MockIScheduleStorerMockRecorder struct {
+	mock *MockIScheduleStorer
+}
+
+// NewMockIScheduleStorer creates a new mock instance.
+func NewMockIScheduleStorer(ctrl *gomock.Controller) *MockIScheduleStorer {
+	mock := &MockIScheduleStorer{ctrl: ctrl}
+	mock.recorder = &MockIScheduleStorerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIScheduleStorer) EXPECT() *MockIScheduleStorerMockRecorder {
+	return m.recorder
+}
+
+// GetDueSchedules mocks base method.
+func (m *MockIScheduleStorer) GetDueSchedules(ctx context.Context, now time.Time, limit int) ([]entity.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueSchedules", ctx, now, limit)
+	ret0, _ := ret[0].([]entity.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDueSchedules indicates an expected call of GetDueSchedules.
+func (mr *MockIScheduleStorerMockRecorder) GetDueSchedules(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueSchedules", reflect.TypeOf((*MockIScheduleStorer)(nil).GetDueSchedules), ctx, now, limit)
+}
+
+// SetScheduleRun mocks base method.
+func (m *MockIScheduleStorer) SetScheduleRun(ctx context.Context, run entity.ScheduleRun) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetScheduleRun", ctx, run)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetScheduleRun indicates an expected call of SetScheduleRun.
+func (mr *MockIScheduleStorerMockRecorder) SetScheduleRun(ctx, run any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetScheduleRun", reflect.TypeOf((*MockIScheduleStorer)(nil).SetScheduleRun), ctx, run)
+}
+
+// SkipScheduleRun mocks base method.
+func (m *MockIScheduleStorer) SkipScheduleRun(ctx context.Context, id entity.ScheduleID, nextRunAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SkipScheduleRun", ctx, id, nextRunAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SkipScheduleRun indicates an expected call of SkipScheduleRun.
+func (mr *MockIScheduleStorerMockRecorder) SkipScheduleRun(ctx, id, nextRunAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SkipScheduleRun", reflect.TypeOf((*MockIScheduleStorer)(nil).SkipScheduleRun), ctx, id, nextRunAt)
+}
+
+// MockICollectionCreator is a mock of ICollectionCreator interface.
+type MockICollectionCreator struct {
+	ctrl     *gomock.Controller
+	recorder *MockICollectionCreatorMockRecorder
+}
+
+// MockICollectionCreatorMockRecorder is the mock recorder for MockICollectionCreator.
+type MockICollectionCreatorMockRecorder struct {
+	mock *MockICollectionCreator
+}
+
+// NewMockICollectionCreator creates a new mock instance.
+func NewMockICollectionCreator(ctrl *gomock.Controller) *MockICollectionCreator {
+	mock := &MockICollectionCreator{ctrl: ctrl}
+	mock.recorder = &MockICollectionCreatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockICollectionCreator) EXPECT() *MockICollectionCreatorMockRecorder {
+	return m.recorder
+}
+
+// CreateCollection mocks base method.
+func (m *MockICollectionCreator) CreateCollection(ctx context.Context, task entity.Task) (entity.CollectionID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCollection", ctx, task)
+	ret0, _ := ret[0].(entity.CollectionID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCollection indicates an expected call of CreateCollection.
+func (mr *MockICollectionCreatorMockRecorder) CreateCollection(ctx, task any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCollection", reflect.TypeOf((*MockICollectionCreator)(nil).CreateCollection), ctx, task)
+}
@@ -0,0 +1,99 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/n-r-w/bootstrap"
+	"github.com/n-r-w/bootstrap/executor"
+	"github.com/n-r-w/collector/internal/config"
+	"github.com/n-r-w/ctxlog"
+	"github.com/n-r-w/pgh/v2/txmgr"
+)
+
+// Service creates collections by schedules.
+type Service struct {
+	cfg               *config.Config
+	now               func() time.Time // for testing
+	executor          *executor.Service
+	txManager         txmgr.ITransactionManager
+	locker            ILocker
+	scheduleStorer    IScheduleStorer
+	collectionCreator ICollectionCreator
+}
+
+// New creates new scheduler service.
+func New(
+	cfg *config.Config, txManager txmgr.ITransactionManager, locker ILocker, scheduleStorer IScheduleStorer,
+	collectionCreator ICollectionCreator,
+) (*Service, error) {
+	s := &Service{
+		cfg:               cfg,
+		now:               time.Now,
+		txManager:         txManager,
+		locker:            locker,
+		scheduleStorer:    scheduleStorer,
+		collectionCreator: collectionCreator,
+	}
+
+	var err error
+	s.executor, err = executor.New("scheduler",
+		&worker{service: s},
+		cfg.Collection.SchedulerInterval,
+		executor.WithJitter(cfg.Collection.SchedulerIntervalJitter),
+		executor.WithOnError(func(ctx context.Context, err error) {
+			ctxlog.Error(ctx, "scheduler error", slog.Any("error", err))
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("new executor: %w", err)
+	}
+
+	return s, nil
+}
+
+var _ bootstrap.IService = (*Service)(nil)
+
+// Info returns service info. Implements bootstrap.IService Info method.
+func (s *Service) Info() bootstrap.Info {
+	return bootstrap.Info{
+		Name: "Scheduler",
+	}
+}
+
+// Start starts the service. Implements bootstrap.IService Start method.
+func (s *Service) Start(ctx context.Context) error {
+	if err := s.executor.Start(ctx); err != nil {
+		return fmt.Errorf("start executor: %w", err)
+	}
+
+	return nil
+}
+
+// Stop stops the service. Implements bootstrap.IService Stop method.
+func (s *Service) Stop(ctx context.Context) error {
+	if err := s.executor.Stop(ctx); err != nil {
+		return fmt.Errorf("stop executor: %w", err)
+	}
+
+	return nil
+}
+
+// worker is an implementation of executor.IExecutor.
+type worker struct {
+	service *Service
+}
+
+var _ executor.IExecutor = (*worker)(nil)
+
+// Execute implements executor.Executor Execute method.
+func (w *worker) Execute(ctx context.Context) error {
+	return w.service.worker(ctx)
+}
+
+// StopExecutor implements executor.Executor StopExecutor method.
+func (w *worker) StopExecutor(_ context.Context) error {
+	return nil
+}
@@ -0,0 +1,110 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/ctxlog"
+)
+
+// worker creates collections of the due schedules.
+func (s *Service) worker(ctx context.Context) error {
+	now := s.now()
+
+	// the lock only excludes other schedulers, every schedule is run in its own transaction
+	acquired, err := s.locker.TryLockFunc(ctx, entity.ScheduleLockKey,
+		func(ctxLock context.Context) error {
+			return s.runSchedules(ctxLock, now)
+		})
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return nil
+		}
+		ctxlog.Error(ctx, "failed to run schedules", slog.Any("error", err))
+
+		return err
+	}
+
+	if !acquired {
+		ctxlog.Debug(ctx, "scheduler lock is already acquired, skipping")
+	}
+
+	return nil
+}
+
+// runSchedules creates a collection for every due schedule.
+// Runs missed while the service was down are not repeated, only one collection is created.
+// A schedule that fails to run is moved to the next run, so it doesn't prevent the others from running.
+func (s *Service) runSchedules(ctx context.Context, now time.Time) error {
+	schedules, err := s.scheduleStorer.GetDueSchedules(ctx, now, s.cfg.Collection.SchedulerMaxSchedules)
+	if err != nil {
+		return fmt.Errorf("get due schedules: %w", err)
+	}
+
+	// a failed run must not abort the transaction holding the lock
+	ctxRun := s.txManager.WithoutTransaction(ctx)
+
+	for _, schedule := range schedules {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var collectionID entity.CollectionID
+		err := s.txManager.Begin(ctxRun, func(ctxTr context.Context) error {
+			var err error
+			collectionID, err = s.runSchedule(ctxTr, schedule, now)
+			return err
+		})
+		if err != nil {
+			ctxlog.Error(ctx, "failed to run schedule, skipping to the next run",
+				slog.String("schedule_id", schedule.ID.String()),
+				slog.Any("error", err))
+
+			errSkip := s.scheduleStorer.SkipScheduleRun(ctxRun, schedule.ID, schedule.Cron.Next(now))
+			if errSkip != nil && !errors.Is(errSkip, entity.ErrScheduleNotFound) {
+				ctxlog.Error(ctx, "failed to skip schedule run",
+					slog.String("schedule_id", schedule.ID.String()),
+					slog.Any("error", errSkip))
+			}
+
+			continue
+		}
+
+		ctxlog.Debug(ctx, "collection created by schedule",
+			slog.String("schedule_id", schedule.ID.String()),
+			slog.String("collection_id", collectionID.String()))
+	}
+
+	return nil
+}
+
+// runSchedule creates a collection of the schedule and moves the schedule to the next run.
+func (s *Service) runSchedule(
+	ctx context.Context, schedule entity.Schedule, now time.Time,
+) (entity.CollectionID, error) {
+	// the limit could be lowered after the schedule was created
+	if limit := schedule.Task.Completion.RequestCountLimit; limit > s.cfg.Collection.MaxRequestsPerCollection {
+		return 0, fmt.Errorf("request count limit %d exceeds %d", limit, s.cfg.Collection.MaxRequestsPerCollection)
+	}
+
+	collectionID, err := s.collectionCreator.CreateCollection(ctx, schedule.Task)
+	if err != nil {
+		return 0, fmt.Errorf("create collection: %w", err)
+	}
+
+	run := entity.ScheduleRun{
+		ScheduleID:   schedule.ID,
+		CollectionID: collectionID,
+		RunAt:        now,
+		NextRunAt:    schedule.Cron.Next(now),
+	}
+	if err := s.scheduleStorer.SetScheduleRun(ctx, run); err != nil {
+		return 0, fmt.Errorf("set schedule run: %w", err)
+	}
+
+	return collectionID, nil
+}
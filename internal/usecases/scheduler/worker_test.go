@@ -0,0 +1,172 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/n-r-w/collector/internal/config"
+	"github.com/n-r-w/collector/internal/entity"
+	"github.com/n-r-w/ctxlog"
+	"github.com/n-r-w/pgh/v2/txmgr"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestWorker(t *testing.T) {
+	ctx := ctxlog.MustContext(context.Background(),
+		ctxlog.WithTesting(t),
+		ctxlog.WithLevel(slog.LevelInfo),
+	)
+
+	now := time.Date(2026, 10, 19, 10, 30, 0, 0, time.UTC)
+
+	cfg := &config.Config{}
+	cfg.Collection.SchedulerMaxSchedules = 100
+	cfg.Collection.MaxRequestsPerCollection = 1000
+
+	cron, err := entity.ParseCronExpression("0 * * * *")
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockLocker := NewMockILocker(ctrl)
+		mockSchedules := NewMockIScheduleStorer(ctrl)
+		mockCreator := NewMockICollectionCreator(ctrl)
+
+		svc := &Service{
+			cfg:               cfg,
+			now:               func() time.Time { return now },
+			txManager:         newMockTxManager(ctrl),
+			locker:            mockLocker,
+			scheduleStorer:    mockSchedules,
+			collectionCreator: mockCreator,
+		}
+
+		task := entity.Task{
+			Completion: entity.CompletionCriteria{
+				TimeLimit:         time.Hour,
+				RequestCountLimit: 10,
+			},
+		}
+		schedules := []entity.Schedule{
+			{ID: 1, Cron: cron, Task: task, NextRunAt: now.Add(-time.Minute)},
+			{ID: 2, Cron: cron, Task: task, NextRunAt: now.Add(-time.Hour * 3)}, // missed runs
+		}
+
+		mockLocker.EXPECT().TryLockFunc(gomock.Any(), entity.ScheduleLockKey, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, key entity.LockKey, fn func(context.Context) error) (bool, error) {
+				return true, fn(ctx)
+			})
+		mockSchedules.EXPECT().GetDueSchedules(gomock.Any(), now, 100).Return(schedules, nil)
+
+		nextRunAt := time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC)
+		mockCreator.EXPECT().CreateCollection(gomock.Any(), task).Return(entity.CollectionID(10), nil)
+		mockSchedules.EXPECT().SetScheduleRun(gomock.Any(), entity.ScheduleRun{
+			ScheduleID:   1,
+			CollectionID: 10,
+			RunAt:        now,
+			NextRunAt:    nextRunAt,
+		}).Return(nil)
+		mockCreator.EXPECT().CreateCollection(gomock.Any(), task).Return(entity.CollectionID(11), nil)
+		mockSchedules.EXPECT().SetScheduleRun(gomock.Any(), entity.ScheduleRun{
+			ScheduleID:   2,
+			CollectionID: 11,
+			RunAt:        now,
+			NextRunAt:    nextRunAt,
+		}).Return(nil)
+
+		require.NoError(t, svc.worker(ctx))
+	})
+
+	t.Run("lock is not acquired", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockLocker := NewMockILocker(ctrl)
+
+		svc := &Service{
+			cfg:    cfg,
+			now:    func() time.Time { return now },
+			locker: mockLocker,
+		}
+
+		mockLocker.EXPECT().TryLockFunc(gomock.Any(), entity.ScheduleLockKey, gomock.Any()).
+			Return(false, nil)
+
+		require.NoError(t, svc.worker(ctx))
+	})
+
+	t.Run("failed schedules are skipped", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+
+		mockLocker := NewMockILocker(ctrl)
+		mockSchedules := NewMockIScheduleStorer(ctrl)
+		mockCreator := NewMockICollectionCreator(ctrl)
+
+		svc := &Service{
+			cfg:               cfg,
+			now:               func() time.Time { return now },
+			txManager:         newMockTxManager(ctrl),
+			locker:            mockLocker,
+			scheduleStorer:    mockSchedules,
+			collectionCreator: mockCreator,
+		}
+
+		task := entity.Task{
+			Completion: entity.CompletionCriteria{
+				TimeLimit:         time.Hour,
+				RequestCountLimit: 10,
+			},
+		}
+		// the limit was lowered after the schedule was created
+		tooLarge := entity.Task{
+			Completion: entity.CompletionCriteria{
+				TimeLimit:         time.Hour,
+				RequestCountLimit: 2000,
+			},
+		}
+
+		mockLocker.EXPECT().TryLockFunc(gomock.Any(), entity.ScheduleLockKey, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, key entity.LockKey, fn func(context.Context) error) (bool, error) {
+				return true, fn(ctx)
+			})
+		mockSchedules.EXPECT().GetDueSchedules(gomock.Any(), now, 100).
+			Return([]entity.Schedule{
+				{ID: 1, Cron: cron, Task: task},
+				{ID: 2, Cron: cron, Task: tooLarge},
+				{ID: 3, Cron: cron, Task: task},
+			}, nil)
+
+		nextRunAt := time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC)
+		mockCreator.EXPECT().CreateCollection(gomock.Any(), task).
+			Return(entity.CollectionID(0), errors.New("db error"))
+		mockSchedules.EXPECT().SkipScheduleRun(gomock.Any(), entity.ScheduleID(1), nextRunAt).Return(nil)
+		mockSchedules.EXPECT().SkipScheduleRun(gomock.Any(), entity.ScheduleID(2), nextRunAt).
+			Return(entity.ErrScheduleNotFound)
+		mockCreator.EXPECT().CreateCollection(gomock.Any(), task).Return(entity.CollectionID(10), nil)
+		mockSchedules.EXPECT().SetScheduleRun(gomock.Any(), entity.ScheduleRun{
+			ScheduleID:   3,
+			CollectionID: 10,
+			RunAt:        now,
+			NextRunAt:    nextRunAt,
+		}).Return(nil)
+
+		require.NoError(t, svc.worker(ctx))
+	})
+}
+
+// newMockTxManager returns a transaction manager that runs functions without transactions.
+func newMockTxManager(ctrl *gomock.Controller) *txmgr.MockITransactionManager {
+	mockTxManager := txmgr.NewMockITransactionManager(ctrl)
+	mockTxManager.EXPECT().WithoutTransaction(gomock.Any()).
+		DoAndReturn(func(ctx context.Context) context.Context { return ctx }).AnyTimes()
+	mockTxManager.EXPECT().Begin(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, fn func(context.Context) error, _ ...txmgr.Option) error {
+			return fn(ctx)
+		}).AnyTimes()
+
+	return mockTxManager
+}
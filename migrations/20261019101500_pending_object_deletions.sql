@@ -0,0 +1,14 @@
+-- +goose Up
+-- objects that failed to be deleted from the object storage and must be retried by the cleaner
+CREATE TABLE pending_object_deletions (
+    result_id TEXT PRIMARY KEY,
+    attempts INTEGER NOT NULL DEFAULT 1,
+    last_error TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_pending_object_deletions_updated_at ON pending_object_deletions(updated_at);
+
+-- +goose Down
+DROP TABLE pending_object_deletions;
@@ -0,0 +1,8 @@
+-- +goose Up
+-- retention_period overrides the global retention period, pinned collections are never cleaned up
+ALTER TABLE collections ADD COLUMN retention_period INTERVAL;
+ALTER TABLE collections ADD COLUMN pinned BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE collections DROP COLUMN pinned;
+ALTER TABLE collections DROP COLUMN retention_period;
@@ -0,0 +1,84 @@
+-- +goose Up
+-- requests and request_collections are partitioned by the request creation time,
+-- so the cleaner can drop whole partitions instead of deleting rows.
+-- Range partitions are created by the cleaner in advance, default partitions keep rows that don't fit any of them.
+CREATE TABLE requests_partitioned (
+    id BIGINT NOT NULL,
+    handler TEXT NOT NULL,
+    headers JSONB NOT NULL,
+    body JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+) PARTITION BY RANGE (created_at);
+
+-- created_at is a copy of requests.created_at, so links are stored in the same partition as requests
+CREATE TABLE request_collections_partitioned (
+    request_id BIGINT NOT NULL,
+    collection_id BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE requests_default PARTITION OF requests_partitioned DEFAULT;
+CREATE TABLE request_collections_default PARTITION OF request_collections_partitioned DEFAULT;
+
+INSERT INTO requests_partitioned (id, handler, headers, body, created_at)
+SELECT id, handler, headers, body, created_at FROM requests;
+
+INSERT INTO request_collections_partitioned (request_id, collection_id, created_at)
+SELECT rc.request_id, rc.collection_id, r.created_at
+FROM request_collections rc
+JOIN requests r ON r.id = rc.request_id;
+
+DROP TABLE request_collections;
+DROP TABLE requests;
+
+ALTER TABLE requests_partitioned RENAME TO requests;
+ALTER TABLE request_collections_partitioned RENAME TO request_collections;
+
+ALTER TABLE requests ADD CONSTRAINT requests_pkey PRIMARY KEY (id, created_at);
+ALTER TABLE request_collections ADD CONSTRAINT request_collections_pkey
+    PRIMARY KEY (request_id, collection_id, created_at);
+
+CREATE SEQUENCE requests_id_seq OWNED BY requests.id;
+SELECT setval('requests_id_seq', COALESCE((SELECT MAX(id) FROM requests), 0) + 1, false);
+ALTER TABLE requests ALTER COLUMN id SET DEFAULT nextval('requests_id_seq');
+
+CREATE INDEX idx_requests_created_at ON requests(created_at);
+CREATE INDEX idx_request_collections_request_id ON request_collections(request_id);
+CREATE INDEX idx_request_collections_collection_id ON request_collections(collection_id);
+
+-- +goose Down
+CREATE TABLE requests_plain (
+    id BIGINT PRIMARY KEY GENERATED BY DEFAULT AS IDENTITY,
+    handler TEXT NOT NULL,
+    headers JSONB NOT NULL,
+    body JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE request_collections_plain (
+    request_id BIGINT NOT NULL,
+    collection_id BIGINT NOT NULL,
+    PRIMARY KEY (request_id, collection_id)
+);
+
+INSERT INTO requests_plain (id, handler, headers, body, created_at)
+SELECT id, handler, headers, body, created_at FROM requests;
+
+INSERT INTO request_collections_plain (request_id, collection_id)
+SELECT request_id, collection_id FROM request_collections;
+
+-- drops all partitions
+DROP TABLE request_collections;
+DROP TABLE requests;
+
+ALTER TABLE requests_plain RENAME TO requests;
+ALTER TABLE request_collections_plain RENAME TO request_collections;
+ALTER INDEX requests_plain_pkey RENAME TO requests_pkey;
+ALTER INDEX request_collections_plain_pkey RENAME TO request_collections_pkey;
+
+SELECT setval(pg_get_serial_sequence('requests', 'id'), COALESCE((SELECT MAX(id) FROM requests), 0) + 1, false);
+ALTER TABLE requests ALTER COLUMN id SET GENERATED ALWAYS;
+
+CREATE INDEX idx_requests_created_at ON requests(created_at);
+CREATE INDEX idx_request_collections_request_id ON request_collections(request_id);
+CREATE INDEX idx_request_collections_collection_id ON request_collections(collection_id);
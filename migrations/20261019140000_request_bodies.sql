@@ -0,0 +1,50 @@
+-- +goose Up
+-- request bodies are stored once per content hash (sha256 of the body)
+CREATE TABLE request_bodies (
+    hash BYTEA PRIMARY KEY,
+    body JSONB NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- refreshed when the body is stored again, protects bodies of requests being stored from the cleanup
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_request_bodies_last_seen_at ON request_bodies(last_seen_at);
+
+-- hashes of existing bodies are calculated from their normalized representation
+ALTER TABLE requests ADD COLUMN body_hash BYTEA;
+UPDATE requests SET body_hash = sha256(convert_to(body::text, 'UTF8'));
+
+INSERT INTO request_bodies (hash, body)
+SELECT DISTINCT ON (body_hash) body_hash, body FROM requests
+ON CONFLICT (hash) DO NOTHING;
+
+ALTER TABLE requests ALTER COLUMN body_hash SET NOT NULL;
+ALTER TABLE requests DROP COLUMN body;
+
+CREATE INDEX idx_requests_body_hash ON requests(body_hash);
+
+-- collections with distinct_bodies collect every body only once, duplicates are counted in duplicate_count
+ALTER TABLE collections ADD COLUMN distinct_bodies BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE collections ADD COLUMN duplicate_count INTEGER NOT NULL DEFAULT 0;
+
+-- bodies already collected by collections with distinct_bodies
+CREATE TABLE collection_bodies (
+    collection_id BIGINT NOT NULL,
+    body_hash BYTEA NOT NULL,
+    PRIMARY KEY (collection_id, body_hash)
+);
+
+-- +goose Down
+DROP TABLE collection_bodies;
+
+ALTER TABLE collections DROP COLUMN duplicate_count;
+ALTER TABLE collections DROP COLUMN distinct_bodies;
+
+DROP INDEX idx_requests_body_hash;
+
+ALTER TABLE requests ADD COLUMN body JSONB;
+UPDATE requests r SET body = b.body FROM request_bodies b WHERE b.hash = r.body_hash;
+ALTER TABLE requests ALTER COLUMN body SET NOT NULL;
+ALTER TABLE requests DROP COLUMN body_hash;
+
+DROP TABLE request_bodies;
@@ -0,0 +1,30 @@
+-- +goose Up
+-- large bodies are stored in the object storage, only their keys are kept in the database
+ALTER TABLE request_bodies ALTER COLUMN body DROP NOT NULL;
+ALTER TABLE request_bodies ADD COLUMN object_key TEXT;
+ALTER TABLE request_bodies ADD CONSTRAINT request_bodies_body_check CHECK ((body IS NULL) <> (object_key IS NULL));
+
+-- released objects are checked for being referenced again before they are deleted
+CREATE INDEX idx_request_bodies_object_key ON request_bodies(object_key) WHERE object_key IS NOT NULL;
+
+-- objects of request bodies that are not used anymore, deleted from the object storage by the cleaner
+CREATE TABLE released_body_objects (
+    object_key TEXT PRIMARY KEY,
+    released_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    -- the object is being deleted from the object storage, it can't be claimed until the deletion ends
+    deleting_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_released_body_objects_released_at ON released_body_objects(released_at);
+
+-- +goose Down
+DROP TABLE released_body_objects;
+DROP INDEX idx_request_bodies_object_key;
+
+-- bodies stored in the object storage can't be restored
+DELETE FROM requests WHERE body_hash IN (SELECT hash FROM request_bodies WHERE object_key IS NOT NULL);
+DELETE FROM request_bodies WHERE object_key IS NOT NULL;
+
+ALTER TABLE request_bodies DROP CONSTRAINT request_bodies_body_check;
+ALTER TABLE request_bodies DROP COLUMN object_key;
+ALTER TABLE request_bodies ALTER COLUMN body SET NOT NULL;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- quotas and counters of the selection rules of multi-rule collections,
+-- rule definitions are stored in collections.criteria
+CREATE TABLE collection_rules (
+    collection_id BIGINT NOT NULL,
+    rule_id INTEGER NOT NULL, -- position of the rule in the collection criteria
+    name TEXT NOT NULL,
+    request_count_limit INTEGER NOT NULL,
+    request_count INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (collection_id, rule_id)
+);
+
+-- rule that collected the request, NULL for collections without rules
+ALTER TABLE request_collections ADD COLUMN rule_id INTEGER;
+
+-- +goose Down
+ALTER TABLE request_collections DROP COLUMN rule_id;
+
+DROP TABLE collection_rules;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- reservoir collections keep a uniform sample of request_count_limit requests of the whole time limit,
+-- seen_count is the number of matching requests offered to the reservoir
+ALTER TABLE collections ADD COLUMN reservoir BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE collections ADD COLUMN seen_count INTEGER NOT NULL DEFAULT 0;
+
+-- position of the request in the reservoir, NULL for other collections
+ALTER TABLE request_collections ADD COLUMN slot INTEGER;
+
+CREATE INDEX idx_request_collections_slot ON request_collections(collection_id, slot) WHERE slot IS NOT NULL;
+
+-- +goose Down
+DROP INDEX idx_request_collections_slot;
+
+ALTER TABLE request_collections DROP COLUMN slot;
+
+ALTER TABLE collections DROP COLUMN seen_count;
+ALTER TABLE collections DROP COLUMN reservoir;
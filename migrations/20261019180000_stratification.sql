@@ -0,0 +1,21 @@
+-- +goose Up
+-- stratified collections collect at most max_per_value requests per distinct value of the stratification key,
+-- the key is defined in collections.criteria
+ALTER TABLE collections ADD COLUMN max_per_value INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE collections ADD COLUMN min_distinct_values INTEGER NOT NULL DEFAULT 0;
+
+-- number of collected requests per value of the stratification key
+-- values are unbounded, so strata are keyed by the value hash (sha256), the value itself is kept truncated for display
+CREATE TABLE collection_strata (
+    collection_id BIGINT NOT NULL,
+    value TEXT NOT NULL,
+    request_count INTEGER NOT NULL DEFAULT 0,
+    value_hash BYTEA NOT NULL,
+    PRIMARY KEY (collection_id, value_hash)
+);
+
+-- +goose Down
+DROP TABLE collection_strata;
+
+ALTER TABLE collections DROP COLUMN min_distinct_values;
+ALTER TABLE collections DROP COLUMN max_per_value;
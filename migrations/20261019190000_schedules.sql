@@ -0,0 +1,30 @@
+-- +goose Up
+-- collecting of the collection is delayed until start_at
+ALTER TABLE collections ADD COLUMN start_at TIMESTAMP WITH TIME ZONE;
+
+-- schedules create collections from a task template by a cron expression,
+-- the template is stored in the same way as the collection parameters
+CREATE TABLE schedules (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    cron_expression TEXT NOT NULL,
+    request_count_limit INTEGER NOT NULL,
+    request_duration_limit INTERVAL NOT NULL,
+    criteria JSONB NOT NULL,
+    retention_period INTERVAL,
+    pinned BOOLEAN NOT NULL DEFAULT FALSE,
+    distinct_bodies BOOLEAN NOT NULL DEFAULT FALSE,
+    reservoir BOOLEAN NOT NULL DEFAULT FALSE,
+    max_per_value INTEGER NOT NULL DEFAULT 0,
+    min_distinct_values INTEGER NOT NULL DEFAULT 0,
+    next_run_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_run_at TIMESTAMP WITH TIME ZONE,
+    last_collection_id BIGINT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_schedules_next_run_at ON schedules(next_run_at);
+
+-- +goose Down
+DROP TABLE schedules;
+
+ALTER TABLE collections DROP COLUMN start_at;
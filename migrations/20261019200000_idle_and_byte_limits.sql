@@ -0,0 +1,19 @@
+-- +goose Up
+-- collections are also completed when no request arrives for idle_timeout
+-- or when the body bytes of collected requests reach byte_size_limit, zero means no limit
+ALTER TABLE collections ADD COLUMN idle_timeout INTERVAL NOT NULL DEFAULT '0';
+ALTER TABLE collections ADD COLUMN byte_size_limit BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE collections ADD COLUMN byte_count BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE collections ADD COLUMN last_request_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE schedules ADD COLUMN idle_timeout INTERVAL NOT NULL DEFAULT '0';
+ALTER TABLE schedules ADD COLUMN byte_size_limit BIGINT NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE schedules DROP COLUMN byte_size_limit;
+ALTER TABLE schedules DROP COLUMN idle_timeout;
+
+ALTER TABLE collections DROP COLUMN last_request_at;
+ALTER TABLE collections DROP COLUMN byte_count;
+ALTER TABLE collections DROP COLUMN byte_size_limit;
+ALTER TABLE collections DROP COLUMN idle_timeout;
@@ -0,0 +1,12 @@
+-- +goose Up
+-- collections with fewer requests than min_request_count fail instead of being completed, zero means no minimum
+ALTER TABLE collections ADD COLUMN min_request_count INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE schedules ADD COLUMN min_request_count INTEGER NOT NULL DEFAULT 0;
+
+-- failed finalization attempts are counted, the collection fails when their number reaches the limit
+ALTER TABLE collections ADD COLUMN finalization_attempts INTEGER NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE collections DROP COLUMN finalization_attempts;
+ALTER TABLE schedules DROP COLUMN min_request_count;
+ALTER TABLE collections DROP COLUMN min_request_count;
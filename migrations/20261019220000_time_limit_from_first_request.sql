@@ -0,0 +1,14 @@
+-- +goose Up
+-- the time limit can be counted from the first request (started_at), the first request is waited for max_wait
+ALTER TABLE collections ADD COLUMN time_limit_from_first_request BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE collections ADD COLUMN max_wait INTERVAL NOT NULL DEFAULT '0';
+
+ALTER TABLE schedules ADD COLUMN time_limit_from_first_request BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE schedules ADD COLUMN max_wait INTERVAL NOT NULL DEFAULT '0';
+
+-- +goose Down
+ALTER TABLE schedules DROP COLUMN max_wait;
+ALTER TABLE schedules DROP COLUMN time_limit_from_first_request;
+
+ALTER TABLE collections DROP COLUMN max_wait;
+ALTER TABLE collections DROP COLUMN time_limit_from_first_request;
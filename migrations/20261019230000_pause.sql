@@ -0,0 +1,12 @@
+-- +goose Up
+-- paused collections don't collect requests, the time limit may be frozen during a pause
+ALTER TABLE collections ADD COLUMN paused_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE collections ADD COLUMN resumed_at TIMESTAMP WITH TIME ZONE;
+ALTER TABLE collections ADD COLUMN freeze_time_limit BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE collections ADD COLUMN frozen_duration INTERVAL NOT NULL DEFAULT '0';
+
+-- +goose Down
+ALTER TABLE collections DROP COLUMN frozen_duration;
+ALTER TABLE collections DROP COLUMN freeze_time_limit;
+ALTER TABLE collections DROP COLUMN resumed_at;
+ALTER TABLE collections DROP COLUMN paused_at;
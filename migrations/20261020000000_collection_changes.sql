@@ -0,0 +1,16 @@
+-- +goose Up
+-- audit of the changes of collecting collections, previous and current contain the changed fields only
+CREATE TABLE collection_changes (
+    id BIGINT PRIMARY KEY GENERATED ALWAYS AS IDENTITY,
+    collection_id BIGINT NOT NULL,
+    author TEXT NOT NULL,
+    fields TEXT[] NOT NULL,
+    previous JSONB NOT NULL,
+    current JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_collection_changes_collection_id ON collection_changes(collection_id);
+
+-- +goose Down
+DROP TABLE collection_changes;
@@ -0,0 +1,29 @@
+-- +goose Up
+-- name, description, owner and labels describe the collection for people, they don't affect collecting
+ALTER TABLE collections ADD COLUMN name TEXT NOT NULL DEFAULT '';
+ALTER TABLE collections ADD COLUMN description TEXT NOT NULL DEFAULT '';
+ALTER TABLE collections ADD COLUMN owner TEXT NOT NULL DEFAULT '';
+ALTER TABLE collections ADD COLUMN labels JSONB NOT NULL DEFAULT '{}';
+
+-- collections are filtered by owner and label selectors
+CREATE INDEX idx_collections_owner ON collections(owner);
+CREATE INDEX idx_collections_labels ON collections USING GIN (labels);
+
+ALTER TABLE schedules ADD COLUMN name TEXT NOT NULL DEFAULT '';
+ALTER TABLE schedules ADD COLUMN description TEXT NOT NULL DEFAULT '';
+ALTER TABLE schedules ADD COLUMN owner TEXT NOT NULL DEFAULT '';
+ALTER TABLE schedules ADD COLUMN labels JSONB NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE schedules DROP COLUMN labels;
+ALTER TABLE schedules DROP COLUMN owner;
+ALTER TABLE schedules DROP COLUMN description;
+ALTER TABLE schedules DROP COLUMN name;
+
+DROP INDEX idx_collections_labels;
+DROP INDEX idx_collections_owner;
+
+ALTER TABLE collections DROP COLUMN labels;
+ALTER TABLE collections DROP COLUMN owner;
+ALTER TABLE collections DROP COLUMN description;
+ALTER TABLE collections DROP COLUMN name;
@@ -0,0 +1,25 @@
+-- +goose Up
+-- handlers of the selection criteria, of its handler patterns and of every rule, collections are filtered by any
+-- of them. Handlers are matched case-insensitively, so they are kept in lower case
+ALTER TABLE collections ADD COLUMN handlers JSONB GENERATED ALWAYS AS (
+    lower((
+        jsonb_path_query_array(criteria, '$.handler') ||
+        jsonb_path_query_array(criteria, '$.handlerMatch.patterns[*]') ||
+        jsonb_path_query_array(criteria, '$.rules[*].criteria.handler') ||
+        jsonb_path_query_array(criteria, '$.rules[*].criteria.handlerMatch.patterns[*]')
+    )::text)::jsonb
+) STORED;
+
+CREATE INDEX idx_collections_handlers ON collections USING GIN (handlers);
+
+-- owner, labels and completed_at are backed by their own indexes,
+-- pages are sorted by the creation time, the id breaks ties
+CREATE INDEX idx_collections_created_at_id ON collections(created_at, id);
+DROP INDEX idx_collections_created_at;
+
+-- +goose Down
+CREATE INDEX idx_collections_created_at ON collections(created_at);
+DROP INDEX idx_collections_created_at_id;
+
+DROP INDEX idx_collections_handlers;
+ALTER TABLE collections DROP COLUMN handlers;